@@ -12,10 +12,17 @@ import (
 	"syscall"
 	"time"
 
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/domain/service"
 	"article-manager/internal/infrastructure/ai"
+	"article-manager/internal/infrastructure/cache"
 	"article-manager/internal/infrastructure/database"
+	"article-manager/internal/infrastructure/external"
+	"article-manager/internal/infrastructure/linkcheck"
 	applogger "article-manager/internal/infrastructure/logger"
+	"article-manager/internal/infrastructure/metadata"
 	"article-manager/internal/infrastructure/repository"
+	"article-manager/internal/infrastructure/scheduler"
 	infraservice "article-manager/internal/infrastructure/service"
 	"article-manager/internal/interface/handler"
 	"article-manager/internal/usecase"
@@ -57,28 +64,175 @@ func main() {
 		logger.Fatalf("マイグレーション実行に失敗: %v", err)
 	}
 
-	// 依存性注入(article)
-	articleRepo := repository.NewMySQLArticleRepository(db)
-	articleUsecase := usecase.NewArticleUsecase(articleRepo)
-	articleHandler := handler.NewArticleHandler(articleUsecase)
+	// タグ名の正規化キーの更新と衝突の検出
+	tagNamePolicy := entity.TagNamePolicy{FoldCase: config.TagCaseFolding}
+	tagNameCollisions, err := migrationManager.MigrateTagNormalizedKeys(context.Background(), tagNamePolicy)
+	if err != nil {
+		logger.Fatalf("タグ名の正規化に失敗: %v", err)
+	}
 
 	// 依存性注入(tag)
-	tagRepo := repository.NewMySQLTagRepository(db)
+	tagRepo := repository.NewMySQLTagRepository(db, tagNamePolicy)
 	tagUsecase := usecase.NewTagUsecase(tagRepo)
 	tagHandler := handler.NewTagHandler(tagUsecase)
 
+	// 衝突したタグは正規化キーを持たないため、先頭のタグに統合する（統合できない場合は起動しない）
+	if err := tagUsecase.MergeNameCollisions(context.Background(), tagNameCollisions); err != nil {
+		logger.Fatalf("タグ名が衝突したタグの統合に失敗: %v", err)
+	}
+
+	// 依存性注入(article)
+	articleRepo := repository.NewMySQLArticleRepository(db, tagNamePolicy)
+	articleUsecase := usecase.NewArticleUsecase(articleRepo, tagRepo)
+	articleHandler := handler.NewArticleHandler(articleUsecase)
+
+	// 依存性注入(tag graph)
+	tagGraphUsecase := usecase.NewTagGraphUsecase(articleRepo)
+	tagGraphHandler := handler.NewTagGraphHandler(tagGraphUsecase)
+
+	// 依存性注入(ai usage)
+	aiUsageRepo := repository.NewMySQLAIUsageRepository(db)
+	aiUsageUsecase := usecase.NewAIUsageUsecase(aiUsageRepo, config.AIMonthlyTokenBudget)
+	aiUsageHandler := handler.NewAIUsageHandler(aiUsageUsecase)
+
 	// 依存性注入(ai generator)
 	geminiConfig := ai.DefaultGeminiConfig(config.GeminiAPIKey)
+	geminiConfig.UsageTracker = aiUsageUsecase
+	promptTemplates, err := ai.LoadPromptTemplates(config.PromptTemplateDir)
+	if err != nil {
+		logger.Fatalf("プロンプトテンプレートの読み込みに失敗: %v", err)
+	}
+	geminiConfig.Prompts = promptTemplates
+	logger.Printf("プロンプトテンプレート: article=%s", promptTemplates.ArticleVersion())
 	geminiClient := ai.NewGeminiClient(geminiConfig)
-	articleGeneratorUsecase := usecase.NewArticleGeneratorUsecase(geminiClient, articleRepo, tagRepo)
+	metadataFetcher := metadata.NewHTMLMetadataFetcher(metadata.DefaultHTMLMetadataFetcherConfig())
+	tagSuggestionConfig := usecase.TagSuggestionConfig{
+		Policy:              config.TagSuggestionPolicy,
+		SimilarityThreshold: config.TagSimilarityThreshold,
+	}
+	var generationCache service.GenerationCache
+	switch config.GenerationCacheBackend {
+	case "memory":
+		generationCache = cache.NewMemoryGenerationCache(config.GenerationCacheMaxEntries, config.GenerationCacheTTL)
+	case "mysql":
+		generationCache = cache.NewMySQLGenerationCache(db, config.GenerationCacheMaxEntries, config.GenerationCacheTTL)
+	}
+	logger.Printf("生成キャッシュ: backend=%s, ttl=%s, max_entries=%d", config.GenerationCacheBackend, config.GenerationCacheTTL, config.GenerationCacheMaxEntries)
+	articleGeneratorUsecase := usecase.NewArticleGeneratorUsecase(geminiClient, articleRepo, tagRepo, metadataFetcher, generationCache, tagSuggestionConfig)
 	articleGeneratorHandler := handler.NewArticleGeneratorHandler(articleGeneratorUsecase)
 
+	// 依存性注入(ask)
+	var questionAnswerer service.QuestionAnswerer = geminiClient
+	if config.AskProvider == "fake" {
+		questionAnswerer = ai.NewFakeQuestionAnswerer()
+	}
+	logger.Printf("質問応答プロバイダー: %s", config.AskProvider)
+	askUsecase := usecase.NewAskUsecase(articleRepo, questionAnswerer)
+	askHandler := handler.NewAskHandler(askUsecase)
+
+	// 依存性注入(digest)
+	digestRepo := repository.NewMySQLDigestRepository(db)
+	digestUsecase := usecase.NewDigestUsecase(articleRepo, digestRepo, geminiClient, config.DigestPeriod)
+	digestHandler := handler.NewDigestHandler(digestUsecase)
+
+	// 依存性注入(book feedback)
+	bookFeedbackRepo := repository.NewMySQLBookFeedbackRepository(db)
+	bookFeedbackUsecase := usecase.NewBookFeedbackUsecase(bookFeedbackRepo)
+	bookFeedbackHandler := handler.NewBookFeedbackHandler(bookFeedbackUsecase)
+
 	// 依存性注入(book recommendation)
-	bookRecommendationService := infraservice.NewBookRecommendationService(geminiClient)
+	googleBooksClient := external.NewGoogleBooksClient(external.DefaultGoogleBooksConfig(config.GoogleBooksAPIKey))
+	bookRecommendationService := infraservice.NewBookRecommendationService(geminiClient, googleBooksClient)
 	bookRecommendationRepo := repository.NewMySQLBookRecommendationRepository(db)
-	bookRecommendationUsecase := usecase.NewBookRecommendationUsecase(articleRepo, bookRecommendationRepo, bookRecommendationService)
+	bookRecommendationUsecase := usecase.NewBookRecommendationUsecase(articleRepo, tagRepo, bookRecommendationRepo, bookRecommendationService, bookFeedbackRepo, config.BookRecommendation)
 	bookRecommendationHandler := handler.NewBookRecommendationHandler(bookRecommendationUsecase)
 
+	// 依存性注入(reading list)
+	readingListRepo := repository.NewMySQLReadingListRepository(db)
+	readingListUsecase := usecase.NewReadingListUsecase(readingListRepo, articleRepo, bookRecommendationRepo)
+	readingListHandler := handler.NewReadingListHandler(readingListUsecase)
+
+	// 依存性注入(collection)
+	collectionRepo := repository.NewMySQLCollectionRepository(db)
+	collectionUsecase := usecase.NewCollectionUsecase(collectionRepo, articleRepo)
+	collectionHandler := handler.NewCollectionHandler(collectionUsecase)
+
+	// 依存性注入(link health)
+	linkChecker := linkcheck.NewHTTPLinkChecker(linkcheck.DefaultHTTPLinkCheckerConfig())
+	linkHealthRepo := repository.NewMySQLLinkHealthRepository(db)
+	linkHealthUsecase := usecase.NewLinkHealthUsecase(articleRepo, linkHealthRepo, linkChecker, config.LinkCheckAutoUpdateURL)
+	linkHealthHandler := handler.NewLinkHealthHandler(linkHealthUsecase)
+
+	// 依存性注入(article metadata)
+	articleMetadataUsecase := usecase.NewArticleMetadataUsecase(articleRepo, metadataFetcher)
+	articleMetadataHandler := handler.NewArticleMetadataHandler(articleMetadataUsecase)
+
+	// 依存性注入(health)
+	healthHandler := handler.NewHealthHandler(geminiClient.CircuitBreaker(), googleBooksClient.CircuitBreaker())
+
+	// バックグラウンドジョブの設定
+	jobScheduler := scheduler.NewScheduler()
+	jobScheduler.AddJob(scheduler.Job{
+		Name:     "link-health-check",
+		Interval: config.LinkCheckInterval,
+		Run: func(ctx context.Context) error {
+			_, err := linkHealthUsecase.CheckAllLinks(ctx)
+			return err
+		},
+	})
+	jobScheduler.AddJob(scheduler.Job{
+		Name:       "article-metadata-backfill",
+		Interval:   config.MetadataBackfillInterval,
+		RunOnStart: true,
+		Run: func(ctx context.Context) error {
+			_, err := articleMetadataUsecase.BackfillMetadata(ctx)
+			return err
+		},
+	})
+	// DIGEST_PERIODが0の場合はダイジェストを定期生成しない
+	digestCheckInterval := config.DigestCheckInterval
+	if config.DigestPeriod <= 0 {
+		digestCheckInterval = 0
+	}
+	jobScheduler.AddJob(scheduler.Job{
+		Name:       "digest-generation",
+		Interval:   digestCheckInterval,
+		RunOnStart: true,
+		Run: func(ctx context.Context) error {
+			_, err := digestUsecase.GenerateDueDigests(ctx)
+			return err
+		},
+	})
+	// BOOK_RECOMMENDATION_PREWARM_BEFOREが0の場合は事前生成しない
+	bookRecommendationPrewarmCheck := config.BookRecommendationPrewarmCheck
+	if config.BookRecommendation.PrewarmBefore <= 0 {
+		bookRecommendationPrewarmCheck = 0
+	}
+	jobScheduler.AddJob(scheduler.Job{
+		Name:     "book-recommendation-prewarm",
+		Interval: bookRecommendationPrewarmCheck,
+		Run: func(ctx context.Context) error {
+			_, err := bookRecommendationUsecase.PrewarmBookRecommendations(ctx)
+			return err
+		},
+	})
+	// BOOK_RECOMMENDATION_RETENTIONが0の場合は推薦の履歴を削除しない
+	bookRecommendationPruneInterval := config.BookRecommendationPruneInterval
+	if config.BookRecommendationRetention <= 0 {
+		bookRecommendationPruneInterval = 0
+	}
+	jobScheduler.AddJob(scheduler.Job{
+		Name:     "book-recommendation-pruning",
+		Interval: bookRecommendationPruneInterval,
+		Run: func(ctx context.Context) error {
+			_, err := bookRecommendationUsecase.PruneBookRecommendationHistory(ctx, config.BookRecommendationRetention)
+			return err
+		},
+	})
+	jobCtx, cancelJobs := context.WithCancel(context.Background())
+	defer cancelJobs()
+	jobScheduler.Start(jobCtx)
+
 	// HTTPルーターの設定
 	mux := http.NewServeMux()
 
@@ -90,7 +244,7 @@ func main() {
 	})
 
 	// ヘルスチェックエンドポイント
-	mux.HandleFunc("GET /api/health", handler.HealthCheckHandler)
+	mux.HandleFunc("GET /api/health", healthHandler.HealthCheck)
 
 	// 記事一覧取得
 	mux.HandleFunc("GET /api/articles", articleHandler.GetAllArticles)
@@ -101,6 +255,9 @@ func main() {
 	// 記事自動作成
 	mux.HandleFunc("POST /api/articles/generate", articleGeneratorHandler.GenerateArticle)
 
+	// 記事自動作成（進捗をServer-Sent Eventsで送信）
+	mux.HandleFunc("POST /api/articles/generate/stream", articleGeneratorHandler.GenerateArticleStream)
+
 	// 記事検索（{id}より先に定義）
 	mux.HandleFunc("GET /api/articles/search", articleHandler.SearchArticles)
 
@@ -119,6 +276,18 @@ func main() {
 	// タグ作成
 	mux.HandleFunc("POST /api/tags", tagHandler.CreateTag)
 
+	// タグの階層構造取得
+	mux.HandleFunc("GET /api/tags/tree", tagHandler.GetTagTree)
+
+	// タグ候補取得（入力補完）
+	mux.HandleFunc("GET /api/tags/suggest", tagHandler.SuggestTags)
+
+	// タグの共起グラフ取得
+	mux.HandleFunc("GET /api/tags/graph", tagGraphHandler.GetTagGraph)
+
+	// 未使用タグの一括削除
+	mux.HandleFunc("DELETE /api/tags/unused", tagHandler.DeleteUnusedTags)
+
 	// タグ詳細取得
 	mux.HandleFunc("GET /api/tags/{id}", extractTagID(tagHandler.GetTagByID))
 
@@ -128,9 +297,120 @@ func main() {
 	// タグ削除
 	mux.HandleFunc("DELETE /api/tags/{id}", extractTagID(tagHandler.DeleteTag))
 
+	// タグの使用状況と記事数の推移取得
+	mux.HandleFunc("GET /api/tags/{id}/stats", extractTagID(tagHandler.GetTagStats))
+
+	// タグ統合
+	mux.HandleFunc("POST /api/tags/{id}/merge", extractTagID(tagHandler.MergeTags))
+
+	// タグの別名一覧取得
+	mux.HandleFunc("GET /api/tags/{id}/aliases", extractTagID(tagHandler.GetTagAliases))
+
+	// タグの別名追加
+	mux.HandleFunc("POST /api/tags/{id}/aliases", extractTagID(tagHandler.CreateTagAlias))
+
+	// タグの別名削除
+	mux.HandleFunc("DELETE /api/tags/{id}/aliases/{alias}", extractTagID(tagHandler.DeleteTagAlias))
+
 	// 書籍推薦取得
 	mux.HandleFunc("GET /api/book-recommendations", bookRecommendationHandler.GetBookRecommendations)
 
+	// 書籍推薦の再生成
+	mux.HandleFunc("POST /api/book-recommendations/refresh", bookRecommendationHandler.RefreshBookRecommendations)
+
+	// 書籍推薦の履歴取得
+	mux.HandleFunc("GET /api/book-recommendations/history", bookRecommendationHandler.GetBookRecommendationHistory)
+
+	// 記事ごとの書籍推薦取得
+	mux.HandleFunc("GET /api/articles/{id}/book-recommendations", extractArticleID(bookRecommendationHandler.GetArticleBookRecommendations))
+
+	// 書籍フィードバック一覧取得
+	mux.HandleFunc("GET /api/book-feedback", bookFeedbackHandler.GetAllBookFeedback)
+
+	// 書籍フィードバック作成
+	mux.HandleFunc("POST /api/book-feedback", bookFeedbackHandler.CreateBookFeedback)
+
+	// 書籍フィードバック取得
+	mux.HandleFunc("GET /api/book-feedback/{id}", extractBookFeedbackID(bookFeedbackHandler.GetBookFeedbackByID))
+
+	// 書籍フィードバック更新
+	mux.HandleFunc("PUT /api/book-feedback/{id}", extractBookFeedbackID(bookFeedbackHandler.UpdateBookFeedback))
+
+	// 書籍フィードバック削除
+	mux.HandleFunc("DELETE /api/book-feedback/{id}", extractBookFeedbackID(bookFeedbackHandler.DeleteBookFeedback))
+
+	// 読書リスト取得
+	mux.HandleFunc("GET /api/books", readingListHandler.GetReadingList)
+
+	// 読書リストへの書籍追加
+	mux.HandleFunc("POST /api/books", readingListHandler.AddReadingListItem)
+
+	// 書籍推薦からの読書リストへの書籍追加
+	mux.HandleFunc("POST /api/books/from-recommendation", readingListHandler.AddReadingListItemFromRecommendation)
+
+	// 読書リストの書籍取得
+	mux.HandleFunc("GET /api/books/{id}", extractBookID(readingListHandler.GetReadingListItem))
+
+	// 読書リストの書籍更新
+	mux.HandleFunc("PUT /api/books/{id}", extractBookID(readingListHandler.UpdateReadingListItem))
+
+	// 読書リストの書籍削除
+	mux.HandleFunc("DELETE /api/books/{id}", extractBookID(readingListHandler.DeleteReadingListItem))
+
+	// コレクション一覧取得
+	mux.HandleFunc("GET /api/collections", collectionHandler.GetCollections)
+
+	// コレクション作成
+	mux.HandleFunc("POST /api/collections", collectionHandler.CreateCollection)
+
+	// コレクション取得
+	mux.HandleFunc("GET /api/collections/{id}", extractCollectionID(collectionHandler.GetCollectionByID))
+
+	// コレクション更新
+	mux.HandleFunc("PUT /api/collections/{id}", extractCollectionID(collectionHandler.UpdateCollection))
+
+	// コレクション削除
+	mux.HandleFunc("DELETE /api/collections/{id}", extractCollectionID(collectionHandler.DeleteCollection))
+
+	// コレクションのMarkdown出力
+	mux.HandleFunc("GET /api/collections/{id}/export", extractCollectionID(collectionHandler.ExportCollection))
+
+	// コレクションへの記事追加
+	mux.HandleFunc("POST /api/collections/{id}/articles", extractCollectionID(collectionHandler.AddCollectionArticle))
+
+	// コレクションの記事の並べ替え
+	mux.HandleFunc("PUT /api/collections/{id}/articles/order", extractCollectionID(collectionHandler.ReorderCollectionArticles))
+
+	// コレクションの記事の移動
+	mux.HandleFunc("POST /api/collections/{id}/articles/{articleId}/move", extractCollectionID(collectionHandler.MoveCollectionArticle))
+
+	// コレクションからの記事の削除
+	mux.HandleFunc("DELETE /api/collections/{id}/articles/{articleId}", extractCollectionID(collectionHandler.RemoveCollectionArticle))
+
+	// リンクヘルス一覧取得
+	mux.HandleFunc("GET /api/link-health", linkHealthHandler.GetLinkHealth)
+
+	// 全記事のリンクチェック開始
+	mux.HandleFunc("POST /api/link-health/check", linkHealthHandler.StartLinkCheck)
+
+	// 記事のリンクチェック
+	mux.HandleFunc("POST /api/articles/{id}/link-health/check", extractArticleID(linkHealthHandler.CheckArticleLink))
+
+	// 記事メタデータ再取得
+	mux.HandleFunc("POST /api/articles/{id}/metadata/refresh", extractArticleID(articleMetadataHandler.RefreshArticleMetadata))
+
+	// AI使用量取得
+	mux.HandleFunc("GET /api/ai/usage", aiUsageHandler.GetUsage)
+
+	// 保存済み記事への質問応答
+	mux.HandleFunc("POST /api/ask", askHandler.Ask)
+
+	// ダイジェスト一覧取得
+	mux.HandleFunc("GET /api/digests", digestHandler.GetDigests)
+
+	// ダイジェスト詳細取得
+	mux.HandleFunc("GET /api/digests/{id}", extractDigestID(digestHandler.GetDigestByID))
+
 	// CORSミドルウェアの設定
 	corsMiddleware := func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -198,6 +478,10 @@ func main() {
 				logger.Printf("サーバークローズに失敗: %v", err)
 			}
 		}
+		// バックグラウンドジョブを停止
+		cancelJobs()
+		jobScheduler.Wait()
+
 		logger.Println("サーバーを正常にシャットダウンしました")
 	}
 }
@@ -211,6 +495,31 @@ type Config struct {
 	Port              string
 	GeminiAPIKey      string
 	GoogleBooksAPIKey string
+	PromptTemplateDir string
+
+	LinkCheckInterval        time.Duration
+	LinkCheckAutoUpdateURL   bool
+	MetadataBackfillInterval time.Duration
+
+	TagSuggestionPolicy    usecase.TagPolicy
+	TagSimilarityThreshold float64
+	TagCaseFolding         bool // タグ名の大文字小文字を同一視するか
+
+	AIMonthlyTokenBudget int64
+
+	GenerationCacheBackend    string // memory, mysql, none
+	GenerationCacheTTL        time.Duration
+	GenerationCacheMaxEntries int
+
+	AskProvider string // gemini, fake
+
+	DigestPeriod        time.Duration // 0の場合は定期生成しない
+	DigestCheckInterval time.Duration
+
+	BookRecommendation              usecase.BookRecommendationConfig
+	BookRecommendationPrewarmCheck  time.Duration
+	BookRecommendationRetention     time.Duration // 0の場合は推薦の履歴を削除しない
+	BookRecommendationPruneInterval time.Duration
 }
 
 func loadConfig() Config {
@@ -223,6 +532,56 @@ func loadConfig() Config {
 		Port:              getEnv("PORT", "8080"),
 		GeminiAPIKey:      getEnv("GEMINI_API_KEY", ""),
 		GoogleBooksAPIKey: getEnv("GOOGLE_BOOKS_API_KEY", ""),
+		PromptTemplateDir: getEnv("PROMPT_TEMPLATE_DIR", ""),
+
+		LinkCheckInterval:        getDurationEnv("LINK_CHECK_INTERVAL", 24*time.Hour),
+		LinkCheckAutoUpdateURL:   getBoolEnv("LINK_CHECK_AUTO_UPDATE_URL", false),
+		MetadataBackfillInterval: getDurationEnv("METADATA_BACKFILL_INTERVAL", time.Hour),
+
+		TagSimilarityThreshold: getFloatEnv("TAG_SIMILARITY_THRESHOLD", usecase.DefaultTagSimilarityThreshold),
+		TagCaseFolding:         getBoolEnv("TAG_CASE_FOLDING", entity.DefaultTagNamePolicy().FoldCase),
+
+		AIMonthlyTokenBudget: getInt64Env("AI_MONTHLY_TOKEN_BUDGET", 0),
+
+		GenerationCacheBackend:    getEnv("GENERATION_CACHE_BACKEND", "memory"),
+		GenerationCacheTTL:        getDurationEnv("GENERATION_CACHE_TTL", 7*24*time.Hour),
+		GenerationCacheMaxEntries: int(getInt64Env("GENERATION_CACHE_MAX_ENTRIES", 1000)),
+
+		AskProvider: getEnv("ASK_PROVIDER", "gemini"),
+
+		DigestPeriod:        getDurationEnv("DIGEST_PERIOD", 7*24*time.Hour),
+		DigestCheckInterval: getDurationEnv("DIGEST_CHECK_INTERVAL", time.Hour),
+
+		BookRecommendationPrewarmCheck:  getDurationEnv("BOOK_RECOMMENDATION_PREWARM_CHECK_INTERVAL", 15*time.Minute),
+		BookRecommendationRetention:     getDurationEnv("BOOK_RECOMMENDATION_RETENTION", 90*24*time.Hour),
+		BookRecommendationPruneInterval: getDurationEnv("BOOK_RECOMMENDATION_PRUNE_INTERVAL", 24*time.Hour),
+	}
+
+	defaultBookRecommendation := usecase.DefaultBookRecommendationConfig()
+	config.BookRecommendation = usecase.BookRecommendationConfig{
+		TTL:           getDurationEnv("BOOK_RECOMMENDATION_TTL", defaultBookRecommendation.TTL),
+		ArticleTTL:    getDurationEnv("BOOK_RECOMMENDATION_ARTICLE_TTL", defaultBookRecommendation.ArticleTTL),
+		PrewarmBefore: getDurationEnv("BOOK_RECOMMENDATION_PREWARM_BEFORE", defaultBookRecommendation.PrewarmBefore),
+		TagNamePolicy: entity.TagNamePolicy{FoldCase: config.TagCaseFolding},
+	}
+	if err := config.BookRecommendation.Validate(); err != nil {
+		log.Fatalf("BOOK_RECOMMENDATION_TTL and BOOK_RECOMMENDATION_ARTICLE_TTL must be positive: %v", err)
+	}
+
+	tagPolicy, err := usecase.ParseTagPolicy(getEnv("TAG_SUGGESTION_POLICY", string(usecase.TagPolicyAllowNew)))
+	if err != nil {
+		log.Fatalf("TAG_SUGGESTION_POLICY must be one of existing_only, allow_new, ask: %v", err)
+	}
+	config.TagSuggestionPolicy = tagPolicy
+
+	switch config.GenerationCacheBackend {
+	case "memory", "mysql", "none":
+	default:
+		log.Fatalf("GENERATION_CACHE_BACKEND must be one of memory, mysql, none: %s", config.GenerationCacheBackend)
+	}
+
+	if config.AskProvider != "gemini" && config.AskProvider != "fake" {
+		log.Fatalf("ASK_PROVIDER must be one of gemini, fake: %s", config.AskProvider)
 	}
 
 	// ユーザー名が設定されていない場合はエラー
@@ -254,6 +613,54 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		log.Fatalf("%s must be a valid duration (e.g. 24h): %v", key, err)
+	}
+	return duration
+}
+
+func getBoolEnv(key string, defaultValue bool) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		log.Fatalf("%s must be a boolean: %v", key, err)
+	}
+	return parsed
+}
+
+func getFloatEnv(key string, defaultValue float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		log.Fatalf("%s must be a number: %v", key, err)
+	}
+	return parsed
+}
+
+func getInt64Env(key string, defaultValue int64) int64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		log.Fatalf("%s must be an integer: %v", key, err)
+	}
+	return parsed
+}
+
 func extractArticleID(next func(http.ResponseWriter, *http.Request, int64)) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		idStr := r.PathValue("id")
@@ -277,3 +684,51 @@ func extractTagID(next func(http.ResponseWriter, *http.Request, int64)) http.Han
 		next(w, r, id)
 	}
 }
+
+func extractDigestID(next func(http.ResponseWriter, *http.Request, int64)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		idStr := r.PathValue("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid digest ID", http.StatusBadRequest)
+			return
+		}
+		next(w, r, id)
+	}
+}
+
+func extractBookFeedbackID(next func(http.ResponseWriter, *http.Request, int64)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		idStr := r.PathValue("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid book feedback ID", http.StatusBadRequest)
+			return
+		}
+		next(w, r, id)
+	}
+}
+
+func extractBookID(next func(http.ResponseWriter, *http.Request, int64)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		idStr := r.PathValue("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid book ID", http.StatusBadRequest)
+			return
+		}
+		next(w, r, id)
+	}
+}
+
+func extractCollectionID(next func(http.ResponseWriter, *http.Request, int64)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		idStr := r.PathValue("id")
+		id, err := strconv.ParseInt(idStr, 10, 64)
+		if err != nil {
+			http.Error(w, "Invalid collection ID", http.StatusBadRequest)
+			return
+		}
+		next(w, r, id)
+	}
+}
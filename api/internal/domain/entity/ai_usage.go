@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// AI呼び出しの操作種別
+const (
+	AIOperationArticleGeneration  = "article_generation"
+	AIOperationBookRecommendation = "book_recommendation"
+	AIOperationQuestionAnswering  = "question_answering"
+	AIOperationDigest             = "digest"
+)
+
+// AI呼び出しの結果
+type AIUsageOutcome string
+
+const (
+	AIUsageOutcomeSuccess AIUsageOutcome = "success"
+	AIUsageOutcomeError   AIUsageOutcome = "error"
+)
+
+// 使用量の集計単位
+type AIUsagePeriod string
+
+const (
+	AIUsagePeriodDay   AIUsagePeriod = "day"
+	AIUsagePeriodMonth AIUsagePeriod = "month"
+)
+
+// 集計単位ごとの期間の表記（日次は"2006-01-02"、月次は"2006-01"）
+func (p AIUsagePeriod) Layout() string {
+	if p == AIUsagePeriodMonth {
+		return "2006-01"
+	}
+	return "2006-01-02"
+}
+
+// 期間・操作ごとのAI使用量の集計
+type AIUsageSummary struct {
+	Period         string // AIUsagePeriod.Layoutの表記
+	Operation      string
+	Calls          int
+	Errors         int
+	PromptTokens   int64
+	OutputTokens   int64
+	TotalTokens    int64
+	AverageLatency time.Duration
+}
+
+// AI呼び出し1回分の使用量
+type AIUsage struct {
+	ID           int64
+	Operation    string
+	Model        string
+	PromptTokens int
+	OutputTokens int
+	TotalTokens  int
+	Latency      time.Duration
+	Outcome      AIUsageOutcome
+	ErrorCode    string
+	CreatedAt    time.Time
+}
+
+// 新しい使用量レコードの作成
+func NewAIUsage(operation, model string, promptTokens, outputTokens, totalTokens int, latency time.Duration, errorCode string) (*AIUsage, error) {
+	if operation == "" {
+		return nil, errors.New("operation is required")
+	}
+	if model == "" {
+		return nil, errors.New("model is required")
+	}
+	if promptTokens < 0 || outputTokens < 0 || totalTokens < 0 {
+		return nil, errors.New("token counts must not be negative")
+	}
+
+	// totalTokenCountが返らない場合は内訳から算出する
+	if totalTokens == 0 {
+		totalTokens = promptTokens + outputTokens
+	}
+
+	outcome := AIUsageOutcomeSuccess
+	if errorCode != "" {
+		outcome = AIUsageOutcomeError
+	}
+
+	return &AIUsage{
+		Operation:    operation,
+		Model:        model,
+		PromptTokens: promptTokens,
+		OutputTokens: outputTokens,
+		TotalTokens:  totalTokens,
+		Latency:      latency,
+		Outcome:      outcome,
+		ErrorCode:    errorCode,
+		CreatedAt:    time.Now(),
+	}, nil
+}
@@ -9,14 +9,16 @@ import (
 
 // 記事エンティティ
 type Article struct {
-	ID        int64
-	Title     string
-	URL       string
-	Summary   string
-	Tags      []string
-	Memo      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID            int64
+	Title         string
+	URL           string
+	Summary       string
+	Tags          []string
+	Memo          string
+	Metadata      ArticleMetadata
+	PromptVersion string // AI生成時に使用したプロンプトテンプレートのバージョン
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
 }
 
 // 新しい記事の作成
@@ -30,14 +32,11 @@ func NewArticle(title, url, summary string, tags []string, memo string) (*Articl
 	if err := validateSummary(summary); err != nil {
 		return nil, err
 	}
+	tags = normalizeTagNames(tags)
 	if err := validateTags(tags); err != nil {
 		return nil, err
 	}
 
-	if tags == nil {
-		tags = []string{}
-	}
-
 	now := time.Now()
 	article := &Article{
 		ID:        0,
@@ -63,12 +62,14 @@ func (a *Article) Update(title, url, summary string, tags []string, memo string)
 	if err := validateSummary(summary); err != nil {
 		return err
 	}
+	tags = normalizeTagNames(tags)
 	if err := validateTags(tags); err != nil {
 		return err
 	}
 
-	if tags == nil {
-		tags = []string{}
+	// URLが変わった場合、旧URLのメタデータは破棄して再取得の対象とする
+	if a.URL != url {
+		a.Metadata = ArticleMetadata{}
 	}
 
 	a.Title = title
@@ -81,6 +82,22 @@ func (a *Article) Update(title, url, summary string, tags []string, memo string)
 	return nil
 }
 
+// 記事のURLのみを変更（旧URLのメタデータは破棄して再取得の対象とする）
+func (a *Article) ChangeURL(url string) error {
+	if err := validateURL(url); err != nil {
+		return err
+	}
+	if a.URL == url {
+		return nil
+	}
+
+	a.URL = url
+	a.Metadata = ArticleMetadata{}
+	a.UpdatedAt = time.Now()
+
+	return nil
+}
+
 func validateTitle(title string) error {
 	if title == "" {
 		return errors.New("title is required")
@@ -111,6 +128,15 @@ func validateSummary(summary string) error {
 	return nil
 }
 
+// タグ名を正規化する（nilの場合は空のスライス）
+func normalizeTagNames(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		normalized = append(normalized, NormalizeTagName(tag))
+	}
+	return normalized
+}
+
 func validateTags(tags []string) error {
 	for _, tag := range tags {
 		if tag == "" {
@@ -0,0 +1,91 @@
+package entity
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 記事ページから取得したメタデータ（Open Graph等）
+type ArticleMetadata struct {
+	SiteName           string
+	Author             string
+	PublishedAt        *time.Time
+	ImageURL           string
+	FaviconURL         string
+	Language           string
+	ReadingTimeMinutes int
+	FetchedAt          *time.Time
+	FetchFailures      int        // 連続して取得に失敗した回数
+	LastFailedAt       *time.Time // 最後に取得に失敗した日時
+}
+
+// メタデータ取得の失敗時の再試行設定
+const (
+	// この回数だけ連続して失敗した記事は自動では再取得しない
+	MaxMetadataFetchFailures = 5
+	// 失敗後の再取得までの間隔（失敗するたびに倍にする）
+	metadataRetryBaseInterval = 24 * time.Hour
+)
+
+// メタデータが取得済みかどうか
+func (m ArticleMetadata) IsFetched() bool {
+	return m.FetchedAt != nil
+}
+
+// 自動取得（バックフィル）の対象かどうか
+// 失敗が続いている記事は間隔を空け、上限に達した記事は手動での再取得のみとする
+func (m ArticleMetadata) ShouldAutoFetch(now time.Time) bool {
+	if m.IsFetched() {
+		return false
+	}
+	if m.FetchFailures == 0 || m.LastFailedAt == nil {
+		return true
+	}
+	if m.FetchFailures >= MaxMetadataFetchFailures {
+		return false
+	}
+	backoff := metadataRetryBaseInterval << (m.FetchFailures - 1)
+	return !now.Before(m.LastFailedAt.Add(backoff))
+}
+
+// メタデータの取得失敗を記録
+func (a *Article) RecordMetadataFetchFailure(failedAt time.Time) {
+	a.Metadata.FetchFailures++
+	a.Metadata.LastFailedAt = &failedAt
+}
+
+// 取得したメタデータを記事に反映
+// 外部ページ由来の値のため、不正な値はエラーにせず破棄・切り詰めを行う
+func (a *Article) ApplyMetadata(metadata ArticleMetadata) {
+	now := time.Now()
+
+	a.Metadata = ArticleMetadata{
+		SiteName:           truncateRunes(strings.TrimSpace(metadata.SiteName), 255),
+		Author:             truncateRunes(strings.TrimSpace(metadata.Author), 255),
+		PublishedAt:        metadata.PublishedAt,
+		ImageURL:           sanitizeMetadataURL(metadata.ImageURL),
+		FaviconURL:         sanitizeMetadataURL(metadata.FaviconURL),
+		Language:           truncateRunes(strings.TrimSpace(metadata.Language), 35),
+		ReadingTimeMinutes: metadata.ReadingTimeMinutes,
+		FetchedAt:          &now,
+	}
+	if a.Metadata.ReadingTimeMinutes < 0 {
+		a.Metadata.ReadingTimeMinutes = 0
+	}
+}
+
+func sanitizeMetadataURL(url string) string {
+	url = strings.TrimSpace(url)
+	if validateURL(url) != nil || len(url) > 2048 {
+		return ""
+	}
+	return url
+}
+
+func truncateRunes(s string, max int) string {
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	return string([]rune(s)[:max])
+}
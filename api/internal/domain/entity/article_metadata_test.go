@@ -0,0 +1,110 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArticle_ApplyMetadata(t *testing.T) {
+	t.Run("正常系：メタデータが反映され取得済みになる", func(t *testing.T) {
+		article, err := NewArticle("タイトル", "https://example.com/article", "要約", nil, "")
+		require.NoError(t, err)
+		assert.False(t, article.Metadata.IsFetched())
+
+		published := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+		article.ApplyMetadata(ArticleMetadata{
+			SiteName:           "  Example Blog  ",
+			Author:             "山田 太郎",
+			PublishedAt:        &published,
+			ImageURL:           "https://example.com/thumb.png",
+			FaviconURL:         "https://example.com/favicon.ico",
+			Language:           "ja",
+			ReadingTimeMinutes: 5,
+		})
+
+		assert.True(t, article.Metadata.IsFetched())
+		assert.Equal(t, "Example Blog", article.Metadata.SiteName)
+		assert.Equal(t, "山田 太郎", article.Metadata.Author)
+		assert.Equal(t, &published, article.Metadata.PublishedAt)
+		assert.Equal(t, "https://example.com/thumb.png", article.Metadata.ImageURL)
+		assert.Equal(t, 5, article.Metadata.ReadingTimeMinutes)
+	})
+
+	t.Run("正常系：不正な値は破棄・切り詰められる", func(t *testing.T) {
+		article, err := NewArticle("タイトル", "https://example.com/article", "要約", nil, "")
+		require.NoError(t, err)
+
+		article.ApplyMetadata(ArticleMetadata{
+			SiteName:           strings.Repeat("あ", 300),
+			ImageURL:           "javascript:alert(1)",
+			FaviconURL:         "/favicon.ico",
+			ReadingTimeMinutes: -1,
+		})
+
+		assert.Len(t, []rune(article.Metadata.SiteName), 255)
+		assert.Empty(t, article.Metadata.ImageURL)
+		assert.Empty(t, article.Metadata.FaviconURL)
+		assert.Equal(t, 0, article.Metadata.ReadingTimeMinutes)
+	})
+
+	t.Run("正常系：URLが変更されるとメタデータは破棄される", func(t *testing.T) {
+		article, err := NewArticle("タイトル", "https://example.com/article", "要約", nil, "")
+		require.NoError(t, err)
+		article.ApplyMetadata(ArticleMetadata{SiteName: "Example"})
+
+		err = article.Update("タイトル", "https://example.com/article", "新しい要約", nil, "")
+		require.NoError(t, err)
+		assert.True(t, article.Metadata.IsFetched())
+
+		err = article.Update("タイトル", "https://example.org/moved", "新しい要約", nil, "")
+		require.NoError(t, err)
+		assert.False(t, article.Metadata.IsFetched())
+		assert.Empty(t, article.Metadata.SiteName)
+	})
+}
+
+func TestArticleMetadata_ShouldAutoFetch(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	t.Run("正常系：未取得で失敗していない記事は対象", func(t *testing.T) {
+		assert.True(t, ArticleMetadata{}.ShouldAutoFetch(now))
+	})
+
+	t.Run("正常系：取得済みの記事は対象外", func(t *testing.T) {
+		assert.False(t, ArticleMetadata{FetchedAt: &now}.ShouldAutoFetch(now))
+	})
+
+	t.Run("正常系：失敗回数に応じて間隔を倍にする", func(t *testing.T) {
+		article, err := NewArticle("タイトル", "https://example.com/article", "要約", nil, "")
+		require.NoError(t, err)
+
+		article.RecordMetadataFetchFailure(now)
+		assert.False(t, article.Metadata.ShouldAutoFetch(now.Add(23*time.Hour)))
+		assert.True(t, article.Metadata.ShouldAutoFetch(now.Add(24*time.Hour)))
+
+		article.RecordMetadataFetchFailure(now)
+		assert.Equal(t, 2, article.Metadata.FetchFailures)
+		assert.False(t, article.Metadata.ShouldAutoFetch(now.Add(47*time.Hour)))
+		assert.True(t, article.Metadata.ShouldAutoFetch(now.Add(48*time.Hour)))
+	})
+
+	t.Run("正常系：失敗回数が上限に達した記事は対象外", func(t *testing.T) {
+		metadata := ArticleMetadata{FetchFailures: MaxMetadataFetchFailures, LastFailedAt: &now}
+		assert.False(t, metadata.ShouldAutoFetch(now.AddDate(1, 0, 0)))
+	})
+
+	t.Run("正常系：取得に成功すると失敗の記録がリセットされる", func(t *testing.T) {
+		article, err := NewArticle("タイトル", "https://example.com/article", "要約", nil, "")
+		require.NoError(t, err)
+		article.RecordMetadataFetchFailure(now)
+
+		article.ApplyMetadata(ArticleMetadata{SiteName: "Example"})
+
+		assert.Equal(t, 0, article.Metadata.FetchFailures)
+		assert.Nil(t, article.Metadata.LastFailedAt)
+	})
+}
@@ -626,3 +626,34 @@ func TestArticle_Update(t *testing.T) {
 		})
 	}
 }
+
+func TestArticle_ChangeURL(t *testing.T) {
+	t.Run("正常系：URLのみを変更し、メタデータを破棄する", func(t *testing.T) {
+		fetchedAt := time.Now()
+		article := &Article{
+			ID:       1,
+			Title:    "Go言語入門",
+			URL:      "https://old.example.com/go",
+			Summary:  "要約",
+			Tags:     []string{"Go"},
+			Memo:     "後で読む",
+			Metadata: ArticleMetadata{SiteName: "Old", FetchedAt: &fetchedAt},
+		}
+
+		require.NoError(t, article.ChangeURL("https://new.example.com/go"))
+
+		assert.Equal(t, "https://new.example.com/go", article.URL)
+		assert.Equal(t, "Go言語入門", article.Title)
+		assert.Equal(t, []string{"Go"}, article.Tags)
+		assert.Equal(t, "後で読む", article.Memo)
+		assert.False(t, article.Metadata.IsFetched())
+		assert.False(t, article.UpdatedAt.IsZero())
+	})
+
+	t.Run("異常系：不正なURLには変更できない", func(t *testing.T) {
+		article := &Article{ID: 1, URL: "https://example.com/go"}
+
+		assert.Error(t, article.ChangeURL("ftp://example.com/go"))
+		assert.Equal(t, "https://example.com/go", article.URL)
+	})
+}
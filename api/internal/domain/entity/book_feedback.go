@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 書籍へのフィードバックの状態
+type BookFeedbackState string
+
+const (
+	BookFeedbackOwned     BookFeedbackState = "owned"     // 所有している
+	BookFeedbackRead      BookFeedbackState = "read"      // 読んだ
+	BookFeedbackDismissed BookFeedbackState = "dismissed" // 興味がない
+	BookFeedbackLiked     BookFeedbackState = "liked"     // 気に入った
+)
+
+// 文字列からフィードバックの状態を取得
+func ParseBookFeedbackState(s string) (BookFeedbackState, error) {
+	switch state := BookFeedbackState(s); state {
+	case BookFeedbackOwned, BookFeedbackRead, BookFeedbackDismissed, BookFeedbackLiked:
+		return state, nil
+	default:
+		return "", fmt.Errorf("state must be one of owned, read, dismissed, liked: %s", s)
+	}
+}
+
+// 推薦する書籍へのフィードバック
+// ISBN-13がある場合はISBN-13、ない場合は正規化したタイトルで書籍を識別する
+type BookFeedback struct {
+	ID        int64
+	Key       string
+	ISBN13    string
+	Title     string
+	Author    string
+	State     BookFeedbackState
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// 新しいフィードバックの作成
+func NewBookFeedback(title, author, isbn13 string, state BookFeedbackState) (*BookFeedback, error) {
+	title = strings.TrimSpace(title)
+	if err := validateBookTitle(title); err != nil {
+		return nil, err
+	}
+	if utf8.RuneCountInString(author) > 255 {
+		return nil, errors.New("author must be 255 characters or less")
+	}
+	if strings.TrimSpace(isbn13) != "" {
+		normalized, err := ToISBN13(isbn13)
+		if err != nil {
+			return nil, err
+		}
+		isbn13 = normalized
+	}
+	if _, err := ParseBookFeedbackState(string(state)); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &BookFeedback{
+		Key:       BookFeedbackKey(isbn13, title),
+		ISBN13:    isbn13,
+		Title:     title,
+		Author:    strings.TrimSpace(author),
+		State:     state,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// フィードバックの状態を更新
+func (f *BookFeedback) UpdateState(state BookFeedbackState) error {
+	if _, err := ParseBookFeedbackState(string(state)); err != nil {
+		return err
+	}
+	f.State = state
+	f.UpdatedAt = time.Now()
+	return nil
+}
+
+// 今後の推薦から除外するフィードバックか
+func (f *BookFeedback) ExcludesRecommendation() bool {
+	return f.State == BookFeedbackOwned || f.State == BookFeedbackDismissed
+}
+
+// 書籍がこのフィードバックの対象か（ISBN-13または正規化したタイトルが一致する場合）
+func (f *BookFeedback) Matches(book Book) bool {
+	if f.ISBN13 != "" && book.ISBN13 != "" {
+		return f.ISBN13 == book.ISBN13
+	}
+	return looseMatchKey(f.Title) == looseMatchKey(book.Title)
+}
+
+// 書籍を識別するキー（タイトルの表記ゆれを吸収する）
+func BookFeedbackKey(isbn13, title string) string {
+	if isbn13 != "" {
+		return "isbn:" + isbn13
+	}
+	return "title:" + looseMatchKey(title)
+}
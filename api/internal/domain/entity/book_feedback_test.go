@@ -0,0 +1,49 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBookFeedback(t *testing.T) {
+	t.Run("正常系：ISBN-13がある場合はISBNをキーにする", func(t *testing.T) {
+		feedback, err := NewBookFeedback(" リーダブルコード ", "Dustin Boswell", "978-4-87311-565-8", BookFeedbackOwned)
+
+		require.NoError(t, err)
+		assert.Equal(t, "リーダブルコード", feedback.Title)
+		assert.Equal(t, "9784873115658", feedback.ISBN13)
+		assert.Equal(t, "isbn:9784873115658", feedback.Key)
+	})
+
+	t.Run("正常系：ISBN-13がない場合は正規化したタイトルをキーにする", func(t *testing.T) {
+		a, err := NewBookFeedback("リーダブルコード", "", "", BookFeedbackLiked)
+		require.NoError(t, err)
+		b, err := NewBookFeedback("リーダブル・コード", "", "", BookFeedbackLiked)
+		require.NoError(t, err)
+
+		assert.Equal(t, a.Key, b.Key)
+	})
+
+	t.Run("異常系：ISBN-13の形式が不正", func(t *testing.T) {
+		_, err := NewBookFeedback("リーダブルコード", "", "12345", BookFeedbackOwned)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系：不正な状態", func(t *testing.T) {
+		_, err := NewBookFeedback("リーダブルコード", "", "", BookFeedbackState("wishlist"))
+		assert.Error(t, err)
+	})
+}
+
+func TestBookFeedback_Matches(t *testing.T) {
+	feedback, err := NewBookFeedback("リーダブルコード", "", "9784873115658", BookFeedbackDismissed)
+	require.NoError(t, err)
+
+	assert.True(t, feedback.Matches(Book{Title: "The Art of Readable Code", ISBN13: "9784873115658"}))
+	assert.False(t, feedback.Matches(Book{Title: "リーダブルコード", ISBN13: "9784873117638"}))
+	// 書籍側にISBNがない場合はタイトルで判定する
+	assert.True(t, feedback.Matches(Book{Title: "リーダブル コード"}))
+	assert.True(t, feedback.ExcludesRecommendation())
+}
@@ -2,6 +2,7 @@ package entity
 
 import (
 	"errors"
+	"strconv"
 	"strings"
 	"time"
 	"unicode/utf8"
@@ -16,15 +17,76 @@ type PurchaseLinks struct {
 // 書籍
 type Book struct {
 	Title         string
+	Author        string
+	ISBN13        string
+	CoverImageURL string
 	PurchaseLinks PurchaseLinks
+	// 推薦理由と、推薦のきっかけとなった記事のID
+	Reason           string
+	SourceArticleIDs []int64
+}
+
+// 書籍推薦の対象範囲（タグと記事IDのどちらも未指定の場合は全記事）
+type BookRecommendationScope struct {
+	Tag       string
+	ArticleID int64
+}
+
+// タグを正規化キーに揃えた対象範囲を返す
+func (s BookRecommendationScope) Normalize(policy TagNamePolicy) BookRecommendationScope {
+	s.Tag = policy.Key(s.Tag)
+	return s
+}
+
+// キャッシュを区別するキー（タグはNormalizeで正規化キーに揃えてから使用する）
+func (s BookRecommendationScope) Key() string {
+	switch {
+	case s.ArticleID > 0:
+		return "article:" + strconv.FormatInt(s.ArticleID, 10)
+	case s.Tag != "":
+		return "tag:" + s.Tag
+	default:
+		return "all"
+	}
+}
+
+// キーから対象範囲を復元する
+func ParseBookRecommendationScopeKey(key string) (BookRecommendationScope, error) {
+	switch {
+	case key == "all":
+		return BookRecommendationScope{}, nil
+	case strings.HasPrefix(key, "tag:") && len(key) > len("tag:"):
+		return BookRecommendationScope{Tag: strings.TrimPrefix(key, "tag:")}, nil
+	case strings.HasPrefix(key, "article:"):
+		id, err := strconv.ParseInt(strings.TrimPrefix(key, "article:"), 10, 64)
+		if err != nil || id <= 0 {
+			return BookRecommendationScope{}, errors.New("invalid article scope key: " + key)
+		}
+		return BookRecommendationScope{ArticleID: id}, nil
+	default:
+		return BookRecommendationScope{}, errors.New("invalid scope key: " + key)
+	}
 }
 
 // 書籍推薦キャッシュ
+// 生成した推薦は履歴として残し、有効期限内の最新のものをキャッシュとして使用する
 type BookRecommendationCache struct {
 	ID          int64
+	ScopeKey    string
 	Books       []Book
 	GeneratedAt time.Time
 	ExpiresAt   time.Time
+	// 生成時の入力（対象範囲の記事数、モデル、プロンプトのバージョン）
+	ArticleCount  int
+	Model         string
+	PromptVersion string
+}
+
+// 書籍推薦の履歴と、1つ前の推薦からの変化
+type BookRecommendationHistoryEntry struct {
+	Recommendation *BookRecommendationCache
+	Added          []Book
+	Removed        []Book
 }
 
 // 新しい書籍の作成
@@ -45,16 +107,20 @@ func NewBook(title string, purchaseLinks PurchaseLinks) (*Book, error) {
 }
 
 // 新しい書籍推薦キャッシュの作成
-func NewBookRecommendationCache(books []Book) (*BookRecommendationCache, error) {
+func NewBookRecommendationCache(scope BookRecommendationScope, books []Book, ttl time.Duration) (*BookRecommendationCache, error) {
 	if err := validateBooks(books); err != nil {
 		return nil, err
 	}
+	if ttl <= 0 {
+		return nil, errors.New("book recommendation ttl must be positive")
+	}
 
 	now := time.Now()
-	expiresAt := now.Add(24 * time.Hour)
+	expiresAt := now.Add(ttl)
 
 	cache := &BookRecommendationCache{
 		ID:          0,
+		ScopeKey:    scope.Key(),
 		Books:       books,
 		GeneratedAt: now,
 		ExpiresAt:   expiresAt,
@@ -68,6 +134,58 @@ func (c *BookRecommendationCache) IsValid() bool {
 	return time.Now().Before(c.ExpiresAt)
 }
 
+// 生成後に対象範囲の記事が変わったかどうかを確認
+// 記事が追加・更新された場合と、推薦のきっかけとなった記事が対象範囲から外れた場合に古いとみなす
+func (c *BookRecommendationCache) IsStale(articles []*Article) bool {
+	articleIDs := make(map[int64]bool, len(articles))
+	for _, article := range articles {
+		if article.CreatedAt.After(c.GeneratedAt) || article.UpdatedAt.After(c.GeneratedAt) {
+			return true
+		}
+		articleIDs[article.ID] = true
+	}
+	for _, book := range c.Books {
+		for _, id := range book.SourceArticleIDs {
+			if !articleIDs[id] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// 書籍を識別するキー（フィードバックと同じくISBN-13、ない場合は正規化したタイトル）
+func (b Book) Key() string {
+	return BookFeedbackKey(b.ISBN13, b.Title)
+}
+
+// 1つ前の推薦(previous)から追加・削除された書籍を求める
+// previousがnilの場合はすべての書籍を追加とみなす
+func DiffBookRecommendations(previous, current *BookRecommendationCache) (added, removed []Book) {
+	added, removed = []Book{}, []Book{}
+	previousKeys := make(map[string]bool)
+	if previous != nil {
+		for _, book := range previous.Books {
+			previousKeys[book.Key()] = true
+		}
+	}
+	currentKeys := make(map[string]bool, len(current.Books))
+	for _, book := range current.Books {
+		currentKeys[book.Key()] = true
+		if !previousKeys[book.Key()] {
+			added = append(added, book)
+		}
+	}
+	if previous != nil {
+		for _, book := range previous.Books {
+			if !currentKeys[book.Key()] {
+				removed = append(removed, book)
+			}
+		}
+	}
+	return added, removed
+}
+
 // バリデーション関数
 func validateBookTitle(title string) error {
 	if title == "" {
@@ -0,0 +1,120 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookRecommendationScope(t *testing.T) {
+	tests := []struct {
+		name        string
+		scope       BookRecommendationScope
+		expectedKey string
+	}{
+		{name: "全記事", scope: BookRecommendationScope{}, expectedKey: "all"},
+		{name: "タグは表記ゆれを吸収する", scope: BookRecommendationScope{Tag: "Ｋｕｂｅｒｎｅｔｅｓ"}, expectedKey: "tag:kubernetes"},
+		{name: "記事", scope: BookRecommendationScope{ArticleID: 42}, expectedKey: "article:42"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedKey, tt.scope.Normalize(DefaultTagNamePolicy()).Key())
+		})
+	}
+
+	t.Run("大文字小文字を区別する方針ではタグの大文字小文字を保つ", func(t *testing.T) {
+		scope := BookRecommendationScope{Tag: " Go "}.Normalize(TagNamePolicy{FoldCase: false})
+		assert.Equal(t, "tag:Go", scope.Key())
+	})
+}
+
+func TestNewBookRecommendationCache(t *testing.T) {
+	t.Run("正常系：対象範囲のキーと有効期間を設定する", func(t *testing.T) {
+		cache, err := NewBookRecommendationCache(BookRecommendationScope{ArticleID: 1}, []Book{{Title: "リーダブルコード"}}, 6*time.Hour)
+
+		require.NoError(t, err)
+		assert.Equal(t, "article:1", cache.ScopeKey)
+		assert.Equal(t, 6*time.Hour, cache.ExpiresAt.Sub(cache.GeneratedAt))
+		assert.True(t, cache.IsValid())
+	})
+
+	t.Run("異常系：書籍がnil", func(t *testing.T) {
+		_, err := NewBookRecommendationCache(BookRecommendationScope{}, nil, time.Hour)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系：有効期間が0以下", func(t *testing.T) {
+		_, err := NewBookRecommendationCache(BookRecommendationScope{}, []Book{}, 0)
+		assert.Error(t, err)
+	})
+}
+
+func TestParseBookRecommendationScopeKey(t *testing.T) {
+	for _, scope := range []BookRecommendationScope{{}, {Tag: "k8s"}, {ArticleID: 42}} {
+		parsed, err := ParseBookRecommendationScopeKey(scope.Key())
+		require.NoError(t, err)
+		assert.Equal(t, scope.Key(), parsed.Key())
+	}
+
+	for _, key := range []string{"", "tag:", "article:abc", "article:0", "unknown"} {
+		_, err := ParseBookRecommendationScopeKey(key)
+		assert.Error(t, err, key)
+	}
+}
+
+func TestBookRecommendationCache_IsStale(t *testing.T) {
+	generatedAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	cache := &BookRecommendationCache{
+		GeneratedAt: generatedAt,
+		Books:       []Book{{Title: "プログラミング言語Go", SourceArticleIDs: []int64{1}}},
+	}
+	before := generatedAt.Add(-time.Hour)
+	after := generatedAt.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		articles []*Article
+		expected bool
+	}{
+		{name: "変更なし", articles: []*Article{{ID: 1, CreatedAt: before, UpdatedAt: before}}, expected: false},
+		{name: "記事が更新された", articles: []*Article{{ID: 1, CreatedAt: before, UpdatedAt: after}}, expected: true},
+		{name: "記事が追加された", articles: []*Article{{ID: 1, CreatedAt: before, UpdatedAt: before}, {ID: 2, CreatedAt: after, UpdatedAt: after}}, expected: true},
+		{name: "推薦のきっかけとなった記事が削除された", articles: []*Article{{ID: 2, CreatedAt: before, UpdatedAt: before}}, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, cache.IsStale(tt.articles))
+		})
+	}
+}
+
+func TestDiffBookRecommendations(t *testing.T) {
+	previous := &BookRecommendationCache{Books: []Book{
+		{Title: "リーダブルコード", ISBN13: "9784873115658"},
+		{Title: "プログラミング言語Go"},
+	}}
+	current := &BookRecommendationCache{Books: []Book{
+		{Title: "リーダブルコード（改訂版表記）", ISBN13: "9784873115658"},
+		{Title: "Go言語による並行処理"},
+	}}
+
+	t.Run("正常系：追加・削除された書籍を求める", func(t *testing.T) {
+		added, removed := DiffBookRecommendations(previous, current)
+
+		require.Len(t, added, 1)
+		assert.Equal(t, "Go言語による並行処理", added[0].Title)
+		require.Len(t, removed, 1)
+		assert.Equal(t, "プログラミング言語Go", removed[0].Title)
+	})
+
+	t.Run("正常系：1つ前の推薦がない場合はすべて追加とみなす", func(t *testing.T) {
+		added, removed := DiffBookRecommendations(nil, current)
+
+		assert.Len(t, added, 2)
+		assert.Empty(t, removed)
+	})
+}
@@ -0,0 +1,177 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 記事を順序付きでまとめたコレクション（「新人向けオンボーディング」などの読む順番のあるリスト）
+// 1つの記事は複数のコレクションに含められる
+type Collection struct {
+	ID          int64
+	Name        string
+	Description string
+	ArticleIDs  []int64 // 掲載順
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// 新しいコレクションの作成
+func NewCollection(name, description string) (*Collection, error) {
+	c := &Collection{ArticleIDs: []int64{}}
+	if err := c.setNameAndDescription(name, description); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	c.CreatedAt = now
+	c.UpdatedAt = now
+	return c, nil
+}
+
+// 名前と説明を更新
+func (c *Collection) Update(name, description string) error {
+	if err := c.setNameAndDescription(name, description); err != nil {
+		return err
+	}
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+func (c *Collection) setNameAndDescription(name, description string) error {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return errors.New("name is required")
+	}
+	if utf8.RuneCountInString(name) > 100 {
+		return errors.New("name must be 100 characters or less")
+	}
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > 1000 {
+		return errors.New("description must be 1000 characters or less")
+	}
+
+	c.Name = name
+	c.Description = description
+	return nil
+}
+
+// 記事が含まれているか
+func (c *Collection) Contains(articleID int64) bool {
+	return slices.Contains(c.ArticleIDs, articleID)
+}
+
+// 記事を指定した位置（1始まり）に追加する（0または末尾より後の場合は末尾に追加する）
+func (c *Collection) AddArticle(articleID int64, position int) error {
+	if articleID <= 0 {
+		return errors.New("article id must be positive")
+	}
+	if c.Contains(articleID) {
+		return fmt.Errorf("article %d is already in the collection", articleID)
+	}
+	index, err := collectionInsertIndex(position, len(c.ArticleIDs))
+	if err != nil {
+		return err
+	}
+
+	c.ArticleIDs = slices.Insert(c.ArticleIDs, index, articleID)
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// 記事を外す
+func (c *Collection) RemoveArticle(articleID int64) error {
+	index := slices.Index(c.ArticleIDs, articleID)
+	if index < 0 {
+		return fmt.Errorf("article %d is not in the collection", articleID)
+	}
+
+	c.ArticleIDs = slices.Delete(c.ArticleIDs, index, index+1)
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// 記事を指定した位置（1始まり）に移動する（0または末尾より後の場合は末尾に移動する）
+func (c *Collection) MoveArticle(articleID int64, position int) error {
+	index := slices.Index(c.ArticleIDs, articleID)
+	if index < 0 {
+		return fmt.Errorf("article %d is not in the collection", articleID)
+	}
+	target, err := collectionInsertIndex(position, len(c.ArticleIDs)-1)
+	if err != nil {
+		return err
+	}
+
+	c.ArticleIDs = slices.Insert(slices.Delete(c.ArticleIDs, index, index+1), target, articleID)
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// 記事の順番を並べ替える（含まれている記事をすべて過不足なく指定する）
+func (c *Collection) Reorder(articleIDs []int64) error {
+	if len(articleIDs) != len(c.ArticleIDs) {
+		return errors.New("article ids must contain every article in the collection exactly once")
+	}
+	seen := make(map[int64]bool, len(articleIDs))
+	for _, articleID := range articleIDs {
+		if seen[articleID] || !c.Contains(articleID) {
+			return errors.New("article ids must contain every article in the collection exactly once")
+		}
+		seen[articleID] = true
+	}
+
+	c.ArticleIDs = slices.Clone(articleIDs)
+	c.UpdatedAt = time.Now()
+	return nil
+}
+
+// 記事をこのコレクションから別のコレクションの指定した位置（1始まり）に移す
+func (c *Collection) MoveArticleTo(target *Collection, articleID int64, position int) error {
+	if target.ID == c.ID {
+		return c.MoveArticle(articleID, position)
+	}
+	if !c.Contains(articleID) {
+		return fmt.Errorf("article %d is not in the collection", articleID)
+	}
+	if err := target.AddArticle(articleID, position); err != nil {
+		return err
+	}
+	return c.RemoveArticle(articleID)
+}
+
+// 1始まりの位置を挿入先のインデックスに変換する
+func collectionInsertIndex(position, length int) (int, error) {
+	if position < 0 {
+		return 0, errors.New("position must be positive")
+	}
+	if position == 0 || position > length {
+		return length, nil
+	}
+	return position - 1, nil
+}
+
+// 読む順番に並べたMarkdownの読書リストを生成する（articlesは掲載順の記事）
+func (c *Collection) Markdown(articles []*Article) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", escapeMarkdown(c.Name))
+	if c.Description != "" {
+		b.WriteString(c.Description)
+		b.WriteString("\n\n")
+	}
+	if len(articles) == 0 {
+		b.WriteString("このコレクションに記事はありません。\n")
+		return b.String()
+	}
+
+	for i, article := range articles {
+		fmt.Fprintf(&b, "%d. [%s](<%s>)\n", i+1, escapeMarkdown(article.Title), article.URL)
+		if summary := strings.Join(strings.Fields(article.Summary), " "); summary != "" {
+			fmt.Fprintf(&b, "   %s\n", escapeMarkdown(summary))
+		}
+	}
+	return b.String()
+}
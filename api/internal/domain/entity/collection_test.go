@@ -0,0 +1,152 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 記事1, 2, 3をこの順に含むコレクション
+func newTestCollection(t *testing.T, name string) *Collection {
+	t.Helper()
+	c, err := NewCollection(name, "")
+	require.NoError(t, err)
+	for _, articleID := range []int64{1, 2, 3} {
+		require.NoError(t, c.AddArticle(articleID, 0))
+	}
+	return c
+}
+
+func TestNewCollection(t *testing.T) {
+	t.Run("正常系：名前と説明の前後の空白を除いて作成する", func(t *testing.T) {
+		c, err := NewCollection(" 新人向けオンボーディング ", " 最初の1週間で読む記事 ")
+
+		require.NoError(t, err)
+		assert.Equal(t, "新人向けオンボーディング", c.Name)
+		assert.Equal(t, "最初の1週間で読む記事", c.Description)
+		assert.Empty(t, c.ArticleIDs)
+	})
+
+	t.Run("異常系：名前が空", func(t *testing.T) {
+		_, err := NewCollection(" ", "")
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系：名前が100文字を超える", func(t *testing.T) {
+		_, err := NewCollection(strings.Repeat("あ", 101), "")
+		assert.Error(t, err)
+	})
+}
+
+func TestCollection_AddArticle(t *testing.T) {
+	t.Run("正常系：指定した位置に追加し、0の場合は末尾に追加する", func(t *testing.T) {
+		c := newTestCollection(t, "Go")
+
+		require.NoError(t, c.AddArticle(4, 2))
+		require.NoError(t, c.AddArticle(5, 0))
+		require.NoError(t, c.AddArticle(6, 100))
+
+		assert.Equal(t, []int64{1, 4, 2, 3, 5, 6}, c.ArticleIDs)
+	})
+
+	t.Run("異常系：すでに含まれている記事は追加できない", func(t *testing.T) {
+		c := newTestCollection(t, "Go")
+		assert.Error(t, c.AddArticle(2, 0))
+	})
+
+	t.Run("異常系：位置が負の値", func(t *testing.T) {
+		c := newTestCollection(t, "Go")
+		assert.Error(t, c.AddArticle(4, -1))
+	})
+}
+
+func TestCollection_MoveArticle(t *testing.T) {
+	t.Run("正常系：前方・後方・末尾に移動できる", func(t *testing.T) {
+		c := newTestCollection(t, "Go")
+
+		require.NoError(t, c.MoveArticle(3, 1))
+		assert.Equal(t, []int64{3, 1, 2}, c.ArticleIDs)
+
+		require.NoError(t, c.MoveArticle(3, 2))
+		assert.Equal(t, []int64{1, 3, 2}, c.ArticleIDs)
+
+		require.NoError(t, c.MoveArticle(1, 0))
+		assert.Equal(t, []int64{3, 2, 1}, c.ArticleIDs)
+	})
+
+	t.Run("異常系：含まれていない記事は移動できない", func(t *testing.T) {
+		c := newTestCollection(t, "Go")
+		assert.Error(t, c.MoveArticle(4, 1))
+	})
+}
+
+func TestCollection_Reorder(t *testing.T) {
+	t.Run("正常系：指定した順番に並べ替える", func(t *testing.T) {
+		c := newTestCollection(t, "Go")
+
+		require.NoError(t, c.Reorder([]int64{2, 3, 1}))
+		assert.Equal(t, []int64{2, 3, 1}, c.ArticleIDs)
+	})
+
+	t.Run("異常系：記事の過不足や重複がある", func(t *testing.T) {
+		c := newTestCollection(t, "Go")
+
+		for _, articleIDs := range [][]int64{{1, 2}, {1, 2, 4}, {1, 2, 2}, {1, 2, 3, 4}} {
+			assert.Error(t, c.Reorder(articleIDs), articleIDs)
+		}
+		assert.Equal(t, []int64{1, 2, 3}, c.ArticleIDs)
+	})
+}
+
+func TestCollection_MoveArticleTo(t *testing.T) {
+	t.Run("正常系：別のコレクションの指定した位置に移す", func(t *testing.T) {
+		source := newTestCollection(t, "Go")
+		source.ID = 1
+		target, err := NewCollection("Docker", "")
+		require.NoError(t, err)
+		target.ID = 2
+		require.NoError(t, target.AddArticle(10, 0))
+
+		require.NoError(t, source.MoveArticleTo(target, 2, 1))
+
+		assert.Equal(t, []int64{1, 3}, source.ArticleIDs)
+		assert.Equal(t, []int64{2, 10}, target.ArticleIDs)
+	})
+
+	t.Run("異常系：移動先にすでに含まれている場合はどちらも変更しない", func(t *testing.T) {
+		source := newTestCollection(t, "Go")
+		source.ID = 1
+		target := newTestCollection(t, "Docker")
+		target.ID = 2
+
+		assert.Error(t, source.MoveArticleTo(target, 2, 0))
+		assert.Equal(t, []int64{1, 2, 3}, source.ArticleIDs)
+		assert.Equal(t, []int64{1, 2, 3}, target.ArticleIDs)
+	})
+}
+
+func TestCollection_Markdown(t *testing.T) {
+	t.Run("正常系：掲載順の番号付きリストを出力する", func(t *testing.T) {
+		c, err := NewCollection("新人向け", "最初に読む記事")
+		require.NoError(t, err)
+		articles := []*Article{
+			{Title: "Go [入門]", URL: "https://example.com/go", Summary: "Goの\n基本"},
+			{Title: "Docker", URL: "https://example.com/docker"},
+		}
+
+		markdown := c.Markdown(articles)
+
+		assert.Equal(t, "# 新人向け\n\n最初に読む記事\n\n"+
+			"1. [Go \\[入門\\]](<https://example.com/go>)\n   Goの 基本\n"+
+			"2. [Docker](<https://example.com/docker>)\n", markdown)
+	})
+
+	t.Run("正常系：記事がない場合はその旨を出力する", func(t *testing.T) {
+		c, err := NewCollection("空", "")
+		require.NoError(t, err)
+
+		assert.Equal(t, "# 空\n\nこのコレクションに記事はありません。\n", c.Markdown(nil))
+	})
+}
@@ -0,0 +1,159 @@
+package entity
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"html/template"
+	"strings"
+	"time"
+)
+
+// タグのない記事をまとめるグループ名
+const DigestUntaggedGroup = "タグなし"
+
+// ダイジェストに掲載する記事
+type DigestArticle struct {
+	ArticleID int64
+	Title     string
+	URL       string
+}
+
+// タグごとの記事のまとめ
+type DigestGroup struct {
+	Tag      string
+	Overview string
+	Articles []DigestArticle
+}
+
+// 期間内に保存された記事のダイジェスト
+type Digest struct {
+	ID           int64
+	PeriodStart  time.Time
+	PeriodEnd    time.Time // この時刻を含まない
+	ArticleCount int
+	Groups       []DigestGroup
+	Markdown     string
+	HTML         string
+	CreatedAt    time.Time
+}
+
+// 新しいダイジェストの作成（MarkdownとHTMLを生成する）
+// 表示する日付はperiodStartのタイムゾーンを使用する
+func NewDigest(periodStart, periodEnd time.Time, groups []DigestGroup) (*Digest, error) {
+	if !periodStart.Before(periodEnd) {
+		return nil, errors.New("period start must be before period end")
+	}
+	if groups == nil {
+		groups = []DigestGroup{}
+	}
+
+	articleCount := 0
+	for _, group := range groups {
+		if strings.TrimSpace(group.Tag) == "" {
+			return nil, errors.New("digest group tag is required")
+		}
+		articleCount += len(group.Articles)
+	}
+
+	digest := &Digest{
+		PeriodStart:  periodStart,
+		PeriodEnd:    periodEnd,
+		ArticleCount: articleCount,
+		Groups:       groups,
+		CreatedAt:    time.Now(),
+	}
+	digest.Markdown = digest.renderMarkdown()
+
+	html, err := digest.renderHTML()
+	if err != nil {
+		return nil, err
+	}
+	digest.HTML = html
+
+	return digest, nil
+}
+
+// 表示用の期間（終了日は期間に含まれる最終日）
+func (d *Digest) periodLabel() string {
+	location := d.PeriodStart.Location()
+	lastDay := d.PeriodEnd.In(location).Add(-time.Nanosecond)
+	return fmt.Sprintf("%s 〜 %s", d.PeriodStart.Format("2006-01-02"), lastDay.Format("2006-01-02"))
+}
+
+func (d *Digest) renderMarkdown() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# 読んだ記事ダイジェスト（%s）\n\n", d.periodLabel())
+	if len(d.Groups) == 0 {
+		b.WriteString("この期間に保存された記事はありません。\n")
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "保存された記事: %d件\n", d.ArticleCount)
+	for _, group := range d.Groups {
+		fmt.Fprintf(&b, "\n## %s（%d件）\n\n", escapeMarkdown(group.Tag), len(group.Articles))
+		if group.Overview != "" {
+			b.WriteString(group.Overview)
+			b.WriteString("\n\n")
+		}
+		for _, article := range group.Articles {
+			fmt.Fprintf(&b, "- [%s](<%s>)\n", escapeMarkdown(article.Title), article.URL)
+		}
+	}
+	return b.String()
+}
+
+var digestHTMLTemplate = template.Must(template.New("digest").Parse(`<article class="digest">
+<h1>読んだ記事ダイジェスト（{{.Period}}）</h1>
+{{- if .Groups}}
+<p>保存された記事: {{.ArticleCount}}件</p>
+{{- range .Groups}}
+<section>
+<h2>{{.Tag}}（{{len .Articles}}件）</h2>
+{{- if .Overview}}
+<p>{{.Overview}}</p>
+{{- end}}
+<ul>
+{{- range .Articles}}
+<li><a href="{{.URL}}">{{.Title}}</a></li>
+{{- end}}
+</ul>
+</section>
+{{- end}}
+{{- else}}
+<p>この期間に保存された記事はありません。</p>
+{{- end}}
+</article>
+`))
+
+func (d *Digest) renderHTML() (string, error) {
+	var buf bytes.Buffer
+	err := digestHTMLTemplate.Execute(&buf, struct {
+		Period       string
+		ArticleCount int
+		Groups       []DigestGroup
+	}{
+		Period:       d.periodLabel(),
+		ArticleCount: d.ArticleCount,
+		Groups:       d.Groups,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to render digest html: %w", err)
+	}
+	return buf.String(), nil
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`,
+	"[", `\[`,
+	"]", `\]`,
+	"*", `\*`,
+	"_", `\_`,
+	"`", "\\`",
+	"<", `\<`,
+)
+
+// Markdownの書式として解釈される文字をエスケープする
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
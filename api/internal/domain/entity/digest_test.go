@@ -0,0 +1,73 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDigest(t *testing.T) {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	start := time.Date(2026, 10, 5, 0, 0, 0, 0, jst)
+	end := start.AddDate(0, 0, 7)
+
+	t.Run("正常系：MarkdownとHTMLを生成する", func(t *testing.T) {
+		digest, err := NewDigest(start, end, []DigestGroup{
+			{
+				Tag:      "Go",
+				Overview: "Goの並行処理に関する記事が中心でした。",
+				Articles: []DigestArticle{
+					{ArticleID: 1, Title: "Goroutine入門", URL: "https://example.com/goroutine"},
+					{ArticleID: 2, Title: "Channelの使い方", URL: "https://example.com/channel"},
+				},
+			},
+			{
+				Tag:      DigestUntaggedGroup,
+				Articles: []DigestArticle{{ArticleID: 3, Title: "雑記", URL: "https://example.com/memo"}},
+			},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, digest.ArticleCount)
+		assert.Contains(t, digest.Markdown, "# 読んだ記事ダイジェスト（2026-10-05 〜 2026-10-11）")
+		assert.Contains(t, digest.Markdown, "## Go（2件）\n\nGoの並行処理に関する記事が中心でした。")
+		assert.Contains(t, digest.Markdown, "- [Goroutine入門](<https://example.com/goroutine>)")
+		assert.Contains(t, digest.Markdown, "## タグなし（1件）")
+		assert.Contains(t, digest.HTML, `<h2>Go（2件）</h2>`)
+		assert.Contains(t, digest.HTML, `<li><a href="https://example.com/channel">Channelの使い方</a></li>`)
+	})
+
+	t.Run("正常系：記事がない期間", func(t *testing.T) {
+		digest, err := NewDigest(start, end, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, digest.ArticleCount)
+		assert.NotNil(t, digest.Groups)
+		assert.Contains(t, digest.Markdown, "この期間に保存された記事はありません。")
+		assert.Contains(t, digest.HTML, "この期間に保存された記事はありません。")
+	})
+
+	t.Run("正常系：タイトルをエスケープする", func(t *testing.T) {
+		digest, err := NewDigest(start, end, []DigestGroup{{
+			Tag:      "Web",
+			Articles: []DigestArticle{{ArticleID: 1, Title: "[速報] <script>の*罠*", URL: "https://example.com/a"}},
+		}})
+
+		require.NoError(t, err)
+		assert.Contains(t, digest.Markdown, `- [\[速報\] \<script>の\*罠\*](<https://example.com/a>)`)
+		assert.Contains(t, digest.HTML, "&lt;script&gt;")
+		assert.NotContains(t, digest.HTML, "<script>")
+	})
+
+	t.Run("異常系：期間の開始が終了以降", func(t *testing.T) {
+		_, err := NewDigest(end, start, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系：グループのタグが空", func(t *testing.T) {
+		_, err := NewDigest(start, end, []DigestGroup{{Tag: " "}})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"errors"
+	"strings"
+)
+
+// ISBN-10またはISBN-13を検証し、ISBN-13に変換する（ハイフンと空白は無視する）
+func ToISBN13(isbn string) (string, error) {
+	isbn = strings.ReplaceAll(strings.ReplaceAll(strings.TrimSpace(isbn), "-", ""), " ", "")
+	switch len(isbn) {
+	case 10:
+		if !validISBN10(isbn) {
+			return "", errors.New("invalid ISBN-10 checksum: " + isbn)
+		}
+		body := "978" + isbn[:9]
+		return body + string(isbn13CheckDigit(body)), nil
+	case 13:
+		if !isDigits(isbn) || isbn13CheckDigit(isbn[:12]) != isbn[12] {
+			return "", errors.New("invalid ISBN-13 checksum: " + isbn)
+		}
+		return isbn, nil
+	default:
+		return "", errors.New("isbn must be 10 or 13 digits: " + isbn)
+	}
+}
+
+func validISBN10(isbn string) bool {
+	if !isDigits(isbn[:9]) {
+		return false
+	}
+	sum := 0
+	for i := range 9 {
+		sum += int(isbn[i]-'0') * (10 - i)
+	}
+	switch last := isbn[9]; {
+	case last == 'X' || last == 'x':
+		sum += 10
+	case last >= '0' && last <= '9':
+		sum += int(last - '0')
+	default:
+		return false
+	}
+	return sum%11 == 0
+}
+
+// 先頭12桁からISBN-13のチェックディジットを計算
+func isbn13CheckDigit(body string) byte {
+	sum := 0
+	for i := range 12 {
+		digit := int(body[i] - '0')
+		if i%2 == 1 {
+			digit *= 3
+		}
+		sum += digit
+	}
+	return byte('0' + (10-sum%10)%10)
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
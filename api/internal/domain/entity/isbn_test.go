@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToISBN13(t *testing.T) {
+	t.Run("正常系：ISBN-10とISBN-13をISBN-13に変換する", func(t *testing.T) {
+		tests := map[string]string{
+			"9784873115658":     "9784873115658",
+			"978-4-87311-565-8": "9784873115658",
+			"4873115655":        "9784873115658",
+			"020161622X":        "9780201616224",
+		}
+		for isbn, expected := range tests {
+			actual, err := ToISBN13(isbn)
+			require.NoError(t, err, isbn)
+			assert.Equal(t, expected, actual, isbn)
+		}
+	})
+
+	t.Run("異常系：チェックディジットや桁数が不正", func(t *testing.T) {
+		for _, isbn := range []string{"9784873115659", "4873115656", "12345", "978487311565X"} {
+			_, err := ToISBN13(isbn)
+			assert.Error(t, err, isbn)
+		}
+	})
+}
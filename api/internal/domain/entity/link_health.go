@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// リンクの状態
+type LinkStatus string
+
+const (
+	LinkStatusOK     LinkStatus = "ok"
+	LinkStatusBroken LinkStatus = "broken"
+	LinkStatusMoved  LinkStatus = "moved"
+)
+
+// 記事URLのリンクヘルス
+type LinkHealth struct {
+	ArticleID         int64
+	CheckedURL        string
+	StatusCode        int
+	FinalURL          string
+	Status            LinkStatus
+	PermanentRedirect bool
+	ErrorMessage      string
+	CheckedAt         time.Time
+}
+
+// 新しいリンクヘルスの作成
+func NewLinkHealth(articleID int64, checkedURL string, statusCode int, finalURL string, permanentRedirect bool, errorMessage string) (*LinkHealth, error) {
+	if articleID <= 0 {
+		return nil, errors.New("article id must be positive")
+	}
+	if err := validateURL(checkedURL); err != nil {
+		return nil, err
+	}
+
+	if finalURL == "" {
+		finalURL = checkedURL
+	}
+
+	health := &LinkHealth{
+		ArticleID:         articleID,
+		CheckedURL:        checkedURL,
+		StatusCode:        statusCode,
+		FinalURL:          finalURL,
+		PermanentRedirect: permanentRedirect,
+		ErrorMessage:      errorMessage,
+		CheckedAt:         time.Now(),
+	}
+	health.Status = health.determineStatus()
+
+	return health, nil
+}
+
+// 恒久的なリダイレクトによりURLの更新が可能かどうか
+func (h *LinkHealth) CanUpdateURL() bool {
+	return h.Status == LinkStatusMoved && h.PermanentRedirect && h.FinalURL != h.CheckedURL
+}
+
+// ステータスコードとリダイレクト先からリンクの状態を判定
+func (h *LinkHealth) determineStatus() LinkStatus {
+	if h.ErrorMessage != "" || h.StatusCode == 0 || h.StatusCode >= 400 {
+		return LinkStatusBroken
+	}
+	if sameURL(h.CheckedURL, h.FinalURL) {
+		return LinkStatusOK
+	}
+	// 記事ページがトップページへリダイレクトされる場合は実質的なリンク切れとみなす
+	if redirectedToHomePage(h.CheckedURL, h.FinalURL) {
+		return LinkStatusBroken
+	}
+	return LinkStatusMoved
+}
+
+// リンク状態文字列の解析
+func ParseLinkStatus(status string) (LinkStatus, error) {
+	switch LinkStatus(status) {
+	case LinkStatusOK, LinkStatusBroken, LinkStatusMoved:
+		return LinkStatus(status), nil
+	default:
+		return "", errors.New("link status must be one of ok, broken, moved")
+	}
+}
+
+func sameURL(a, b string) bool {
+	return strings.TrimSuffix(a, "/") == strings.TrimSuffix(b, "/")
+}
+
+func redirectedToHomePage(originalURL, finalURL string) bool {
+	original, err := url.Parse(originalURL)
+	if err != nil {
+		return false
+	}
+	final, err := url.Parse(finalURL)
+	if err != nil {
+		return false
+	}
+
+	originalPath := strings.Trim(original.Path, "/")
+	finalPath := strings.Trim(final.Path, "/")
+	return originalPath != "" && finalPath == "" && final.RawQuery == ""
+}
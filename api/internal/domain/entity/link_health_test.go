@@ -0,0 +1,131 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewLinkHealth(t *testing.T) {
+	tests := []struct {
+		name           string
+		articleID      int64
+		checkedURL     string
+		statusCode     int
+		finalURL       string
+		permanent      bool
+		errorMessage   string
+		wantErr        bool
+		expectedStatus LinkStatus
+		canUpdateURL   bool
+	}{
+		{
+			name:           "正常系：200でリダイレクトなし",
+			articleID:      1,
+			checkedURL:     "https://example.com/article",
+			statusCode:     200,
+			finalURL:       "https://example.com/article",
+			expectedStatus: LinkStatusOK,
+		},
+		{
+			name:           "正常系：末尾スラッシュの違いは同一URLとみなす",
+			articleID:      1,
+			checkedURL:     "https://example.com/article",
+			statusCode:     200,
+			finalURL:       "https://example.com/article/",
+			expectedStatus: LinkStatusOK,
+		},
+		{
+			name:           "正常系：404はリンク切れ",
+			articleID:      1,
+			checkedURL:     "https://example.com/article",
+			statusCode:     404,
+			expectedStatus: LinkStatusBroken,
+		},
+		{
+			name:           "正常系：通信エラーはリンク切れ",
+			articleID:      1,
+			checkedURL:     "https://example.com/article",
+			errorMessage:   "connection refused",
+			expectedStatus: LinkStatusBroken,
+		},
+		{
+			name:           "正常系：恒久的なリダイレクトは移動扱いでURL更新可能",
+			articleID:      1,
+			checkedURL:     "http://example.com/article",
+			statusCode:     200,
+			finalURL:       "https://example.com/new-article",
+			permanent:      true,
+			expectedStatus: LinkStatusMoved,
+			canUpdateURL:   true,
+		},
+		{
+			name:           "正常系：一時的なリダイレクトはURL更新しない",
+			articleID:      1,
+			checkedURL:     "https://example.com/article",
+			statusCode:     200,
+			finalURL:       "https://example.com/login?next=article",
+			permanent:      false,
+			expectedStatus: LinkStatusMoved,
+			canUpdateURL:   false,
+		},
+		{
+			name:           "正常系：トップページへのリダイレクトはリンク切れ",
+			articleID:      1,
+			checkedURL:     "https://example.com/blog/2020/old-post",
+			statusCode:     200,
+			finalURL:       "https://example.com/",
+			permanent:      true,
+			expectedStatus: LinkStatusBroken,
+			canUpdateURL:   false,
+		},
+		{
+			name:       "異常系：記事IDが0",
+			articleID:  0,
+			checkedURL: "https://example.com/article",
+			statusCode: 200,
+			wantErr:    true,
+		},
+		{
+			name:       "異常系：不正なURL",
+			articleID:  1,
+			checkedURL: "ftp://example.com/article",
+			statusCode: 200,
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			health, err := NewLinkHealth(tt.articleID, tt.checkedURL, tt.statusCode, tt.finalURL, tt.permanent, tt.errorMessage)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Nil(t, health)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedStatus, health.Status)
+			assert.Equal(t, tt.canUpdateURL, health.CanUpdateURL())
+			assert.NotEmpty(t, health.FinalURL)
+			assert.False(t, health.CheckedAt.IsZero())
+		})
+	}
+}
+
+func TestParseLinkStatus(t *testing.T) {
+	t.Run("正常系：有効な状態", func(t *testing.T) {
+		for _, status := range []string{"ok", "broken", "moved"} {
+			parsed, err := ParseLinkStatus(status)
+			require.NoError(t, err)
+			assert.Equal(t, LinkStatus(status), parsed)
+		}
+	})
+
+	t.Run("異常系：不正な状態", func(t *testing.T) {
+		_, err := ParseLinkStatus("unknown")
+		assert.Error(t, err)
+	})
+}
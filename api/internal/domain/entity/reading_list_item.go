@@ -0,0 +1,139 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 読書リストの書籍の状態
+type ReadingStatus string
+
+const (
+	ReadingStatusWant    ReadingStatus = "want"    // 読みたい
+	ReadingStatusReading ReadingStatus = "reading" // 読んでいる
+	ReadingStatusDone    ReadingStatus = "done"    // 読み終えた
+)
+
+// 評価の上限（0は未評価）
+const MaxReadingRating = 5
+
+// 文字列から読書リストの状態を取得
+func ParseReadingStatus(s string) (ReadingStatus, error) {
+	switch status := ReadingStatus(s); status {
+	case ReadingStatusWant, ReadingStatusReading, ReadingStatusDone:
+		return status, nil
+	default:
+		return "", fmt.Errorf("status must be one of want, reading, done: %s", s)
+	}
+}
+
+// 読書リストの書籍
+type ReadingListItem struct {
+	ID            int64
+	Key           string // 書籍を識別するキー（Book.Keyと同じ）
+	Title         string
+	Author        string
+	ISBN13        string
+	CoverImageURL string
+	Status        ReadingStatus
+	Notes         string
+	Rating        int
+	ArticleIDs    []int64 // 関連する記事のID
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// 新しい読書リストの書籍の作成（状態は「読みたい」）
+// ISBNはISBN-10とISBN-13を受け付け、チェックディジットを検証してISBN-13で保持する
+func NewReadingListItem(title, author, isbn, coverImageURL string, articleIDs []int64) (*ReadingListItem, error) {
+	title = strings.TrimSpace(title)
+	if err := validateBookTitle(title); err != nil {
+		return nil, err
+	}
+	author = strings.TrimSpace(author)
+	if utf8.RuneCountInString(author) > 255 {
+		return nil, errors.New("author must be 255 characters or less")
+	}
+	isbn13 := ""
+	if strings.TrimSpace(isbn) != "" {
+		normalized, err := ToISBN13(isbn)
+		if err != nil {
+			return nil, err
+		}
+		isbn13 = normalized
+	}
+	if err := validateCoverImageURL(coverImageURL); err != nil {
+		return nil, err
+	}
+	articleIDs, err := normalizeArticleIDs(articleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	return &ReadingListItem{
+		Key:           Book{Title: title, ISBN13: isbn13}.Key(),
+		Title:         title,
+		Author:        author,
+		ISBN13:        isbn13,
+		CoverImageURL: coverImageURL,
+		Status:        ReadingStatusWant,
+		ArticleIDs:    articleIDs,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// 状態・メモ・評価・関連する記事を更新
+func (i *ReadingListItem) Update(status ReadingStatus, notes string, rating int, articleIDs []int64) error {
+	if _, err := ParseReadingStatus(string(status)); err != nil {
+		return err
+	}
+	if utf8.RuneCountInString(notes) > 2000 {
+		return errors.New("notes must be 2000 characters or less")
+	}
+	if rating < 0 || rating > MaxReadingRating {
+		return fmt.Errorf("rating must be between 0 and %d", MaxReadingRating)
+	}
+	articleIDs, err := normalizeArticleIDs(articleIDs)
+	if err != nil {
+		return err
+	}
+
+	i.Status = status
+	i.Notes = notes
+	i.Rating = rating
+	i.ArticleIDs = articleIDs
+	i.UpdatedAt = time.Now()
+	return nil
+}
+
+func validateCoverImageURL(url string) error {
+	if url == "" {
+		return nil
+	}
+	if !strings.HasPrefix(url, "http://") && !strings.HasPrefix(url, "https://") {
+		return errors.New("cover image url must start with http:// or https://")
+	}
+	if len(url) > 2048 {
+		return errors.New("cover image url must be 2048 characters or less")
+	}
+	return nil
+}
+
+// 記事IDを検証し、重複を除いて昇順に並べる
+func normalizeArticleIDs(ids []int64) ([]int64, error) {
+	normalized := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id <= 0 {
+			return nil, errors.New("article ids must be positive")
+		}
+		normalized = append(normalized, id)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
@@ -0,0 +1,76 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewReadingListItem(t *testing.T) {
+	t.Run("正常系：ISBN-10をISBN-13に変換し、読みたい状態で作成する", func(t *testing.T) {
+		item, err := NewReadingListItem(" リーダブルコード ", "Dustin Boswell", "4873115655", "https://example.com/cover.jpg", []int64{3, 1, 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, "リーダブルコード", item.Title)
+		assert.Equal(t, "9784873115658", item.ISBN13)
+		assert.Equal(t, "isbn:9784873115658", item.Key)
+		assert.Equal(t, ReadingStatusWant, item.Status)
+		assert.Equal(t, []int64{1, 3}, item.ArticleIDs)
+	})
+
+	t.Run("異常系：ISBNのチェックディジットが不正", func(t *testing.T) {
+		_, err := NewReadingListItem("リーダブルコード", "", "9784873115659", "", nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系：タイトルが空", func(t *testing.T) {
+		_, err := NewReadingListItem(" ", "", "", "", nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系：表紙のURLが不正", func(t *testing.T) {
+		_, err := NewReadingListItem("リーダブルコード", "", "", "ftp://example.com/cover.jpg", nil)
+		assert.Error(t, err)
+	})
+}
+
+func TestReadingListItem_Update(t *testing.T) {
+	newItem := func(t *testing.T) *ReadingListItem {
+		item, err := NewReadingListItem("リーダブルコード", "", "", "", nil)
+		require.NoError(t, err)
+		return item
+	}
+
+	t.Run("正常系：状態・メモ・評価・関連する記事を更新する", func(t *testing.T) {
+		item := newItem(t)
+
+		err := item.Update(ReadingStatusDone, "命名の章が参考になった", 5, []int64{2})
+
+		require.NoError(t, err)
+		assert.Equal(t, ReadingStatusDone, item.Status)
+		assert.Equal(t, "命名の章が参考になった", item.Notes)
+		assert.Equal(t, 5, item.Rating)
+		assert.Equal(t, []int64{2}, item.ArticleIDs)
+	})
+
+	tests := []struct {
+		name       string
+		status     ReadingStatus
+		notes      string
+		rating     int
+		articleIDs []int64
+	}{
+		{name: "不正な状態", status: "finished"},
+		{name: "評価が範囲外", status: ReadingStatusDone, rating: 6},
+		{name: "メモが長すぎる", status: ReadingStatusDone, notes: strings.Repeat("あ", 2001)},
+		{name: "記事IDが不正", status: ReadingStatusDone, articleIDs: []int64{0}},
+	}
+	for _, tt := range tests {
+		t.Run("異常系："+tt.name, func(t *testing.T) {
+			item := newItem(t)
+			assert.Error(t, item.Update(tt.status, tt.notes, tt.rating, tt.articleIDs))
+		})
+	}
+}
@@ -2,24 +2,31 @@ package entity
 
 import (
 	"errors"
+	"regexp"
 	"strings"
 	"time"
 	"unicode/utf8"
 )
 
+var tagColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
 // タグエンティティ
 type Tag struct {
-	ID        int64
-	Name      string
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID          int64
+	Name        string
+	ParentID    int64  // 親タグのID（0の場合は親なし）
+	Color       string // 表示用の色（#RRGGBB形式、空文字の場合は未設定）
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
-// 新しいタグの作成
+// 新しいタグの作成（タグ名は正規化して保存する）
 func NewTag(name string) (*Tag, error) {
 	if err := validateTagName(name); err != nil {
 		return nil, err
 	}
+	name = NormalizeTagName(name)
 
 	now := time.Now()
 	tag := &Tag{
@@ -37,12 +44,74 @@ func (t *Tag) Update(name string) error {
 	if err := validateTagName(name); err != nil {
 		return err
 	}
+	name = NormalizeTagName(name)
 
 	t.Name = name
 	t.UpdatedAt = time.Now()
 	return nil
 }
 
+// 表示用の色を設定（空文字の場合は未設定とする）
+func (t *Tag) SetColor(color string) error {
+	color = strings.TrimSpace(color)
+	if color != "" && !tagColorPattern.MatchString(color) {
+		return errors.New("color must be in #RRGGBB format")
+	}
+
+	t.Color = strings.ToUpper(color)
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// 説明を設定
+func (t *Tag) SetDescription(description string) error {
+	description = strings.TrimSpace(description)
+	if utf8.RuneCountInString(description) > 500 {
+		return errors.New("description must be 500 characters or less")
+	}
+
+	t.Description = description
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
+// 親タグを設定（0の場合は親なし）
+// tagsには既存のすべてのタグを渡し、親をたどって自身に戻る場合はエラーとする
+func (t *Tag) SetParent(parentID int64, tags []*Tag) error {
+	if parentID < 0 {
+		return errors.New("parent id must be positive")
+	}
+	if parentID == 0 {
+		t.ParentID = 0
+		t.UpdatedAt = time.Now()
+		return nil
+	}
+	if parentID == t.ID {
+		return errors.New("tag cannot be its own parent")
+	}
+
+	parents := make(map[int64]int64, len(tags))
+	for _, tag := range tags {
+		parents[tag.ID] = tag.ParentID
+	}
+	if _, exists := parents[parentID]; !exists {
+		return errors.New("parent tag does not exist")
+	}
+
+	// 新しい親から祖先をたどり、自身が含まれていれば循環する
+	visited := make(map[int64]bool)
+	for current := parentID; current != 0 && !visited[current]; current = parents[current] {
+		if t.ID != 0 && current == t.ID {
+			return errors.New("parent tag would create a cycle")
+		}
+		visited[current] = true
+	}
+
+	t.ParentID = parentID
+	t.UpdatedAt = time.Now()
+	return nil
+}
+
 // タグ名のバリデーション
 func validateTagName(name string) error {
 	// 空文字チェック
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// タグの別名（別名で指定されたタグは代表のタグとして扱う）
+type TagAlias struct {
+	Alias     string
+	TagID     int64
+	CreatedAt time.Time
+}
+
+// 新しい別名の作成
+func NewTagAlias(alias string, tagID int64) (*TagAlias, error) {
+	alias = NormalizeTagName(alias)
+	if err := validateTagName(alias); err != nil {
+		return nil, err
+	}
+	if tagID <= 0 {
+		return nil, errors.New("tag id must be positive")
+	}
+
+	return &TagAlias{
+		Alias:     alias,
+		TagID:     tagID,
+		CreatedAt: time.Now(),
+	}, nil
+}
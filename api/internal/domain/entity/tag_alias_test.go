@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTagAlias(t *testing.T) {
+	t.Run("正常系：前後の空白を除いて作成できる", func(t *testing.T) {
+		alias, err := NewTagAlias(" golang ", 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, "golang", alias.Alias)
+		assert.Equal(t, int64(1), alias.TagID)
+	})
+
+	t.Run("異常系：空の別名", func(t *testing.T) {
+		_, err := NewTagAlias("  ", 1)
+
+		require.Error(t, err)
+	})
+}
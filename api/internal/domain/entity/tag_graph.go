@@ -0,0 +1,208 @@
+package entity
+
+import (
+	"encoding/xml"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// タグの共起グラフのノード（記事数付きのタグ）
+type TagGraphNode struct {
+	TagID        int64 // タグとして登録されていない場合は0
+	Name         string
+	ArticleCount int
+}
+
+// タグの共起グラフの辺（両方のタグが付いた記事数を重みとする）
+type TagGraphEdge struct {
+	Source string // タグ名（SourceとTargetは名前順）
+	Target string
+	Weight int
+}
+
+// タグの共起グラフ
+type TagGraph struct {
+	Nodes []TagGraphNode
+	Edges []TagGraphEdge
+}
+
+// 共起グラフの集計条件
+type TagGraphOptions struct {
+	MinWeight int // 重みがこの値未満の辺は含めない
+	Limit     int // 記事数の多い上位のタグのみをノードとする（0の場合は制限なし）
+}
+
+// ノードと辺を並べ替えて共起グラフを作成する
+// ノードは記事数の多い順、辺は重みの大きい順とし、同じ場合は名前順とする
+func NewTagGraph(nodes []TagGraphNode, edges []TagGraphEdge) *TagGraph {
+	if nodes == nil {
+		nodes = []TagGraphNode{}
+	}
+	if edges == nil {
+		edges = []TagGraphEdge{}
+	}
+	for i, edge := range edges {
+		if edge.Target < edge.Source {
+			edges[i].Source, edges[i].Target = edge.Target, edge.Source
+		}
+	}
+
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].ArticleCount != nodes[j].ArticleCount {
+			return nodes[i].ArticleCount > nodes[j].ArticleCount
+		}
+		return nodes[i].Name < nodes[j].Name
+	})
+	sort.Slice(edges, func(i, j int) bool {
+		if edges[i].Weight != edges[j].Weight {
+			return edges[i].Weight > edges[j].Weight
+		}
+		if edges[i].Source != edges[j].Source {
+			return edges[i].Source < edges[j].Source
+		}
+		return edges[i].Target < edges[j].Target
+	})
+
+	return &TagGraph{Nodes: nodes, Edges: edges}
+}
+
+// 記事ごとのタグ名から共起グラフを作成する（同じ記事で重複したタグ名は1つとして数える）
+func BuildTagGraph(articleTags [][]string, opts TagGraphOptions) *TagGraph {
+	counts := make(map[string]int)
+	pairs := make(map[[2]string]int)
+	for _, tags := range articleTags {
+		unique := make([]string, 0, len(tags))
+		for _, tag := range tags {
+			if !slices.Contains(unique, tag) {
+				unique = append(unique, tag)
+			}
+		}
+		sort.Strings(unique)
+
+		for i, source := range unique {
+			counts[source]++
+			for _, target := range unique[i+1:] {
+				pairs[[2]string{source, target}]++
+			}
+		}
+	}
+
+	nodes := make([]TagGraphNode, 0, len(counts))
+	for name, count := range counts {
+		nodes = append(nodes, TagGraphNode{Name: name, ArticleCount: count})
+	}
+	graph := NewTagGraph(nodes, nil)
+	if opts.Limit > 0 && len(graph.Nodes) > opts.Limit {
+		graph.Nodes = graph.Nodes[:opts.Limit]
+	}
+
+	included := make(map[string]bool, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		included[node.Name] = true
+	}
+	edges := make([]TagGraphEdge, 0)
+	for pair, weight := range pairs {
+		if weight < opts.MinWeight || !included[pair[0]] || !included[pair[1]] {
+			continue
+		}
+		edges = append(edges, TagGraphEdge{Source: pair[0], Target: pair[1], Weight: weight})
+	}
+
+	return NewTagGraph(graph.Nodes, edges)
+}
+
+// GraphML形式のノード・辺・属性
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   graphMLGraph `xml:"graph"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLGraph struct {
+	ID          string        `xml:"id,attr"`
+	EdgeDefault string        `xml:"edgedefault,attr"`
+	Nodes       []graphMLNode `xml:"node"`
+	Edges       []graphMLEdge `xml:"edge"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// GraphML形式で出力する（ノードのIDはn0, n1, ...とし、タグ名は属性に含める）
+func (g *TagGraph) GraphML() (string, error) {
+	ids := make(map[string]string, len(g.Nodes))
+	graph := graphMLGraph{ID: "tags", EdgeDefault: "undirected"}
+	for i, node := range g.Nodes {
+		id := fmt.Sprintf("n%d", i)
+		ids[node.Name] = id
+		graph.Nodes = append(graph.Nodes, graphMLNode{
+			ID: id,
+			Data: []graphMLData{
+				{Key: "name", Value: node.Name},
+				{Key: "article_count", Value: fmt.Sprint(node.ArticleCount)},
+			},
+		})
+	}
+	for _, edge := range g.Edges {
+		graph.Edges = append(graph.Edges, graphMLEdge{
+			Source: ids[edge.Source],
+			Target: ids[edge.Target],
+			Data:   []graphMLData{{Key: "weight", Value: fmt.Sprint(edge.Weight)}},
+		})
+	}
+
+	document := graphMLDocument{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "name", For: "node", AttrName: "name", AttrType: "string"},
+			{ID: "article_count", For: "node", AttrName: "article_count", AttrType: "int"},
+			{ID: "weight", For: "edge", AttrName: "weight", AttrType: "int"},
+		},
+		Graph: graph,
+	}
+	output, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(output) + "\n", nil
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// DOT形式（Graphviz）で出力する
+func (g *TagGraph) DOT() string {
+	var b strings.Builder
+	b.WriteString("graph tags {\n")
+	for _, node := range g.Nodes {
+		name := dotEscaper.Replace(node.Name)
+		fmt.Fprintf(&b, "  \"%s\" [label=\"%s (%d)\", article_count=%d];\n", name, name, node.ArticleCount, node.ArticleCount)
+	}
+	for _, edge := range g.Edges {
+		fmt.Fprintf(&b, "  \"%s\" -- \"%s\" [weight=%d, label=\"%d\"];\n", dotEscaper.Replace(edge.Source), dotEscaper.Replace(edge.Target), edge.Weight, edge.Weight)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTagGraph(t *testing.T) {
+	articleTags := [][]string{
+		{"Go", "Docker", "AWS"},
+		{"Go", "Docker"},
+		{"Go", "Go"},
+		{"Python", "AWS"},
+	}
+
+	t.Run("正常系：タグの記事数と共起数を集計できる", func(t *testing.T) {
+		graph := BuildTagGraph(articleTags, TagGraphOptions{MinWeight: 1})
+
+		assert.Equal(t, []TagGraphNode{
+			{Name: "Go", ArticleCount: 3},
+			{Name: "AWS", ArticleCount: 2},
+			{Name: "Docker", ArticleCount: 2},
+			{Name: "Python", ArticleCount: 1},
+		}, graph.Nodes)
+		assert.Equal(t, []TagGraphEdge{
+			{Source: "Docker", Target: "Go", Weight: 2},
+			{Source: "AWS", Target: "Docker", Weight: 1},
+			{Source: "AWS", Target: "Go", Weight: 1},
+			{Source: "AWS", Target: "Python", Weight: 1},
+		}, graph.Edges)
+	})
+
+	t.Run("正常系：最小の重みと上位の件数で絞り込める", func(t *testing.T) {
+		graph := BuildTagGraph(articleTags, TagGraphOptions{MinWeight: 2, Limit: 3})
+
+		require.Len(t, graph.Nodes, 3)
+		assert.Equal(t, "Docker", graph.Nodes[2].Name)
+		assert.Equal(t, []TagGraphEdge{{Source: "Docker", Target: "Go", Weight: 2}}, graph.Edges)
+	})
+
+	t.Run("正常系：記事がない場合は空のグラフを返す", func(t *testing.T) {
+		graph := BuildTagGraph(nil, TagGraphOptions{})
+
+		assert.Empty(t, graph.Nodes)
+		assert.NotNil(t, graph.Edges)
+	})
+}
+
+func TestTagGraph_Export(t *testing.T) {
+	graph := NewTagGraph(
+		[]TagGraphNode{{Name: "Go", ArticleCount: 2}, {Name: `C"Lang`, ArticleCount: 1}},
+		[]TagGraphEdge{{Source: "Go", Target: `C"Lang`, Weight: 1}},
+	)
+
+	t.Run("正常系：GraphML形式で出力できる", func(t *testing.T) {
+		output, err := graph.GraphML()
+
+		require.NoError(t, err)
+		assert.Contains(t, output, `<graph id="tags" edgedefault="undirected">`)
+		assert.Contains(t, output, `<data key="name">C&#34;Lang</data>`)
+		assert.Contains(t, output, `<edge source="n1" target="n0">`)
+		assert.Contains(t, output, `<data key="weight">1</data>`)
+	})
+
+	t.Run("正常系：DOT形式で出力できる", func(t *testing.T) {
+		output := graph.DOT()
+
+		assert.Contains(t, output, "graph tags {\n")
+		assert.Contains(t, output, `"Go" [label="Go (2)", article_count=2];`)
+		assert.Contains(t, output, `"C\"Lang" -- "Go" [weight=1, label="1"];`)
+	})
+}
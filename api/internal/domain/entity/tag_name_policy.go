@@ -0,0 +1,66 @@
+package entity
+
+import (
+	"sort"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// タグ名の正規化方針
+// タグ名はNFKC正規化と空白の正規化を行って保存し、一意性は正規化キーで判定する
+type TagNamePolicy struct {
+	// 大文字小文字を同一視するか（"AWS"と"aws"を同じタグとして扱う）
+	FoldCase bool
+}
+
+// 既定の正規化方針（大文字小文字を同一視する）
+func DefaultTagNamePolicy() TagNamePolicy {
+	return TagNamePolicy{FoldCase: true}
+}
+
+// 一意性の判定と検索に使う正規化キーを返す
+func (p TagNamePolicy) Key(name string) string {
+	key := NormalizeTagName(name)
+	if p.FoldCase {
+		key = strings.ToLower(key)
+	}
+	return key
+}
+
+// 同じ正規化キーになるタグの組
+type TagNameCollision struct {
+	Key  string
+	Tags []*Tag // ID順（先頭のタグが正規化キーを持つ）
+}
+
+// 同じ正規化キーになるタグの組をキー順に返す
+func (p TagNamePolicy) FindCollisions(tags []*Tag) []TagNameCollision {
+	groups := make(map[string][]*Tag)
+	for _, tag := range tags {
+		key := p.Key(tag.Name)
+		groups[key] = append(groups[key], tag)
+	}
+
+	collisions := make([]TagNameCollision, 0)
+	for key, group := range groups {
+		if len(group) < 2 {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool {
+			return group[i].ID < group[j].ID
+		})
+		collisions = append(collisions, TagNameCollision{Key: key, Tags: group})
+	}
+
+	sort.Slice(collisions, func(i, j int) bool {
+		return collisions[i].Key < collisions[j].Key
+	})
+	return collisions
+}
+
+// タグ名を正規化する
+// NFKCで全角英数・半角カナ・丸数字などの互換文字を揃え、連続する空白を1つにまとめて前後の空白を除く
+func NormalizeTagName(name string) string {
+	return strings.Join(strings.Fields(norm.NFKC.String(name)), " ")
+}
@@ -0,0 +1,74 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeTagName(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "正常系：全角英数を半角に変換", input: "ＡＷＳ", expected: "AWS"},
+		{name: "正常系：前後の空白を除去し連続する空白をまとめる", input: "  Machine　 Learning\t", expected: "Machine Learning"},
+		{name: "正常系：半角カナを全角に変換（濁点・半濁点を結合）", input: "ﾃﾞｰﾀﾍﾞｰｽ ﾊﾟｲｿﾝ", expected: "データベース パイソン"},
+		{name: "正常系：結合文字の濁点を結合", input: "テ\u3099ータ", expected: "データ"},
+		{name: "正常系：大文字小文字は変換しない", input: "GoLang", expected: "GoLang"},
+		{name: "正常系：全角記号（FFE0〜FFEE）を半角に変換", input: "￥100ｼｮｯﾌﾟ￨", expected: "¥100ショップ│"},
+		{name: "正常系：丸数字・括弧付き文字を展開", input: "①㈱", expected: "1(株)"},
+		{name: "正常系：合字を展開", input: "ﬁle", expected: "file"},
+		{name: "正常系：互換漢字を統合漢字に変換", input: "\uF900", expected: "\u8C48"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NormalizeTagName(tt.input))
+		})
+	}
+}
+
+func TestTagNamePolicy_Key(t *testing.T) {
+	t.Run("正常系：大文字小文字を同一視する", func(t *testing.T) {
+		policy := DefaultTagNamePolicy()
+
+		assert.Equal(t, "aws", policy.Key("ＡＷＳ"))
+		assert.Equal(t, "aws", policy.Key("AWS"))
+		assert.Equal(t, "aws", policy.Key("aws "))
+	})
+
+	t.Run("正常系：大文字小文字を区別する", func(t *testing.T) {
+		policy := TagNamePolicy{FoldCase: false}
+
+		assert.Equal(t, "AWS", policy.Key("ＡＷＳ"))
+		assert.NotEqual(t, policy.Key("AWS"), policy.Key("aws"))
+	})
+}
+
+func TestTagNamePolicy_FindCollisions(t *testing.T) {
+	tags := []*Tag{
+		{ID: 3, Name: "aws "},
+		{ID: 1, Name: "AWS"},
+		{ID: 2, Name: "Go"},
+		{ID: 4, Name: "ＡＷＳ"},
+	}
+
+	t.Run("正常系：同じキーになるタグをID順にまとめる", func(t *testing.T) {
+		collisions := DefaultTagNamePolicy().FindCollisions(tags)
+
+		require.Len(t, collisions, 1)
+		assert.Equal(t, "aws", collisions[0].Key)
+		require.Len(t, collisions[0].Tags, 3)
+		assert.Equal(t, []int64{1, 3, 4}, []int64{collisions[0].Tags[0].ID, collisions[0].Tags[1].ID, collisions[0].Tags[2].ID})
+	})
+
+	t.Run("正常系：大文字小文字を区別する場合は幅と空白の違いのみ衝突する", func(t *testing.T) {
+		collisions := TagNamePolicy{FoldCase: false}.FindCollisions(tags)
+
+		require.Len(t, collisions, 1)
+		assert.Equal(t, "AWS", collisions[0].Key)
+		assert.Len(t, collisions[0].Tags, 2)
+	})
+}
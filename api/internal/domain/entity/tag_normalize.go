@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// 読みや書籍のタイトルのあいまいな比較に使うキーを生成する
+// NFKCで互換文字を揃え、大文字小文字と空白・区切り記号の違いを無視する
+// タグの同一性の判定にはTagNamePolicy.Keyを使う
+func looseMatchKey(name string) string {
+	var b strings.Builder
+	for _, r := range norm.NFKC.String(strings.TrimSpace(name)) {
+		if unicode.IsSpace(r) || isTagSeparator(r) {
+			continue
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+
+	return b.String()
+}
+
+func isTagSeparator(r rune) bool {
+	switch r {
+	case '-', '_', '.', '・', '･':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLooseMatchKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "正常系：小文字に変換", input: "JavaScript", expected: "javascript"},
+		{name: "正常系：全角英数を半角に変換", input: "Ｇｏ１２３", expected: "go123"},
+		{name: "正常系：空白と区切り記号を除去", input: " Machine-Learning_ v.2 ", expected: "machinelearningv2"},
+		{name: "正常系：全角スペースと中黒を除去", input: "データ・ベース　設計", expected: "データベース設計"},
+		{name: "正常系：半角カナを全角に変換（濁点・半濁点を結合）", input: "ﾃﾞｰﾀﾍﾞｰｽ ﾊﾟｲｿﾝ", expected: "データベースパイソン"},
+		{name: "正常系：空文字", input: "", expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, looseMatchKey(tt.input))
+		})
+	}
+}
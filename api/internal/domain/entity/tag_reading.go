@@ -0,0 +1,157 @@
+package entity
+
+import (
+	"strings"
+)
+
+// ローマ字（ヘボン式・訓令式）とひらがなの対応
+var romajiToHiragana = map[string]string{
+	"a": "あ", "i": "い", "u": "う", "e": "え", "o": "お",
+	"ka": "か", "ki": "き", "ku": "く", "ke": "け", "ko": "こ",
+	"kya": "きゃ", "kyu": "きゅ", "kyo": "きょ",
+	"ga": "が", "gi": "ぎ", "gu": "ぐ", "ge": "げ", "go": "ご",
+	"gya": "ぎゃ", "gyu": "ぎゅ", "gyo": "ぎょ",
+	"sa": "さ", "si": "し", "shi": "し", "su": "す", "se": "せ", "so": "そ",
+	"sha": "しゃ", "shu": "しゅ", "she": "しぇ", "sho": "しょ",
+	"sya": "しゃ", "syu": "しゅ", "syo": "しょ",
+	"za": "ざ", "zi": "じ", "ji": "じ", "zu": "ず", "ze": "ぜ", "zo": "ぞ",
+	"ja": "じゃ", "ju": "じゅ", "je": "じぇ", "jo": "じょ",
+	"jya": "じゃ", "jyu": "じゅ", "jyo": "じょ",
+	"zya": "じゃ", "zyu": "じゅ", "zyo": "じょ",
+	"ta": "た", "ti": "ち", "chi": "ち", "tu": "つ", "tsu": "つ", "te": "て", "to": "と",
+	"cha": "ちゃ", "chu": "ちゅ", "che": "ちぇ", "cho": "ちょ",
+	"tya": "ちゃ", "tyu": "ちゅ", "tyo": "ちょ",
+	"cya": "ちゃ", "cyu": "ちゅ", "cyo": "ちょ",
+	"da": "だ", "di": "ぢ", "du": "づ", "de": "で", "do": "ど",
+	"dya": "ぢゃ", "dyu": "ぢゅ", "dyo": "ぢょ",
+	"na": "な", "ni": "に", "nu": "ぬ", "ne": "ね", "no": "の",
+	"nya": "にゃ", "nyu": "にゅ", "nyo": "にょ",
+	"ha": "は", "hi": "ひ", "hu": "ふ", "fu": "ふ", "he": "へ", "ho": "ほ",
+	"hya": "ひゃ", "hyu": "ひゅ", "hyo": "ひょ",
+	"fa": "ふぁ", "fi": "ふぃ", "fe": "ふぇ", "fo": "ふぉ",
+	"ba": "ば", "bi": "び", "bu": "ぶ", "be": "べ", "bo": "ぼ",
+	"bya": "びゃ", "byu": "びゅ", "byo": "びょ",
+	"pa": "ぱ", "pi": "ぴ", "pu": "ぷ", "pe": "ぺ", "po": "ぽ",
+	"pya": "ぴゃ", "pyu": "ぴゅ", "pyo": "ぴょ",
+	"ma": "ま", "mi": "み", "mu": "む", "me": "め", "mo": "も",
+	"mya": "みゃ", "myu": "みゅ", "myo": "みょ",
+	"ya": "や", "yu": "ゆ", "yo": "よ",
+	"ra": "ら", "ri": "り", "ru": "る", "re": "れ", "ro": "ろ",
+	"rya": "りゃ", "ryu": "りゅ", "ryo": "りょ",
+	"wa": "わ", "wi": "うぃ", "we": "うぇ", "wo": "を",
+	"va": "ゔぁ", "vi": "ゔぃ", "vu": "ゔ", "ve": "ゔぇ", "vo": "ゔぉ",
+	"xa": "ぁ", "xi": "ぃ", "xu": "ぅ", "xe": "ぇ", "xo": "ぉ",
+	"la": "ぁ", "li": "ぃ", "lu": "ぅ", "le": "ぇ", "lo": "ぉ",
+	"xya": "ゃ", "xyu": "ゅ", "xyo": "ょ",
+	"xtu": "っ", "ltu": "っ",
+}
+
+// タグ名の読みのキーを生成する
+// カタカナとローマ字をひらがなに揃え、長音記号を除く（"Go"・"ゴー"・"ご"を同じ読みとして扱う）
+func TagReadingKey(name string) string {
+	return tagReading(name, false)
+}
+
+// 入力途中の文字列の読みのキーを生成する（末尾の未確定のローマ字は含めない）
+func TagReadingPrefix(query string) string {
+	return tagReading(query, true)
+}
+
+func tagReading(name string, partial bool) string {
+	runes := []rune(looseMatchKey(name))
+	kana := make([]rune, 0, len(runes))
+	for _, r := range runes {
+		switch {
+		case r == 'ー':
+			continue
+		case r >= 'ァ' && r <= 'ヶ':
+			// カタカナ → ひらがな
+			r -= 0x60
+		}
+		kana = append(kana, r)
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(kana); {
+		r := kana[i]
+		if !isRomajiLetter(r) {
+			b.WriteRune(r)
+			i++
+			continue
+		}
+
+		var next rune
+		if i+1 < len(kana) {
+			next = kana[i+1]
+		}
+		switch {
+		case r == 'n' && next == '\'':
+			b.WriteString("ん")
+			i += 2
+			continue
+		case r == 'n' && next == 'n':
+			// "nn"の後に母音が続く場合は後ろのnを次の音に使う（"konnichiha" → こんにちは）
+			b.WriteString("ん")
+			if i+2 < len(kana) && (isRomajiVowel(kana[i+2]) || kana[i+2] == 'y') {
+				i++
+			} else {
+				i += 2
+			}
+			continue
+		case r == 'n' && !isRomajiVowel(next) && next != 'y':
+			if next == 0 && partial {
+				return b.String()
+			}
+			b.WriteString("ん")
+			i++
+			continue
+		case !isRomajiVowel(r) && (next == r || (r == 't' && next == 'c')):
+			// 子音の重なりは促音
+			b.WriteString("っ")
+			i++
+			continue
+		}
+
+		matched := false
+		for length := 3; length >= 1; length-- {
+			if i+length > len(kana) {
+				continue
+			}
+			if hiragana, ok := romajiToHiragana[string(kana[i:i+length])]; ok {
+				b.WriteString(hiragana)
+				i += length
+				matched = true
+				break
+			}
+		}
+		if matched {
+			continue
+		}
+
+		if partial && isIncompleteRomaji(kana[i:]) {
+			return b.String()
+		}
+		b.WriteRune(r)
+		i++
+	}
+
+	return b.String()
+}
+
+func isRomajiLetter(r rune) bool {
+	return r >= 'a' && r <= 'z'
+}
+
+func isRomajiVowel(r rune) bool {
+	return strings.ContainsRune("aiueo", r)
+}
+
+// 末尾の子音のみの並び（入力途中のローマ字）か
+func isIncompleteRomaji(runes []rune) bool {
+	for _, r := range runes {
+		if !isRomajiLetter(r) || isRomajiVowel(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,48 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTagReadingKey(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"正常系：ローマ字をひらがなにする", "dokka", "どっか"},
+		{"正常系：カタカナをひらがなにし、長音記号を除く", "ドッカー", "どっか"},
+		{"正常系：半角カナも同じ読みになる", "ｷｶｲｶﾞｸｼｭｳ", "きかいがくしゅう"},
+		{"正常系：ヘボン式のローマ字", "kikaigakushuu", "きかいがくしゅう"},
+		{"正常系：撥音と促音", "konnichiha matcha", "こんにちはまっちゃ"},
+		{"正常系：ローマ字でない英字はそのまま残す", "Docker", "どcけr"},
+		{"正常系：漢字はそのまま", "機械学習", "機械学習"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TagReadingKey(tt.input))
+		})
+	}
+}
+
+func TestTagReadingPrefix(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"正常系：末尾の未確定の子音は含めない", "dokk", "どっ"},
+		{"正常系：末尾のnは含めない", "kan", "か"},
+		{"正常系：拗音の途中も含めない", "kikaigakush", "きかいがく"},
+		{"正常系：確定した読みはそのまま", "ごー", "ご"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, TagReadingPrefix(tt.input))
+		})
+	}
+}
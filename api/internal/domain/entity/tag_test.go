@@ -98,3 +98,109 @@ func TestNewTag(t *testing.T) {
 		})
 	}
 }
+
+func TestTag_SetParent(t *testing.T) {
+	// Infrastructure(1) ─ Container(2) ─ Docker(3)
+	tags := []*Tag{
+		{ID: 1, Name: "Infrastructure"},
+		{ID: 2, Name: "Container", ParentID: 1},
+		{ID: 3, Name: "Docker", ParentID: 2},
+	}
+
+	tests := []struct {
+		name     string
+		tag      *Tag
+		parentID int64
+		wantErr  bool
+		errMsg   string
+	}{
+		{
+			name:     "正常系：既存のタグを親に設定できる",
+			tag:      &Tag{ID: 4, Name: "Kubernetes"},
+			parentID: 2,
+		},
+		{
+			name:     "正常系：新しいタグにも親を設定できる",
+			tag:      &Tag{Name: "Podman"},
+			parentID: 2,
+		},
+		{
+			name:     "正常系：0を指定すると親を外せる",
+			tag:      &Tag{ID: 3, Name: "Docker", ParentID: 2},
+			parentID: 0,
+		},
+		{
+			name:     "異常系：自身を親にはできない",
+			tag:      &Tag{ID: 2, Name: "Container", ParentID: 1},
+			parentID: 2,
+			wantErr:  true,
+			errMsg:   "tag cannot be its own parent",
+		},
+		{
+			name:     "異常系：子孫を親にすると循環する",
+			tag:      &Tag{ID: 1, Name: "Infrastructure"},
+			parentID: 3,
+			wantErr:  true,
+			errMsg:   "parent tag would create a cycle",
+		},
+		{
+			name:     "異常系：存在しないタグは親にできない",
+			tag:      &Tag{ID: 4, Name: "Kubernetes"},
+			parentID: 99,
+			wantErr:  true,
+			errMsg:   "parent tag does not exist",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tag.SetParent(tt.parentID, tags)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.parentID, tt.tag.ParentID)
+			}
+		})
+	}
+}
+
+func TestTag_SetColorAndDescription(t *testing.T) {
+	t.Run("正常系：色は大文字に揃えて設定する", func(t *testing.T) {
+		tag := &Tag{ID: 1, Name: "Go"}
+
+		require.NoError(t, tag.SetColor("#00add8"))
+		assert.Equal(t, "#00ADD8", tag.Color)
+
+		require.NoError(t, tag.SetColor(""))
+		assert.Equal(t, "", tag.Color)
+	})
+
+	t.Run("異常系：#RRGGBB形式でない色", func(t *testing.T) {
+		tag := &Tag{ID: 1, Name: "Go", Color: "#00ADD8"}
+
+		for _, color := range []string{"red", "#FFF", "00ADD8", "#GGGGGG"} {
+			err := tag.SetColor(color)
+			require.Error(t, err, color)
+			assert.Contains(t, err.Error(), "color must be in #RRGGBB format")
+		}
+		assert.Equal(t, "#00ADD8", tag.Color)
+	})
+
+	t.Run("正常系：説明を設定する", func(t *testing.T) {
+		tag := &Tag{ID: 1, Name: "Go"}
+
+		require.NoError(t, tag.SetDescription("  Go言語に関する記事  "))
+		assert.Equal(t, "Go言語に関する記事", tag.Description)
+	})
+
+	t.Run("異常系：説明が長すぎる", func(t *testing.T) {
+		tag := &Tag{ID: 1, Name: "Go"}
+
+		err := tag.SetDescription(strings.Repeat("あ", 501))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "description must be 500 characters or less")
+	})
+}
@@ -0,0 +1,114 @@
+package entity
+
+import (
+	"cmp"
+	"errors"
+	"slices"
+)
+
+// タグの階層構造のノード
+type TagNode struct {
+	Tag      *Tag
+	Children []*TagNode
+}
+
+// タグの一覧から階層構造を組み立てる（各階層は名前順）
+// 親が見つからないタグはルートとして扱う
+func BuildTagTree(tags []*Tag) []*TagNode {
+	nodes := make(map[int64]*TagNode, len(tags))
+	for _, tag := range tags {
+		nodes[tag.ID] = &TagNode{Tag: tag, Children: []*TagNode{}}
+	}
+
+	roots := []*TagNode{}
+	for _, tag := range tags {
+		node := nodes[tag.ID]
+		parent, ok := nodes[tag.ParentID]
+		if tag.ParentID == 0 || !ok || parent == node {
+			roots = append(roots, node)
+			continue
+		}
+		parent.Children = append(parent.Children, node)
+	}
+
+	sortTagNodes(roots)
+	return roots
+}
+
+func sortTagNodes(nodes []*TagNode) {
+	slices.SortFunc(nodes, func(a, b *TagNode) int {
+		return cmp.Or(cmp.Compare(a.Tag.Name, b.Tag.Name), cmp.Compare(a.Tag.ID, b.Tag.ID))
+	})
+	for _, node := range nodes {
+		sortTagNodes(node.Children)
+	}
+}
+
+// 指定されたタグとその子孫のタグを返す
+func TagWithDescendants(tags []*Tag, id int64) []*Tag {
+	children := make(map[int64][]*Tag)
+	var root *Tag
+	for _, tag := range tags {
+		if tag.ID == id {
+			root = tag
+		}
+		if tag.ParentID != 0 {
+			children[tag.ParentID] = append(children[tag.ParentID], tag)
+		}
+	}
+	if root == nil {
+		return []*Tag{}
+	}
+
+	result := []*Tag{}
+	visited := make(map[int64]bool)
+	queue := []*Tag{root}
+	for len(queue) > 0 {
+		tag := queue[0]
+		queue = queue[1:]
+		if visited[tag.ID] {
+			continue
+		}
+		visited[tag.ID] = true
+		result = append(result, tag)
+		queue = append(queue, children[tag.ID]...)
+	}
+	return result
+}
+
+// タグの統合を検証する
+// 統合元の子タグは統合先に移すため、統合先が統合元の子孫の場合は循環するためエラーとする
+func ValidateTagMerge(targetID int64, sourceIDs []int64, tags []*Tag) error {
+	if len(sourceIDs) == 0 {
+		return errors.New("source tags are required")
+	}
+
+	parents := make(map[int64]int64, len(tags))
+	for _, tag := range tags {
+		parents[tag.ID] = tag.ParentID
+	}
+	if _, exists := parents[targetID]; !exists {
+		return errors.New("target tag does not exist")
+	}
+
+	sources := make(map[int64]bool, len(sourceIDs))
+	for _, id := range sourceIDs {
+		if id == targetID {
+			return errors.New("tag cannot be merged into itself")
+		}
+		if _, exists := parents[id]; !exists {
+			return errors.New("source tag does not exist")
+		}
+		sources[id] = true
+	}
+
+	visited := make(map[int64]bool)
+	for current := parents[targetID]; current != 0 && !visited[current]; current = parents[current] {
+		if sources[current] {
+			return errors.New("tag cannot be merged into its own descendant")
+		}
+		visited[current] = true
+	}
+
+	return nil
+}
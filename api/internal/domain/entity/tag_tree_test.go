@@ -0,0 +1,100 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTagTree(t *testing.T) {
+	t.Run("正常系：親子関係を名前順の木にまとめる", func(t *testing.T) {
+		tags := []*Tag{
+			{ID: 1, Name: "Infrastructure"},
+			{ID: 2, Name: "Kubernetes", ParentID: 1},
+			{ID: 3, Name: "Docker", ParentID: 1},
+			{ID: 4, Name: "Go"},
+			{ID: 5, Name: "Helm", ParentID: 2},
+		}
+
+		roots := BuildTagTree(tags)
+
+		require.Len(t, roots, 2)
+		assert.Equal(t, "Go", roots[0].Tag.Name)
+		assert.Empty(t, roots[0].Children)
+		assert.Equal(t, "Infrastructure", roots[1].Tag.Name)
+		require.Len(t, roots[1].Children, 2)
+		assert.Equal(t, "Docker", roots[1].Children[0].Tag.Name)
+		assert.Equal(t, "Kubernetes", roots[1].Children[1].Tag.Name)
+		require.Len(t, roots[1].Children[1].Children, 1)
+		assert.Equal(t, "Helm", roots[1].Children[1].Children[0].Tag.Name)
+	})
+
+	t.Run("正常系：親が存在しないタグはルートになる", func(t *testing.T) {
+		roots := BuildTagTree([]*Tag{{ID: 2, Name: "Docker", ParentID: 1}})
+
+		require.Len(t, roots, 1)
+		assert.Equal(t, "Docker", roots[0].Tag.Name)
+	})
+}
+
+func TestTagWithDescendants(t *testing.T) {
+	tags := []*Tag{
+		{ID: 1, Name: "Infrastructure"},
+		{ID: 2, Name: "Kubernetes", ParentID: 1},
+		{ID: 3, Name: "Helm", ParentID: 2},
+		{ID: 4, Name: "Go"},
+	}
+
+	t.Run("正常系：子孫のタグを含めて返す", func(t *testing.T) {
+		result := TagWithDescendants(tags, 1)
+
+		names := make([]string, 0, len(result))
+		for _, tag := range result {
+			names = append(names, tag.Name)
+		}
+		assert.Equal(t, []string{"Infrastructure", "Kubernetes", "Helm"}, names)
+	})
+
+	t.Run("正常系：存在しないタグの場合は空", func(t *testing.T) {
+		assert.Empty(t, TagWithDescendants(tags, 99))
+	})
+}
+
+func TestValidateTagMerge(t *testing.T) {
+	// Language(1) ─ Go(2) ─ Goroutine(3), golang(4)
+	tags := []*Tag{
+		{ID: 1, Name: "Language"},
+		{ID: 2, Name: "Go", ParentID: 1},
+		{ID: 3, Name: "Goroutine", ParentID: 2},
+		{ID: 4, Name: "golang"},
+	}
+
+	tests := []struct {
+		name      string
+		targetID  int64
+		sourceIDs []int64
+		errMsg    string
+	}{
+		{name: "正常系：別のタグを統合できる", targetID: 2, sourceIDs: []int64{4}},
+		{name: "正常系：子タグを親に統合できる", targetID: 2, sourceIDs: []int64{3}},
+		{name: "異常系：統合元が空", targetID: 2, sourceIDs: nil, errMsg: "source tags are required"},
+		{name: "異常系：自身には統合できない", targetID: 2, sourceIDs: []int64{2}, errMsg: "tag cannot be merged into itself"},
+		{name: "異常系：存在しない統合元", targetID: 2, sourceIDs: []int64{99}, errMsg: "source tag does not exist"},
+		{name: "異常系：存在しない統合先", targetID: 99, sourceIDs: []int64{4}, errMsg: "target tag does not exist"},
+		{name: "異常系：子孫には統合できない", targetID: 3, sourceIDs: []int64{1}, errMsg: "tag cannot be merged into its own descendant"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTagMerge(tt.targetID, tt.sourceIDs, tags)
+
+			if tt.errMsg == "" {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package entity
+
+import "time"
+
+// タグの使用状況
+type TagUsage struct {
+	TagID        int64
+	ArticleCount int
+	LastUsedAt   time.Time // 最後に記事に付けられた日時（未使用の場合はゼロ値）
+}
+
+// 未使用のタグか
+func (u TagUsage) Unused() bool {
+	return u.ArticleCount == 0
+}
+
+// 月ごとの記事数
+type TagMonthlyCount struct {
+	Month string // YYYY-MM形式
+	Count int
+}
+
+// 月ごとの記事数の推移
+type TagGrowthPoint struct {
+	Month string // YYYY-MM形式
+	Count int    // その月に追加された記事数
+	Total int    // その月までの累計
+}
+
+// タグの使用状況と記事数の推移
+type TagStats struct {
+	Tag    *Tag
+	Usage  TagUsage
+	Growth []TagGrowthPoint
+}
+
+// 月ごとの記事数から、fromの月からtoの月までの推移を作成する
+// 記事のない月は0件とし、累計にはfromより前の記事数も含める
+func BuildTagGrowth(counts []TagMonthlyCount, from, to time.Time) []TagGrowthPoint {
+	start := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, time.UTC)
+	startMonth := start.Format("2006-01")
+
+	monthly := make(map[string]int, len(counts))
+	total := 0
+	for _, c := range counts {
+		if c.Month < startMonth {
+			total += c.Count
+			continue
+		}
+		monthly[c.Month] += c.Count
+	}
+
+	growth := make([]TagGrowthPoint, 0)
+	for month := start; !month.After(end); month = month.AddDate(0, 1, 0) {
+		key := month.Format("2006-01")
+		total += monthly[key]
+		growth = append(growth, TagGrowthPoint{Month: key, Count: monthly[key], Total: total})
+	}
+	return growth
+}
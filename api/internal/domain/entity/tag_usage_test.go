@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildTagGrowth(t *testing.T) {
+	from := time.Date(2026, 1, 15, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("正常系：記事のない月を0件とし、以前の記事を累計に含める", func(t *testing.T) {
+		counts := []TagMonthlyCount{
+			{Month: "2025-11", Count: 2},
+			{Month: "2026-01", Count: 1},
+			{Month: "2026-03", Count: 3},
+		}
+
+		growth := BuildTagGrowth(counts, from, to)
+
+		assert.Equal(t, []TagGrowthPoint{
+			{Month: "2026-01", Count: 1, Total: 3},
+			{Month: "2026-02", Count: 0, Total: 3},
+			{Month: "2026-03", Count: 3, Total: 6},
+			{Month: "2026-04", Count: 0, Total: 6},
+		}, growth)
+	})
+
+	t.Run("正常系：記事がない場合はすべて0件", func(t *testing.T) {
+		growth := BuildTagGrowth(nil, from, from)
+
+		assert.Equal(t, []TagGrowthPoint{{Month: "2026-01", Count: 0, Total: 0}}, growth)
+	})
+}
@@ -0,0 +1,71 @@
+package entity
+
+import (
+	"errors"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// 同一ページの判定で無視するトラッキング用のクエリパラメータ
+var trackingQueryParams = map[string]bool{
+	"fbclid":  true,
+	"gclid":   true,
+	"yclid":   true,
+	"mc_cid":  true,
+	"mc_eid":  true,
+	"ref_src": true,
+}
+
+// 同一ページを指すURLを同じ表記に揃える
+// スキームとホストの小文字化、デフォルトポート・フラグメント・トラッキング用パラメータの除去、
+// クエリパラメータの並べ替え、末尾のスラッシュの除去を行う
+func CanonicalizeURL(rawURL string) (string, error) {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return "", err
+	}
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", errors.New("url must start with http:// or https://")
+	}
+	if parsed.Host == "" {
+		return "", errors.New("url must have a host")
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	port := parsed.Port()
+	if (parsed.Scheme == "http" && port == "80") || (parsed.Scheme == "https" && port == "443") {
+		port = ""
+	}
+	parsed.Host = host
+	if port != "" {
+		parsed.Host = host + ":" + port
+	}
+
+	parsed.Fragment = ""
+	parsed.RawFragment = ""
+	parsed.User = nil
+
+	if len(parsed.Path) > 1 {
+		parsed.Path = strings.TrimRight(parsed.Path, "/")
+		parsed.RawPath = ""
+	}
+	if parsed.Path == "/" {
+		parsed.Path = ""
+	}
+
+	query := parsed.Query()
+	for key := range query {
+		if trackingQueryParams[strings.ToLower(key)] || strings.HasPrefix(strings.ToLower(key), "utm_") {
+			query.Del(key)
+		}
+	}
+	for _, values := range query {
+		sort.Strings(values)
+	}
+	// Encodeはキーの順に並べて出力する
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String(), nil
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalizeURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{name: "正常系：スキームとホストを小文字にする", input: "HTTPS://Example.COM/Path", expected: "https://example.com/Path"},
+		{name: "正常系：デフォルトポートを除去する", input: "https://example.com:443/a", expected: "https://example.com/a"},
+		{name: "正常系：デフォルト以外のポートは残す", input: "http://example.com:8080/a", expected: "http://example.com:8080/a"},
+		{name: "正常系：フラグメントと末尾のスラッシュを除去する", input: "https://example.com/a/b/#section", expected: "https://example.com/a/b"},
+		{name: "正常系：ルートパスは空にする", input: "https://example.com/", expected: "https://example.com"},
+		{name: "正常系：トラッキング用パラメータを除去し並べ替える", input: "https://example.com/a?utm_source=x&b=2&fbclid=y&a=1", expected: "https://example.com/a?a=1&b=2"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canonical, err := CanonicalizeURL(tt.input)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, canonical)
+		})
+	}
+
+	t.Run("異常系：スキームが不正", func(t *testing.T) {
+		_, err := CanonicalizeURL("ftp://example.com")
+		assert.Error(t, err)
+	})
+
+	t.Run("異常系：ホストがない", func(t *testing.T) {
+		_, err := CanonicalizeURL("https:///path")
+		assert.Error(t, err)
+	})
+}
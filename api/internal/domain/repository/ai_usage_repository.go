@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"article-manager/internal/domain/entity"
+)
+
+// AI使用量の台帳へのアクセス操作を定義
+type AIUsageRepository interface {
+	// 使用量を記録
+	Create(ctx context.Context, usage *entity.AIUsage) (*entity.AIUsage, error)
+
+	// 期間内（from以上to未満）の使用量をlocationでの日または月と操作ごとに集計し、期間・操作の順に取得
+	SummarizeByPeriod(ctx context.Context, from, to time.Time, period entity.AIUsagePeriod, location *time.Location) ([]*entity.AIUsageSummary, error)
+
+	// 期間内（from以上to未満）の合計トークン数を取得
+	SumTotalTokens(ctx context.Context, from, to time.Time) (int64, error)
+}
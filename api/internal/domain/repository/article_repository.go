@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"article-manager/internal/domain/entity"
 )
@@ -20,9 +21,24 @@ type ArticleRepository interface {
 	// 記事を更新
 	Update(ctx context.Context, article *entity.Article) (*entity.Article, error)
 
+	// 記事のURLのみを更新し、メタデータを破棄（URLがpreviousURLのままの場合のみ更新し、それ以外はNotFoundを返す）
+	UpdateURL(ctx context.Context, article *entity.Article, previousURL string) error
+
+	// 記事のメタデータのみを更新（取得中にURLが変わっていた場合はNotFoundを返す）
+	UpdateMetadata(ctx context.Context, id int64, url string, metadata entity.ArticleMetadata) error
+
+	// メタデータの取得失敗を記録（取得中にURLが変わっていた場合はNotFoundを返す）
+	RecordMetadataFetchFailure(ctx context.Context, id int64, url string, failedAt time.Time) error
+
 	// 指定されたIDの記事を削除
 	Delete(ctx context.Context, id int64) error
 
 	// 曖昧検索でタイトルまたは要約から記事を検索
 	Search(ctx context.Context, keyword string) ([]*entity.Article, error)
+
+	// 作成日時が[from, to)の記事を古い順に取得
+	FindByCreatedAtRange(ctx context.Context, from, to time.Time) ([]*entity.Article, error)
+
+	// 記事に付けられたタグの共起グラフを集計
+	FindTagGraph(ctx context.Context, opts entity.TagGraphOptions) (*entity.TagGraph, error)
 }
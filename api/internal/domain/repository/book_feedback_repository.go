@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"article-manager/internal/domain/entity"
+)
+
+// 書籍フィードバックデータへのアクセス操作を定義
+type BookFeedbackRepository interface {
+	// 新しいフィードバックを保存（同じ書籍のフィードバックがある場合はAlreadyExists）
+	Create(ctx context.Context, feedback *entity.BookFeedback) (*entity.BookFeedback, error)
+
+	// 指定されたIDのフィードバックを取得
+	FindByID(ctx context.Context, id int64) (*entity.BookFeedback, error)
+
+	// すべてのフィードバックを更新の新しい順に取得
+	FindAll(ctx context.Context) ([]*entity.BookFeedback, error)
+
+	// フィードバックを更新
+	Update(ctx context.Context, feedback *entity.BookFeedback) (*entity.BookFeedback, error)
+
+	// 指定されたIDのフィードバックを削除
+	Delete(ctx context.Context, id int64) error
+}
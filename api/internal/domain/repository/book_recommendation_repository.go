@@ -2,14 +2,23 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"article-manager/internal/domain/entity"
 )
 
 // 記事推薦キャッシュデータへのアクセス操作を定義
 type BookRecommendationRepository interface {
-	// 最新の有効なキャッシュを取得
-	FindLatestValid(ctx context.Context) (*entity.BookRecommendationCache, error)
-	// キャッシュを保存
+	// 指定された対象範囲の最新の有効なキャッシュを取得
+	FindLatestValid(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error)
+	// 指定されたIDの推薦を取得（有効期限が切れていても取得する）
+	FindByID(ctx context.Context, id int64) (*entity.BookRecommendationCache, error)
+	// 有効期限がbeforeまでに切れる、対象範囲ごとの最新の有効なキャッシュを取得
+	FindValidExpiringBefore(ctx context.Context, before time.Time) ([]*entity.BookRecommendationCache, error)
+	// 指定された対象範囲の推薦の履歴を新しい順に最大limit件取得
+	FindHistory(ctx context.Context, scopeKey string, limit int) ([]*entity.BookRecommendationCache, error)
+	// キャッシュを保存（過去の推薦は履歴として残す）
 	Save(ctx context.Context, cache *entity.BookRecommendationCache) (*entity.BookRecommendationCache, error)
+	// before より前に生成された推薦を削除し、削除件数を返す（対象範囲ごとの最新の推薦は残す）
+	DeleteGeneratedBefore(ctx context.Context, before time.Time) (int64, error)
 }
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"article-manager/internal/domain/entity"
+)
+
+// コレクションデータへのアクセス操作を定義
+type CollectionRepository interface {
+	// 新しいコレクションを保存（同じ名前のコレクションがある場合はAlreadyExists）
+	Create(ctx context.Context, collection *entity.Collection) (*entity.Collection, error)
+
+	// 指定されたIDのコレクションを取得
+	FindByID(ctx context.Context, id int64) (*entity.Collection, error)
+
+	// すべてのコレクションを名前順に取得
+	FindAll(ctx context.Context) ([]*entity.Collection, error)
+
+	// コレクションの名前と説明を更新（掲載する記事は変更しない）
+	Update(ctx context.Context, collection *entity.Collection) (*entity.Collection, error)
+
+	// 指定されたIDのコレクションをロックして読み込み、modifyで変更した掲載する記事を保存する
+	// 同時に変更されても互いの変更が失われないよう、読み込みから保存までを1つのトランザクションで行う
+	// modifyがエラーを返した場合は保存せずにそのエラーを返す
+	UpdateArticles(ctx context.Context, id int64, modify func(collection *entity.Collection) error) (*entity.Collection, error)
+
+	// 2つのコレクションをロックして読み込み、modifyで記事を移した結果を1つのトランザクションで保存する
+	Move(ctx context.Context, sourceID, targetID int64, modify func(source, target *entity.Collection) error) error
+
+	// 指定されたIDのコレクションを削除
+	Delete(ctx context.Context, id int64) error
+}
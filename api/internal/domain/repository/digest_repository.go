@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"article-manager/internal/domain/entity"
+)
+
+// ダイジェストへのアクセス操作を定義
+type DigestRepository interface {
+	// ダイジェストを保存（同じ期間のダイジェストが存在する場合はAlreadyExistsエラー）
+	Create(ctx context.Context, digest *entity.Digest) (*entity.Digest, error)
+
+	// 指定されたIDのダイジェストを取得
+	FindByID(ctx context.Context, id int64) (*entity.Digest, error)
+
+	// すべてのダイジェストを新しい期間順に取得
+	FindAll(ctx context.Context) ([]*entity.Digest, error)
+
+	// 最も新しい期間のダイジェストを取得（存在しない場合はnil）
+	FindLatest(ctx context.Context) (*entity.Digest, error)
+}
@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"article-manager/internal/domain/entity"
+)
+
+// リンクヘルスデータへのアクセス操作を定義
+type LinkHealthRepository interface {
+	// リンクヘルスを保存（記事ごとに最新の結果で上書き）
+	Save(ctx context.Context, health *entity.LinkHealth) (*entity.LinkHealth, error)
+
+	// 指定された記事IDのリンクヘルスを取得
+	FindByArticleID(ctx context.Context, articleID int64) (*entity.LinkHealth, error)
+
+	// すべてのリンクヘルスを取得
+	FindAll(ctx context.Context) ([]*entity.LinkHealth, error)
+
+	// 指定された状態のリンクヘルスを取得
+	FindByStatus(ctx context.Context, status entity.LinkStatus) ([]*entity.LinkHealth, error)
+}
@@ -0,0 +1,25 @@
+package repository
+
+import (
+	"context"
+
+	"article-manager/internal/domain/entity"
+)
+
+// 読書リストデータへのアクセス操作を定義
+type ReadingListRepository interface {
+	// 新しい書籍を保存（同じ書籍がリストにある場合はAlreadyExists）
+	Create(ctx context.Context, item *entity.ReadingListItem) (*entity.ReadingListItem, error)
+
+	// 指定されたIDの書籍を取得
+	FindByID(ctx context.Context, id int64) (*entity.ReadingListItem, error)
+
+	// 書籍を追加の新しい順に取得（statusが空の場合はすべての状態）
+	FindAll(ctx context.Context, status entity.ReadingStatus) ([]*entity.ReadingListItem, error)
+
+	// 書籍を更新
+	Update(ctx context.Context, item *entity.ReadingListItem) (*entity.ReadingListItem, error)
+
+	// 指定されたIDの書籍を削除
+	Delete(ctx context.Context, id int64) error
+}
@@ -14,15 +14,46 @@ type TagRepository interface {
 	// 指定されたIDのタグを取得
 	FindByID(ctx context.Context, id int64) (*entity.Tag, error)
 
-	// 指定されたIDのタグを取得
+	// 指定された名前のタグを取得（別名の場合は代表のタグを返す）
 	FindByName(ctx context.Context, name string) (*entity.Tag, error)
 
 	// すべてのタグを取得
 	FindAll(ctx context.Context) ([]*entity.Tag, error)
 
+	// 名前が前方一致するタグを名前順に最大limit件取得
+	FindByNamePrefix(ctx context.Context, prefix string, limit int) ([]*entity.Tag, error)
+
 	// タグを更新
 	Update(ctx context.Context, tag *entity.Tag) (*entity.Tag, error)
 
+	// タグ名の一意性の判定に使う正規化方針を取得
+	NamePolicy() entity.TagNamePolicy
+
 	// 指定されたIDのタグを削除
 	Delete(ctx context.Context, id int64) error
+
+	// 統合元のタグを統合先のタグに1つのトランザクションで統合する
+	// 記事の関連付け・子タグ・別名を統合先に移し、統合元の名前は統合先の別名として残す
+	Merge(ctx context.Context, targetID int64, sourceIDs []int64) (*entity.Tag, error)
+
+	// 別名を保存（タグ名や既存の別名と重複する場合はAlreadyExists）
+	CreateAlias(ctx context.Context, alias *entity.TagAlias) (*entity.TagAlias, error)
+
+	// 指定されたタグの別名を名前順に取得
+	FindAliases(ctx context.Context, tagID int64) ([]*entity.TagAlias, error)
+
+	// 指定されたタグの別名を削除
+	DeleteAlias(ctx context.Context, tagID int64, alias string) error
+
+	// 記事に付けられているタグごとの記事数と最終使用日時を取得（記事のないタグは含まない）
+	FindUsages(ctx context.Context) ([]entity.TagUsage, error)
+
+	// 指定されたタグの記事数と最終使用日時を取得（記事のないタグは含まない）
+	FindUsagesByTagIDs(ctx context.Context, tagIDs []int64) ([]entity.TagUsage, error)
+
+	// 指定されたタグの記事数を記事の作成月ごとに古い順で取得
+	FindMonthlyArticleCounts(ctx context.Context, tagID int64) ([]entity.TagMonthlyCount, error)
+
+	// 記事にも子タグにも使われていないタグを削除し、削除したタグを返す
+	DeleteUnused(ctx context.Context) ([]*entity.Tag, error)
 }
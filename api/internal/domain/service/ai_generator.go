@@ -7,7 +7,16 @@ import (
 
 // 記事生成リクエスト
 type ArticleGenerationRequest struct {
-	URL string
+	URL          string
+	Options      ArticleGenerationOptions
+	ExistingTags []string // タグ提案時に優先して使用する既存タグ名
+}
+
+// リクエスト単位で指定できる生成オプション（ゼロ値の項目はデフォルト値を使用）
+type ArticleGenerationOptions struct {
+	SummaryLength int    // 要約の最大文字数
+	Language      string // 出力言語（"ja", "en"などの言語コード）
+	TagCount      int    // 提案するタグの最大数
 }
 
 // 生成された記事の結果
@@ -17,12 +26,16 @@ type GeneratedArticle struct {
 	SuggestedTags []string
 	SourceURL     string
 	TokenUsed     int
+	PromptVersion string // 生成に使用したプロンプトテンプレートのバージョン
 	GeneratedAt   time.Time
 }
 
 // AIを使用した記事生成サービスのインターフェース
 type AIGeneratorService interface {
 	GenerateArticleFromURL(ctx context.Context, req ArticleGenerationRequest) (*GeneratedArticle, error)
+
+	// 記事生成に使用するプロンプトのバージョン（生成結果のキャッシュキーに使用）
+	ArticlePromptVersion() string
 }
 
 // AI生成処理固有のエラー
@@ -45,11 +58,14 @@ func (e *AIGeneratorError) Unwrap() error {
 
 // エラーコード定数
 const (
-	ErrCodeInvalidURL      = "INVALID_URL"
-	ErrCodeAPILimit        = "API_LIMIT_EXCEEDED"
-	ErrCodeTimeout         = "TIMEOUT"
-	ErrCodeContentBlocked  = "CONTENT_BLOCKED"
-	ErrCodeInvalidResponse = "INVALID_RESPONSE"
-	ErrCodeNetworkError    = "NETWORK_ERROR"
-	ErrCodeUnauthorized    = "UNAUTHORIZED"
+	ErrCodeInvalidURL         = "INVALID_URL"
+	ErrCodeAPILimit           = "API_LIMIT_EXCEEDED"
+	ErrCodeTimeout            = "TIMEOUT"
+	ErrCodeContentBlocked     = "CONTENT_BLOCKED"
+	ErrCodeInvalidResponse    = "INVALID_RESPONSE"
+	ErrCodeNetworkError       = "NETWORK_ERROR"
+	ErrCodeUnauthorized       = "UNAUTHORIZED"
+	ErrCodePromptTemplate     = "PROMPT_TEMPLATE_ERROR"
+	ErrCodeBudgetExceeded     = "AI_BUDGET_EXCEEDED"
+	ErrCodeServiceUnavailable = "AI_SERVICE_UNAVAILABLE"
 )
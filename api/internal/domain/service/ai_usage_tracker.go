@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"article-manager/internal/domain/entity"
+)
+
+// AI呼び出しの使用量を記録し、予算を管理するインターフェース
+type AIUsageTracker interface {
+	// 予算を使い切っている場合はErrCodeBudgetExceededのAIGeneratorErrorを返す
+	CheckBudget(ctx context.Context) error
+
+	// 呼び出し1回分の使用量を記録（記録の失敗は呼び出し元に返さない）
+	RecordUsage(ctx context.Context, usage *entity.AIUsage)
+}
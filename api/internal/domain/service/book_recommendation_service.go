@@ -7,8 +7,19 @@ import (
 )
 
 // 書籍推薦サービスのインターフェース
+// feedbackは推薦の参考にする書籍へのフィードバック（気に入った書籍・所有している書籍）
 type BookRecommendationService interface {
-	RecommendBooks(ctx context.Context, articles []*entity.Article) ([]entity.Book, error)
+	RecommendBooks(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error)
+	// 推薦に使用するモデル名
+	RecommendationModel() string
+	// 推薦に使用するプロンプトのバージョン
+	RecommendationPromptVersion() string
+}
+
+// 書籍の実在を確認し、書誌情報を取得するインターフェース
+type BookLookup interface {
+	// 確認できない場合は(nil, nil)を返す
+	LookupBook(ctx context.Context, title, author string) (*entity.Book, error)
 }
 
 // 書籍推薦処理固有のエラー
@@ -0,0 +1,16 @@
+package service
+
+import "context"
+
+// ダイジェストのグループ概要生成リクエスト
+type DigestGroupRequest struct {
+	Tag      string
+	Articles []SourceArticle
+	Language string // 概要の言語コード（空の場合はデフォルト）
+}
+
+// ダイジェストのグループ概要を生成するサービスのインターフェース
+// エラーはAIGeneratorErrorで返す
+type DigestSummarizer interface {
+	SummarizeDigestGroup(ctx context.Context, req DigestGroupRequest) (string, error)
+}
@@ -0,0 +1,25 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// AI生成結果のキャッシュ
+// TTLとエントリ数の上限は実装側で管理する
+type GenerationCache interface {
+	// キャッシュされた生成結果を取得（存在しないか期限切れの場合はfalse）
+	Get(ctx context.Context, key string) (*GeneratedArticle, bool, error)
+
+	// 生成結果を保存
+	Set(ctx context.Context, key string, article *GeneratedArticle) error
+}
+
+// 正規化済みURL・プロンプトバージョン・生成オプションからキャッシュキーを生成する
+func GenerationCacheKey(canonicalURL, promptVersion string, options ArticleGenerationOptions) string {
+	raw := fmt.Sprintf("%s\n%s\n%d\n%s\n%d", canonicalURL, promptVersion, options.SummaryLength, options.Language, options.TagCount)
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
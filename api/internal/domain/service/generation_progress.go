@@ -0,0 +1,48 @@
+package service
+
+import "context"
+
+// 記事生成の進捗段階
+type GenerationStage string
+
+const (
+	GenerationStageFetching     GenerationStage = "fetching"      // 記事ページのメタデータ取得
+	GenerationStageCacheHit     GenerationStage = "cache_hit"     // 生成キャッシュを使用
+	GenerationStageCallingModel GenerationStage = "calling_model" // AIモデルの呼び出し
+	GenerationStageRetrying     GenerationStage = "retrying"      // AIモデル呼び出しのリトライ
+	GenerationStagePartial      GenerationStage = "partial"       // 生成途中の要約テキスト
+	GenerationStageParsing      GenerationStage = "parsing"       // 生成結果の解析
+	GenerationStageCreatingTags GenerationStage = "creating_tags" // タグの対応付けと作成
+	GenerationStageSaving       GenerationStage = "saving"        // 記事の保存
+)
+
+// 記事生成の進捗
+type GenerationProgress struct {
+	Stage   GenerationStage
+	Attempt int    // calling_model・retrying・parsingの試行回数（1始まり）
+	Message string // 補足情報（リトライの理由など）
+	Text    string // partialの場合、これまでに生成された要約テキスト
+}
+
+// 進捗を受け取る関数
+type GenerationProgressFunc func(progress GenerationProgress)
+
+type generationProgressKey struct{}
+
+// 進捗の通知先を設定したコンテキストを返す
+func WithGenerationProgress(ctx context.Context, fn GenerationProgressFunc) context.Context {
+	return context.WithValue(ctx, generationProgressKey{}, fn)
+}
+
+// 通知先が設定されているか
+func HasGenerationProgress(ctx context.Context) bool {
+	fn, _ := ctx.Value(generationProgressKey{}).(GenerationProgressFunc)
+	return fn != nil
+}
+
+// 進捗を通知（通知先が設定されていない場合は何もしない）
+func ReportGenerationProgress(ctx context.Context, progress GenerationProgress) {
+	if fn, ok := ctx.Value(generationProgressKey{}).(GenerationProgressFunc); ok && fn != nil {
+		fn(progress)
+	}
+}
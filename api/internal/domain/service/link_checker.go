@@ -0,0 +1,17 @@
+package service
+
+import (
+	"context"
+)
+
+// リンクチェックの結果
+type LinkCheckResult struct {
+	StatusCode        int
+	FinalURL          string
+	PermanentRedirect bool // すべてのリダイレクトが恒久的(301/308)な場合にtrue
+}
+
+// 記事URLの死活確認を行うサービスのインターフェース
+type LinkChecker interface {
+	CheckLink(ctx context.Context, url string) (*LinkCheckResult, error)
+}
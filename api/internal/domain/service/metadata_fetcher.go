@@ -0,0 +1,12 @@
+package service
+
+import (
+	"context"
+
+	"article-manager/internal/domain/entity"
+)
+
+// 記事ページのメタデータ（Open Graph・favicon等）を取得するサービスのインターフェース
+type MetadataFetcher interface {
+	FetchMetadata(ctx context.Context, url string) (*entity.ArticleMetadata, error)
+}
@@ -0,0 +1,33 @@
+package service
+
+import "context"
+
+// AIに根拠として渡す保存済み記事
+type SourceArticle struct {
+	ArticleID int64
+	Title     string
+	URL       string
+	Summary   string
+	Memo      string
+	Tags      []string
+}
+
+// 質問応答リクエスト
+type QuestionAnswerRequest struct {
+	Question string
+	Sources  []SourceArticle
+	Language string // 回答の言語コード（空の場合はデフォルト）
+}
+
+// 質問への回答
+type QuestionAnswer struct {
+	Answer          string
+	CitedArticleIDs []int64 // 回答の根拠として引用した記事のID
+	TokenUsed       int
+}
+
+// 保存済み記事を根拠に質問へ回答するサービスのインターフェース
+// エラーはAIGeneratorErrorで返す
+type QuestionAnswerer interface {
+	AnswerQuestion(ctx context.Context, req QuestionAnswerRequest) (*QuestionAnswer, error)
+}
@@ -0,0 +1,49 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"article-manager/internal/domain/service"
+)
+
+// LLMを呼び出さずに回答を組み立てるQuestionAnswerer
+// オフラインでの動作確認やテストに使用する
+type FakeQuestionAnswerer struct{}
+
+// コンストラクタ
+func NewFakeQuestionAnswerer() service.QuestionAnswerer {
+	return &FakeQuestionAnswerer{}
+}
+
+// 渡された記事の要約を並べた回答を返し、すべての記事を引用する
+func (f *FakeQuestionAnswerer) AnswerQuestion(ctx context.Context, req service.QuestionAnswerRequest) (*service.QuestionAnswer, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, &service.AIGeneratorError{
+			Code:    service.ErrCodeTimeout,
+			Message: "Context cancelled",
+			Err:     err,
+		}
+	}
+
+	if len(req.Sources) == 0 {
+		return &service.QuestionAnswer{
+			Answer:          "保存された記事から回答できる情報が見つかりませんでした。",
+			CitedArticleIDs: []int64{},
+		}, nil
+	}
+
+	lines := make([]string, 0, len(req.Sources)+1)
+	lines = append(lines, fmt.Sprintf("「%s」に関連する保存済みの記事:", req.Question))
+	cited := make([]int64, 0, len(req.Sources))
+	for _, source := range req.Sources {
+		lines = append(lines, fmt.Sprintf("- %s: %s [#%d]", source.Title, source.Summary, source.ArticleID))
+		cited = append(cited, source.ArticleID)
+	}
+
+	return &service.QuestionAnswer{
+		Answer:          strings.Join(lines, "\n"),
+		CitedArticleIDs: cited,
+	}, nil
+}
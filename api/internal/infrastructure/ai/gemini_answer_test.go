@@ -0,0 +1,64 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"article-manager/internal/domain/service"
+)
+
+func TestGeminiClient_AnswerQuestion(t *testing.T) {
+	var received geminiRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+		w.Write([]byte(`{
+			"candidates": [{"content": {"parts": [{"text": "{\"answer\": \"HPAを使います [#7]\", \"citations\": [7]}"}]}}],
+			"usageMetadata": {"totalTokenCount": 42}
+		}`))
+	}))
+	defer server.Close()
+
+	config := DefaultGeminiConfig("test-api-key")
+	config.BaseURL = server.URL
+	config.MaxRetries = 0
+	client := NewGeminiClient(config)
+
+	answer, err := client.AnswerQuestion(context.Background(), service.QuestionAnswerRequest{
+		Question: "オートスケーリングの方法は？",
+		Sources: []service.SourceArticle{
+			{ArticleID: 7, Title: "Kubernetes HPA", URL: "https://example.com/hpa", Summary: "Pod数を自動調整", Memo: "本番で採用"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if answer.Answer != "HPAを使います [#7]" {
+		t.Errorf("Unexpected answer '%s'", answer.Answer)
+	}
+	if len(answer.CitedArticleIDs) != 1 || answer.CitedArticleIDs[0] != 7 {
+		t.Errorf("Expected citations [7], got %v", answer.CitedArticleIDs)
+	}
+	if answer.TokenUsed != 42 {
+		t.Errorf("Expected 42 token used, got %d", answer.TokenUsed)
+	}
+
+	prompt := received.Contents[0].Parts[0].Text
+	for _, want := range []string{"[#7] タイトル: Kubernetes HPA", "メモ: 本番で採用", "オートスケーリングの方法は？", "日本語で出力"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("Expected prompt to contain '%s', got:\n%s", want, prompt)
+		}
+	}
+	if schema := received.GenerationConfig.ResponseSchema; schema == nil || strings.Join(schema.Required, ",") != "answer,citations" {
+		t.Errorf("Expected answer response schema, got %+v", schema)
+	}
+	if len(received.Tools) != 0 {
+		t.Errorf("Expected no tools, got %v", received.Tools)
+	}
+}
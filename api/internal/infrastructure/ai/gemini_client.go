@@ -3,14 +3,17 @@ package ai
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
 	"strings"
 	"time"
 
 	"article-manager/internal/domain/entity"
 	"article-manager/internal/domain/service"
+	"article-manager/internal/infrastructure/resilience"
 )
 
 // Gemini API設定
@@ -21,6 +24,24 @@ type GeminiConfig struct {
 	Timeout       time.Duration
 	MaxRetries    int
 	RetryWaitTime time.Duration
+	// リトライ間隔の上限（指数バックオフ）
+	MaxRetryWaitTime time.Duration
+	// これより長いRetry-Afterを指定された場合はリトライしない
+	MaxRetryAfter time.Duration
+	// 1秒あたりのリクエスト数とバースト（0以下の場合は制限しない）
+	RequestsPerSecond float64
+	RateBurst         int
+	// サーキットを開く連続失敗数と、開いてから試行呼び出しを行うまでの時間
+	BreakerFailureThreshold int
+	BreakerOpenTimeout      time.Duration
+	// スキーマに適合しないレスポンスに対して修正を依頼する最大回数
+	MaxRepairAttempts int
+	// プロンプトテンプレート（nilの場合は埋め込みのデフォルトを使用）
+	Prompts *PromptTemplates
+	// 使用量の記録と予算管理（nilの場合は記録しない）
+	UsageTracker service.AIUsageTracker
+	// 進捗の通知先がある場合にstreamGenerateContentで生成途中の要約を通知する
+	StreamPartialText bool
 }
 
 // デフォルトGemini API設定
@@ -32,6 +53,17 @@ func DefaultGeminiConfig(apiKey string) *GeminiConfig {
 		Timeout:       30 * time.Second,
 		MaxRetries:    3,
 		RetryWaitTime: 2 * time.Second,
+
+		MaxRetryWaitTime:        30 * time.Second,
+		MaxRetryAfter:           time.Minute,
+		RequestsPerSecond:       1,
+		RateBurst:               5,
+		BreakerFailureThreshold: 5,
+		BreakerOpenTimeout:      30 * time.Second,
+
+		MaxRepairAttempts: 1,
+		Prompts:           DefaultPromptTemplates(),
+		StreamPartialText: true,
 	}
 }
 
@@ -39,18 +71,37 @@ func DefaultGeminiConfig(apiKey string) *GeminiConfig {
 type GeminiClient struct {
 	config     *GeminiConfig
 	httpClient *http.Client
+	guard      *resilience.Guard
 }
 
 // 新しいクライアントを作成
 func NewGeminiClient(config *GeminiConfig) *GeminiClient {
+	if config.Prompts == nil {
+		config.Prompts = DefaultPromptTemplates()
+	}
 	return &GeminiClient{
 		config: config,
 		httpClient: &http.Client{
 			Timeout: config.Timeout,
 		},
+		guard: resilience.NewGuard("gemini", resilience.Config{
+			MaxRetries:        config.MaxRetries,
+			InitialBackoff:    config.RetryWaitTime,
+			MaxBackoff:        config.MaxRetryWaitTime,
+			MaxRetryAfter:     config.MaxRetryAfter,
+			RequestsPerSecond: config.RequestsPerSecond,
+			Burst:             config.RateBurst,
+			FailureThreshold:  config.BreakerFailureThreshold,
+			OpenTimeout:       config.BreakerOpenTimeout,
+		}),
 	}
 }
 
+// Gemini API呼び出しのサーキットブレーカー
+func (c *GeminiClient) CircuitBreaker() *resilience.CircuitBreaker {
+	return c.guard.Breaker()
+}
+
 // Gemini APIリクエスト構造
 type geminiRequest struct {
 	Contents         []geminiContent         `json:"contents"`
@@ -74,8 +125,10 @@ type geminiTool struct {
 type geminiURLContext struct{}
 
 type geminiGenerationConfig struct {
-	Temperature     float32 `json:"temperature,omitempty"`
-	MaxOutputTokens int     `json:"maxOutputTokens,omitempty"`
+	Temperature      float32       `json:"temperature,omitempty"`
+	MaxOutputTokens  int           `json:"maxOutputTokens,omitempty"`
+	ResponseMimeType string        `json:"responseMimeType,omitempty"`
+	ResponseSchema   *geminiSchema `json:"responseSchema,omitempty"`
 }
 
 // Gemini APIレスポンス構造
@@ -89,7 +142,9 @@ type geminiResponse struct {
 		FinishReason string `json:"finishReason"`
 	} `json:"candidates"`
 	UsageMetadata struct {
-		TotalTokenCount int `json:"totalTokenCount"`
+		PromptTokenCount     int `json:"promptTokenCount"`
+		CandidatesTokenCount int `json:"candidatesTokenCount"`
+		TotalTokenCount      int `json:"totalTokenCount"`
 	} `json:"usageMetadata"`
 }
 
@@ -106,9 +161,10 @@ type BookRecommendationRequest struct {
 }
 
 type RecommendedBook struct {
-	Title      string
-	AmazonURL  string
-	RakutenURL string
+	Title            string
+	Author           string
+	Reason           string
+	SourceArticleIDs []int64
 }
 
 // URLから記事を生成
@@ -120,206 +176,360 @@ func (c *GeminiClient) GenerateArticleFromURL(ctx context.Context, req service.A
 		}
 	}
 
-	prompt := c.buildPrompt(req.URL)
-	response, err := c.callAPI(ctx, prompt)
-	if err != nil {
-		return nil, err
-	}
-
-	article, err := c.parseResponse(response, req.URL)
+	options := withDefaultOptions(req.Options)
+	prompt, err := c.config.Prompts.buildArticlePrompt(req.URL, options.SummaryLength, options.Language, options.TagCount, req.ExistingTags)
 	if err != nil {
 		return nil, &service.AIGeneratorError{
-			Code:    service.ErrCodeInvalidResponse,
-			Message: "Failed to parse response",
+			Code:    service.ErrCodePromptTemplate,
+			Message: "Failed to build prompt",
 			Err:     err,
 		}
 	}
 
+	var article *service.GeneratedArticle
+	tokenUsed, err := c.generateStructured(ctx, entity.AIOperationArticleGeneration, prompt, true, articleResponseSchema, func(text string) error {
+		parsed, err := c.parseResponse(text, req.URL)
+		if err != nil {
+			return err
+		}
+		article = parsed
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	article.TokenUsed = tokenUsed
+	article.PromptVersion = c.config.Prompts.ArticleVersion()
 	article.GeneratedAt = time.Now()
 	return article, nil
 }
 
-// プロンプト構築
-func (c *GeminiClient) buildPrompt(url string) string {
-	return fmt.Sprintf("以下のURLの記事を分析し、記事管理用の情報をJSON形式で生成してください。\n\n"+
-		"URL: %s\n\n"+
-		"【重要な指示】\n"+
-		"1. 出力は必ず以下のJSON形式のみとし、それ以外のテキスト（説明文、マークダウン、コードブロック記号など）は一切含めないでください\n"+
-		"2. JSONオブジェクトのみを出力してください（前後に余分なテキストを含めない）\n"+
-		"3. summaryは記事の核心を捉え、200文字以内で簡潔にまとめる\n"+
-		"4. suggestedTagsは3-5個、検索しやすく具体的なものを選ぶ\n"+
-		"5. 技術記事の場合は使用されている技術スタックをタグに含める\n"+
-		"6. 日本語記事は日本語で、英語記事は日本語に翻訳して出力する\n\n"+
-		"出力形式（このフォーマット通りに出力）:\n"+
-		"{\n"+
-		"  \"title\": \"記事のタイトル\",\n"+
-		"  \"summary\": \"記事の要約（200文字以内）\",\n"+
-		"  \"suggestedTags\": [\"タグ1\", \"タグ2\", \"タグ3\", \"タグ4\", \"タグ5\"]\n"+
-		"}\n\n"+
-		"必ず上記のJSON形式のみで回答してください。説明文は不要です。", url)
+// 記事生成に使用するプロンプトのバージョン
+func (c *GeminiClient) ArticlePromptVersion() string {
+	return c.config.Prompts.ArticleVersion()
+}
+
+// 書籍推薦に使用するプロンプトのバージョン
+func (c *GeminiClient) BookRecommendationPromptVersion() string {
+	return c.config.Prompts.BookRecommendationVersion()
+}
+
+// 使用するモデル名
+func (c *GeminiClient) Model() string {
+	return c.config.Model
+}
+
+// 未指定のオプションにデフォルト値を設定
+func withDefaultOptions(options service.ArticleGenerationOptions) service.ArticleGenerationOptions {
+	if options.SummaryLength <= 0 {
+		options.SummaryLength = DefaultSummaryLength
+	}
+	if options.Language == "" {
+		options.Language = DefaultLanguage
+	}
+	if options.TagCount <= 0 {
+		options.TagCount = DefaultTagCount
+	}
+	return options
 }
 
 // 全記事から書籍を推薦
-func (c *GeminiClient) RecommendBooks(ctx context.Context, articles []*entity.Article) ([]RecommendedBook, error) {
+// feedbackのうち気に入った書籍と所有・読了済みの書籍をプロンプトで伝える
+func (c *GeminiClient) RecommendBooks(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]RecommendedBook, error) {
 	if len(articles) == 0 {
 		return []RecommendedBook{}, nil
 	}
 
-	prompt := c.buildBookRecommendationPrompt(articles)
+	prompt, err := c.config.Prompts.buildBookRecommendationPrompt(articles, feedback)
+	if err != nil {
+		return nil, &service.AIGeneratorError{
+			Code:    service.ErrCodePromptTemplate,
+			Message: "Failed to build prompt",
+			Err:     err,
+		}
+	}
+
+	var books []RecommendedBook
 	// 書籍推薦ではURLコンテキストツールは不要なので、falseを指定
-	response, err := c.callAPIWithTools(ctx, prompt, false)
+	_, err = c.generateStructured(ctx, entity.AIOperationBookRecommendation, prompt, false, bookRecommendationResponseSchema, func(text string) error {
+		parsed, err := c.parseBookRecommendationResponse(text)
+		if err != nil {
+			return err
+		}
+		books = parsed
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	books, err := c.parseBookRecommendationResponse(response)
+	return books, nil
+}
+
+// 保存済み記事を根拠に質問へ回答
+func (c *GeminiClient) AnswerQuestion(ctx context.Context, req service.QuestionAnswerRequest) (*service.QuestionAnswer, error) {
+	language := req.Language
+	if language == "" {
+		language = DefaultLanguage
+	}
+	prompt, err := c.config.Prompts.buildAnswerPrompt(req.Question, req.Sources, language)
 	if err != nil {
 		return nil, &service.AIGeneratorError{
-			Code:    service.ErrCodeInvalidResponse,
-			Message: "Failed to parse book recommendation response",
+			Code:    service.ErrCodePromptTemplate,
+			Message: "Failed to build prompt",
 			Err:     err,
 		}
 	}
 
-	return books, nil
+	var answer *service.QuestionAnswer
+	tokenUsed, err := c.generateStructured(ctx, entity.AIOperationQuestionAnswering, prompt, false, answerResponseSchema, func(text string) error {
+		parsed, err := c.parseAnswerResponse(text)
+		if err != nil {
+			return err
+		}
+		answer = parsed
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	answer.TokenUsed = tokenUsed
+	return answer, nil
 }
 
-// 書籍推薦用プロンプト構築
-func (c *GeminiClient) buildBookRecommendationPrompt(articles []*entity.Article) string {
-	var articlesInfo strings.Builder
-
-	articlesInfo.WriteString("【登録されている記事一覧】\n\n")
-	for i, article := range articles {
-		articlesInfo.WriteString(fmt.Sprintf("%d. タイトル: %s\n", i+1, article.Title))
-		articlesInfo.WriteString(fmt.Sprintf("   要約: %s\n", article.Summary))
-		if len(article.Tags) > 0 {
-			articlesInfo.WriteString(fmt.Sprintf("   タグ: %s\n", strings.Join(article.Tags, ", ")))
-		}
-		if article.Memo != "" {
-			articlesInfo.WriteString(fmt.Sprintf("   メモ: %s\n", article.Memo))
-		}
-		articlesInfo.WriteString("\n")
-	}
-
-	return fmt.Sprintf("%s\n\n"+
-		"【タスク】上記のユーザーが登録している記事の内容を総合的に分析し、ユーザーの興味・関心領域に基づいておすすめの書籍を5冊推薦してください。\n\n"+
-		"【最重要事項 - 必ず遵守してください】\n"+
-		"- あなたは書籍情報をJSON形式で返す専用BOTです\n"+
-		"- 説明文、理由、コメント、挨拶、マークダウン記号は絶対に出力しないでください\n"+
-		"- 最初の文字は必ず「{」で、最後の文字は必ず「}」で終わってください\n"+
-		"- JSON以外の出力は厳禁です\n\n"+
-		"【重要な指示】\n"+
-		"1. 出力は必ず以下のJSON形式のみ\n"+
-		"2. JSONオブジェクトのみを出力（前後に余分なテキストを含めない）\n"+
-		"3. 推薦書籍は正確な書籍タイトルと著者名を記載してください\n"+
-		"4. 実在する書籍のみを推薦してください（架空の書籍は不可）\n"+
-		"5. 記事の内容から推測されるユーザーの専門性や興味に合った書籍を選んでください\n"+
-		"6. 技術書、ビジネス書、専門書など、実用的な書籍を優先してください\n"+
-		"7. 必ず5冊推薦してください\n"+
-		"8. 著者名は正式名称（フルネーム）で記載してください\n"+
-		"9. 【重要】日本の出版社から日本語で出版されている書籍のみを推薦してください（翻訳書を含む）\n"+
-		"10. 【重要】洋書（原書が英語で海外出版社から出版されている書籍）は絶対に推薦しないでください\n"+
-		"11. 【重要】書籍タイトルは必ず日本語で記載してください（ローマ字表記は不可）\n"+
-		"12. 【超重要】各書籍のAmazon ASIN（10桁の商品コード）を正確に記載してください\n"+
-		"    - ASINはAmazon.co.jpで実際に使用されている10桁のコードです\n"+
-		"    - ISBN-10が存在する場合、多くの場合ISBN-10がASINと一致します\n"+
-		"    - 例：「実践Rustプログラミング入門」のASINは「4798061700」です\n"+
-		"    - 例：「Kubernetes完全ガイド 第2版」のASINは「4295009792」です\n"+
-		"13. amazonUrlには、ASINを使用したAmazon.co.jp直接リンク（https://www.amazon.co.jp/dp/ASIN）を記載してください\n"+
-		"    - 正しい形式：https://www.amazon.co.jp/dp/4798061700\n"+
-		"    - 間違った形式：13桁のISBN-13を使用しないでください\n"+
-		"14. rakutenUrlには、書籍タイトルをURLエンコードした楽天ブックス検索URL（https://books.rakuten.co.jp/search?g=001&sitem=書籍タイトル）を記載してください\n\n"+
-		"出力形式（このフォーマット通りに出力）:\n"+
-		"{\n"+
-		"  \"books\": [\n"+
-		"    {\n"+
-		"      \"title\": \"実践Rustプログラミング入門\",\n"+
-		"      \"amazonUrl\": \"https://www.amazon.co.jp/dp/4798061700\",\n"+
-		"      \"rakutenUrl\": \"https://books.rakuten.co.jp/search?g=001&sitem=実践Rustプログラミング入門\"\n"+
-		"    },\n"+
-		"    {\n"+
-		"      \"title\": \"Kubernetes完全ガイド 第2版\",\n"+
-		"      \"amazonUrl\": \"https://www.amazon.co.jp/dp/4295009792\",\n"+
-		"      \"rakutenUrl\": \"https://books.rakuten.co.jp/search?g=001&sitem=Kubernetes完全ガイド 第2版\"\n"+
-		"    },\n"+
-		"    {\n"+
-		"      \"title\": \"リーダブルコード\",\n"+
-		"      \"amazonUrl\": \"https://www.amazon.co.jp/dp/4873115655\",\n"+
-		"      \"rakutenUrl\": \"https://books.rakuten.co.jp/search?g=001&sitem=リーダブルコード\"\n"+
-		"    },\n"+
-		"    {\n"+
-		"      \"title\": \"入門 監視\",\n"+
-		"      \"amazonUrl\": \"https://www.amazon.co.jp/dp/4873118646\",\n"+
-		"      \"rakutenUrl\": \"https://books.rakuten.co.jp/search?g=001&sitem=入門 監視\"\n"+
-		"    },\n"+
-		"    {\n"+
-		"      \"title\": \"プログラミング言語Go\",\n"+
-		"      \"amazonUrl\": \"https://www.amazon.co.jp/dp/4621300253\",\n"+
-		"      \"rakutenUrl\": \"https://books.rakuten.co.jp/search?g=001&sitem=プログラミング言語Go\"\n"+
-		"    }\n"+
-		"  ]\n"+
-		"}\n\n"+
-		"必ず上記のJSON形式のみで回答してください。説明文や推薦理由は不要です。", articlesInfo.String())
-}
-
-// Gemini API呼び出し
-func (c *GeminiClient) callAPI(ctx context.Context, prompt string) (*geminiResponse, error) {
-	return c.callAPIWithTools(ctx, prompt, true)
-}
-
-// ツールの有無を指定してGemini API呼び出し
-func (c *GeminiClient) callAPIWithTools(ctx context.Context, prompt string, includeURLContext bool) (*geminiResponse, error) {
-	var lastErr error
+// ダイジェストのグループ概要を生成
+func (c *GeminiClient) SummarizeDigestGroup(ctx context.Context, req service.DigestGroupRequest) (string, error) {
+	language := req.Language
+	if language == "" {
+		language = DefaultLanguage
+	}
+	prompt, err := c.config.Prompts.buildDigestPrompt(req.Tag, req.Articles, language)
+	if err != nil {
+		return "", &service.AIGeneratorError{
+			Code:    service.ErrCodePromptTemplate,
+			Message: "Failed to build prompt",
+			Err:     err,
+		}
+	}
+
+	var overview string
+	_, err = c.generateStructured(ctx, entity.AIOperationDigest, prompt, false, digestResponseSchema, func(text string) error {
+		var data struct {
+			Overview string `json:"overview"`
+		}
+		if err := json.Unmarshal([]byte(text), &data); err != nil {
+			return fmt.Errorf("failed to parse JSON: %w", err)
+		}
+		if strings.TrimSpace(data.Overview) == "" {
+			return &schemaValidationError{Path: "overview", Reason: "must not be empty"}
+		}
+		overview = data.Overview
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return overview, nil
+}
 
-	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
+// スキーマを指定してAPIを呼び出し、検証済みのJSONをdecodeに渡す
+// スキーマ検証やdecodeに失敗した場合は、エラー内容を伝えて修正を依頼する
+// 戻り値は修正依頼を含めた合計トークン数
+func (c *GeminiClient) generateStructured(
+	ctx context.Context,
+	operation string,
+	prompt string,
+	includeURLContext bool,
+	schema *geminiSchema,
+	decode func(text string) error,
+) (int, error) {
+	contents := []geminiContent{
+		{
+			Role:  "user",
+			Parts: []geminiPart{{Text: prompt}},
+		},
+	}
+	tokenUsed := 0
+
+	for attempt := 0; ; attempt++ {
 		if attempt > 0 {
-			select {
-			case <-time.After(c.config.RetryWaitTime * time.Duration(attempt)):
-			case <-ctx.Done():
-				return nil, &service.AIGeneratorError{
-					Code:    service.ErrCodeTimeout,
-					Message: "Context cancelled",
-					Err:     ctx.Err(),
+			service.ReportGenerationProgress(ctx, service.GenerationProgress{
+				Stage:   service.GenerationStageCallingModel,
+				Attempt: attempt + 1,
+				Message: "requesting a corrected response",
+			})
+		}
+		response, err := c.callAPI(ctx, operation, contents, includeURLContext, schema)
+		if err != nil {
+			return tokenUsed, err
+		}
+		tokenUsed += response.UsageMetadata.TotalTokenCount
+		service.ReportGenerationProgress(ctx, service.GenerationProgress{Stage: service.GenerationStageParsing, Attempt: attempt + 1})
+
+		text := responseText(response)
+		invalidErr := c.checkStructuredText(text, schema, decode)
+		if invalidErr == nil {
+			return tokenUsed, nil
+		}
+
+		if attempt >= c.config.MaxRepairAttempts {
+			return tokenUsed, &service.AIGeneratorError{
+				Code:    service.ErrCodeInvalidResponse,
+				Message: "Failed to parse response",
+				Err:     invalidErr,
+			}
+		}
+
+		// 空のレスポンスはそのまま再リクエストし、それ以外は会話履歴に加えて修正を依頼する
+		if text != "" {
+			repairPrompt, err := c.config.Prompts.buildRepairPrompt(invalidErr)
+			if err != nil {
+				return tokenUsed, &service.AIGeneratorError{
+					Code:    service.ErrCodePromptTemplate,
+					Message: "Failed to build prompt",
+					Err:     err,
 				}
 			}
+			contents = append(contents,
+				geminiContent{Role: "model", Parts: []geminiPart{{Text: text}}},
+				geminiContent{Role: "user", Parts: []geminiPart{{Text: repairPrompt}}},
+			)
 		}
+	}
+}
+
+// レスポンステキストをスキーマで検証してからdecodeする
+func (c *GeminiClient) checkStructuredText(text string, schema *geminiSchema, decode func(text string) error) error {
+	if text == "" {
+		return fmt.Errorf("empty response from API")
+	}
+	// JSONモードを使わない場合は説明文やコードブロックで囲まれることがあるため取り出す
+	text = extractJSONText(text)
+	if err := schema.validateJSON(text); err != nil {
+		return err
+	}
+	return decode(text)
+}
 
-		response, err := c.makeRequestWithTools(ctx, prompt, includeURLContext)
-		if err == nil {
-			return response, nil
+// ```json ... ``` のようなコードブロック
+var jsonCodeFencePattern = regexp.MustCompile("(?s)```(?:json|JSON)?[ \t]*\n?(.*?)```")
+
+// レスポンステキストからJSONを取り出す
+// 最初のコードブロックの中身、なければ最も外側の{...}を返し、どちらもない場合はそのまま返す（修正依頼に任せる）
+func extractJSONText(text string) string {
+	if match := jsonCodeFencePattern.FindStringSubmatch(text); match != nil {
+		return strings.TrimSpace(match[1])
+	}
+	start, end := strings.Index(text, "{"), strings.LastIndex(text, "}")
+	if start >= 0 && end > start {
+		return text[start : end+1]
+	}
+	return text
+}
+
+// Gemini API呼び出し（レート制限・リトライ・サーキットブレーカー付き）
+func (c *GeminiClient) callAPI(ctx context.Context, operation string, contents []geminiContent, includeURLContext bool, schema *geminiSchema) (*geminiResponse, error) {
+	var response *geminiResponse
+	var lastErr error
+	attempt := 0
+	err := c.guard.Do(ctx, func(ctx context.Context) error {
+		attempt++
+		if attempt > 1 {
+			service.ReportGenerationProgress(ctx, service.GenerationProgress{
+				Stage:   service.GenerationStageRetrying,
+				Attempt: attempt,
+				Message: lastErr.Error(),
+			})
 		}
+		resp, err := c.trackedRequest(ctx, operation, contents, includeURLContext, schema)
+		if err != nil {
+			lastErr = err
+			return err
+		}
+		response = resp
+		return nil
+	}, c.isRetryable)
+	if err != nil {
+		return nil, c.toAIGeneratorError(err)
+	}
+	return response, nil
+}
 
-		lastErr = err
-		if !c.isRetryable(err) {
-			return nil, err
+// 呼び出し保護で発生したエラーをAIGeneratorErrorに変換
+func (c *GeminiClient) toAIGeneratorError(err error) error {
+	if errors.Is(err, resilience.ErrCircuitOpen) {
+		return &service.AIGeneratorError{
+			Code:    service.ErrCodeServiceUnavailable,
+			Message: "Gemini API is temporarily unavailable",
+			Err:     err,
 		}
 	}
 
-	// リトライ可能なエラーで全て失敗した場合
-	if lastErr != nil {
-		return nil, lastErr
+	var aiErr *service.AIGeneratorError
+	if errors.As(err, &aiErr) {
+		return aiErr
 	}
 
-	return nil, &service.AIGeneratorError{
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return &service.AIGeneratorError{
+			Code:    service.ErrCodeTimeout,
+			Message: "Context cancelled",
+			Err:     err,
+		}
+	}
+
+	return &service.AIGeneratorError{
 		Code:    service.ErrCodeNetworkError,
 		Message: "Unknown error",
+		Err:     err,
 	}
 }
 
-// 単一のAPIリクエストを送信
-func (c *GeminiClient) makeRequest(ctx context.Context, prompt string) (*geminiResponse, error) {
-	return c.makeRequestWithTools(ctx, prompt, true)
+// 予算を確認してからAPIリクエストを送信し、使用量を記録する
+func (c *GeminiClient) trackedRequest(ctx context.Context, operation string, contents []geminiContent, includeURLContext bool, schema *geminiSchema) (*geminiResponse, error) {
+	tracker := c.config.UsageTracker
+	if tracker == nil {
+		return c.makeRequest(ctx, contents, includeURLContext, schema)
+	}
+
+	if err := tracker.CheckBudget(ctx); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+	response, err := c.makeRequest(ctx, contents, includeURLContext, schema)
+	latency := time.Since(start)
+
+	var promptTokens, outputTokens, totalTokens int
+	if response != nil {
+		promptTokens = response.UsageMetadata.PromptTokenCount
+		outputTokens = response.UsageMetadata.CandidatesTokenCount
+		totalTokens = response.UsageMetadata.TotalTokenCount
+	}
+	errorCode := ""
+	if err != nil {
+		errorCode = service.ErrCodeNetworkError
+		var aiErr *service.AIGeneratorError
+		if errors.As(err, &aiErr) {
+			errorCode = aiErr.Code
+		}
+	}
+
+	usage, usageErr := entity.NewAIUsage(operation, c.config.Model, promptTokens, outputTokens, totalTokens, latency, errorCode)
+	if usageErr == nil {
+		// 呼び出し元のキャンセルに関わらず記録する
+		tracker.RecordUsage(context.WithoutCancel(ctx), usage)
+	}
+
+	return response, err
 }
 
-// ツールの有無を指定してAPIリクエストを送信
-func (c *GeminiClient) makeRequestWithTools(ctx context.Context, prompt string, includeURLContext bool) (*geminiResponse, error) {
+// 単一のAPIリクエストを送信
+func (c *GeminiClient) makeRequest(ctx context.Context, contents []geminiContent, includeURLContext bool, schema *geminiSchema) (*geminiResponse, error) {
 	reqBody := geminiRequest{
-		Contents: []geminiContent{
-			{
-				Role:  "user",
-				Parts: []geminiPart{{Text: prompt}},
-			},
-		},
+		Contents: contents,
 		GenerationConfig: &geminiGenerationConfig{
 			Temperature:     0.3, // より決定論的な出力のため低く設定
 			MaxOutputTokens: 4096,
@@ -327,10 +537,16 @@ func (c *GeminiClient) makeRequestWithTools(ctx context.Context, prompt string,
 	}
 
 	// URL Contextツールを条件付きで追加
+	// 組み込みツールはJSONモード・スキーマ指定と併用できないため、その場合はスキーマを送らず
+	// レスポンスをローカルでスキーマ検証する（不適合の場合は修正を依頼する）
 	if includeURLContext {
 		reqBody.Tools = []geminiTool{
 			{URLContext: &geminiURLContext{}},
 		}
+	} else if schema != nil {
+		// 構造化出力（JSON）を指定
+		reqBody.GenerationConfig.ResponseMimeType = "application/json"
+		reqBody.GenerationConfig.ResponseSchema = schema
 	}
 
 	jsonData, err := json.Marshal(reqBody)
@@ -342,11 +558,20 @@ func (c *GeminiClient) makeRequestWithTools(ctx context.Context, prompt string,
 		}
 	}
 
+	// 進捗の通知先がある場合はストリーミングで生成途中のテキストを通知する
+	stream := c.config.StreamPartialText && service.HasGenerationProgress(ctx)
 	url := fmt.Sprintf("%s/models/%s:generateContent?key=%s",
 		c.config.BaseURL,
 		c.config.Model,
 		c.config.APIKey,
 	)
+	if stream {
+		url = fmt.Sprintf("%s/models/%s:streamGenerateContent?alt=sse&key=%s",
+			c.config.BaseURL,
+			c.config.Model,
+			c.config.APIKey,
+		)
+	}
 
 	req, err := http.NewRequestWithContext(ctx, "POST", url, strings.NewReader(string(jsonData)))
 	if err != nil {
@@ -369,6 +594,10 @@ func (c *GeminiClient) makeRequestWithTools(ctx context.Context, prompt string,
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusOK && stream {
+		return c.readStream(ctx, resp.Body)
+	}
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
 		return nil, &service.AIGeneratorError{
@@ -379,7 +608,7 @@ func (c *GeminiClient) makeRequestWithTools(ctx context.Context, prompt string,
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, c.handleError(resp.StatusCode, body)
+		return nil, resilience.WithRetryAfter(c.handleError(resp.StatusCode, body), resp.Header)
 	}
 
 	var geminiResp geminiResponse
@@ -431,8 +660,8 @@ func (c *GeminiClient) handleError(statusCode int, body []byte) error {
 
 // リトライ可能なエラーか判定
 func (c *GeminiClient) isRetryable(err error) bool {
-	aiErr, ok := err.(*service.AIGeneratorError)
-	if !ok {
+	var aiErr *service.AIGeneratorError
+	if !errors.As(err, &aiErr) {
 		return false
 	}
 
@@ -441,59 +670,36 @@ func (c *GeminiClient) isRetryable(err error) bool {
 		aiErr.Code == service.ErrCodeTimeout
 }
 
-// マークダウンのコードブロックからJSONを抽出（フェイルセーフ用）
-func (c *GeminiClient) extractJSON(text string) string {
-	text = strings.TrimSpace(text)
-
-	// ```json ... ``` または ``` ... ``` で囲まれている場合は中身を抽出
-	if strings.HasPrefix(text, "```") {
-		lines := strings.Split(text, "\n")
-		if len(lines) > 2 {
-			// 最初の行（```json または ```）を除去
-			content := strings.Join(lines[1:], "\n")
-			// 最後の ``` を除去
-			if idx := strings.LastIndex(content, "```"); idx != -1 {
-				content = content[:idx]
-			}
-			text = strings.TrimSpace(content)
-		}
+// レスポンスのテキスト部分を取得
+func responseText(resp *geminiResponse) string {
+	if len(resp.Candidates) == 0 {
+		return ""
 	}
 
-	// 念のため、最初の { から最後の } までを抽出
-	startIdx := strings.Index(text, "{")
-	endIdx := strings.LastIndex(text, "}")
-
-	if startIdx != -1 && endIdx != -1 && endIdx > startIdx {
-		text = text[startIdx : endIdx+1]
+	var text strings.Builder
+	for _, part := range resp.Candidates[0].Content.Parts {
+		text.WriteString(part.Text)
 	}
-
-	return strings.TrimSpace(text)
+	return strings.TrimSpace(text.String())
 }
 
 // レスポンスをパース
-func (c *GeminiClient) parseResponse(resp *geminiResponse, sourceURL string) (*service.GeneratedArticle, error) {
-	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
-		return nil, fmt.Errorf("empty response from API")
-	}
-
-	originalText := resp.Candidates[0].Content.Parts[0].Text
-
-	// マークダウンのコードブロックを除去
-	extractedText := c.extractJSON(originalText)
-
+func (c *GeminiClient) parseResponse(text string, sourceURL string) (*service.GeneratedArticle, error) {
 	var data struct {
 		Title         string   `json:"title"`
 		Summary       string   `json:"summary"`
 		SuggestedTags []string `json:"suggestedTags"`
 	}
 
-	if err := json.Unmarshal([]byte(extractedText), &data); err != nil {
-		// デバッグ情報を含めたエラーメッセージ
-		return nil, fmt.Errorf("failed to parse JSON: %w\nOriginal text: %s\nExtracted text: %s", err, originalText, extractedText)
+	if err := json.Unmarshal([]byte(text), &data); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
 	}
 
-	if data.Title == "" || data.Summary == "" {
-		return nil, fmt.Errorf("missing required fields (title or summary is empty)\nParsed data: title=%s, summary=%s, tags=%v", data.Title, data.Summary, data.SuggestedTags)
+	if strings.TrimSpace(data.Title) == "" {
+		return nil, &schemaValidationError{Path: "title", Reason: "must not be empty"}
+	}
+	if strings.TrimSpace(data.Summary) == "" {
+		return nil, &schemaValidationError{Path: "summary", Reason: "must not be empty"}
 	}
 
 	// タグが空の場合はデフォルト値を設定
@@ -506,48 +712,60 @@ func (c *GeminiClient) parseResponse(resp *geminiResponse, sourceURL string) (*s
 		Summary:       data.Summary,
 		SuggestedTags: data.SuggestedTags,
 		SourceURL:     sourceURL,
-		TokenUsed:     resp.UsageMetadata.TotalTokenCount,
 	}, nil
 }
 
 // 書籍推薦レスポンスをパース
-func (c *GeminiClient) parseBookRecommendationResponse(resp *geminiResponse) ([]RecommendedBook, error) {
-	if len(resp.Candidates) == 0 || len(resp.Candidates[0].Content.Parts) == 0 {
-		return nil, fmt.Errorf("empty response from API")
-	}
-
-	originalText := resp.Candidates[0].Content.Parts[0].Text
-
-	// マークダウンのコードブロックを除去（既存のextractJSONメソッドを利用）
-	extractedText := c.extractJSON(originalText)
-
+func (c *GeminiClient) parseBookRecommendationResponse(text string) ([]RecommendedBook, error) {
 	var data struct {
 		Books []struct {
-			Title      string `json:"title"`
-			AmazonURL  string `json:"amazonUrl"`
-			RakutenURL string `json:"rakutenUrl"`
+			Title            string  `json:"title"`
+			Author           string  `json:"author"`
+			Reason           string  `json:"reason"`
+			SourceArticleIDs []int64 `json:"sourceArticleIds"`
 		} `json:"books"`
 	}
 
-	if err := json.Unmarshal([]byte(extractedText), &data); err != nil {
-		return nil, fmt.Errorf("failed to parse JSON: %w\nOriginal text: %s\nExtracted text: %s", err, originalText, extractedText)
-	}
-
-	if len(data.Books) == 0 {
-		return nil, fmt.Errorf("no books found in response")
+	if err := json.Unmarshal([]byte(text), &data); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
 	}
 
 	books := make([]RecommendedBook, 0, len(data.Books))
 	for i, book := range data.Books {
-		if book.Title == "" {
-			return nil, fmt.Errorf("book at index %d is missing title (title=%s)", i, book.Title)
+		if strings.TrimSpace(book.Title) == "" {
+			return nil, &schemaValidationError{Path: fmt.Sprintf("books[%d].title", i), Reason: "must not be empty"}
 		}
 		books = append(books, RecommendedBook{
-			Title:      book.Title,
-			AmazonURL:  book.AmazonURL,
-			RakutenURL: book.RakutenURL,
+			Title:            book.Title,
+			Author:           book.Author,
+			Reason:           book.Reason,
+			SourceArticleIDs: book.SourceArticleIDs,
 		})
 	}
 
 	return books, nil
 }
+
+// 質問応答レスポンスをパース
+func (c *GeminiClient) parseAnswerResponse(text string) (*service.QuestionAnswer, error) {
+	var data struct {
+		Answer    string  `json:"answer"`
+		Citations []int64 `json:"citations"`
+	}
+
+	if err := json.Unmarshal([]byte(text), &data); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %w", err)
+	}
+
+	if strings.TrimSpace(data.Answer) == "" {
+		return nil, &schemaValidationError{Path: "answer", Reason: "must not be empty"}
+	}
+	if data.Citations == nil {
+		data.Citations = []int64{}
+	}
+
+	return &service.QuestionAnswer{
+		Answer:          data.Answer,
+		CitedArticleIDs: data.Citations,
+	}, nil
+}
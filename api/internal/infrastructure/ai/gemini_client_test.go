@@ -48,7 +48,9 @@ func TestGeminiClient_GenerateArticleFromURL_Success(t *testing.T) {
 				},
 			},
 			UsageMetadata: struct {
-				TotalTokenCount int `json:"totalTokenCount"`
+				PromptTokenCount     int `json:"promptTokenCount"`
+				CandidatesTokenCount int `json:"candidatesTokenCount"`
+				TotalTokenCount      int `json:"totalTokenCount"`
 			}{
 				TotalTokenCount: 100,
 			},
@@ -253,7 +255,9 @@ func TestGeminiClient_GenerateArticleFromURL_Retry(t *testing.T) {
 				},
 			},
 			UsageMetadata: struct {
-				TotalTokenCount int `json:"totalTokenCount"`
+				PromptTokenCount     int `json:"promptTokenCount"`
+				CandidatesTokenCount int `json:"candidatesTokenCount"`
+				TotalTokenCount      int `json:"totalTokenCount"`
 			}{
 				TotalTokenCount: 50,
 			},
@@ -331,3 +335,44 @@ func TestGeminiClient_GenerateArticleFromURL_ContentBlocked(t *testing.T) {
 		t.Errorf("Expected error code %s, got %s", service.ErrCodeContentBlocked, aiErr.Code)
 	}
 }
+
+func TestGeminiClient_CircuitBreaker(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.Header().Set("Retry-After", "0")
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error": {"code": 503, "message": "overloaded"}}`))
+	}))
+	defer server.Close()
+
+	config := DefaultGeminiConfig("test-api-key")
+	config.BaseURL = server.URL
+	config.MaxRetries = 1
+	config.RetryWaitTime = time.Millisecond
+	config.BreakerFailureThreshold = 2
+	client := NewGeminiClient(config)
+	req := service.ArticleGenerationRequest{URL: "https://example.com/article"}
+
+	_, err := client.GenerateArticleFromURL(context.Background(), req)
+	aiErr, ok := err.(*service.AIGeneratorError)
+	if !ok || aiErr.Code != service.ErrCodeNetworkError {
+		t.Fatalf("Expected NETWORK_ERROR, got %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected 2 attempts, got %d", attempts)
+	}
+
+	// サーキットが開いた後はAPIを呼び出さない
+	_, err = client.GenerateArticleFromURL(context.Background(), req)
+	aiErr, ok = err.(*service.AIGeneratorError)
+	if !ok || aiErr.Code != service.ErrCodeServiceUnavailable {
+		t.Fatalf("Expected AI_SERVICE_UNAVAILABLE, got %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("Expected no additional attempts, got %d", attempts)
+	}
+	if state := client.CircuitBreaker().Snapshot().State; state != "open" {
+		t.Errorf("Expected open circuit, got '%s'", state)
+	}
+}
@@ -0,0 +1,130 @@
+package ai
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"article-manager/internal/domain/service"
+)
+
+// SSEの1行あたりの最大サイズ
+const maxStreamLineSize = 1024 * 1024
+
+// streamGenerateContent（alt=sse）のレスポンスを読み込み、1つのレスポンスにまとめる
+// 読み込みの途中で、生成途中の要約テキストを進捗として通知する
+func (c *GeminiClient) readStream(ctx context.Context, body io.Reader) (*geminiResponse, error) {
+	var combined geminiResponse
+	var text strings.Builder
+	lastSummary := ""
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxStreamLineSize)
+	for scanner.Scan() {
+		line := scanner.Text()
+		data, ok := strings.CutPrefix(line, "data:")
+		if !ok {
+			continue
+		}
+
+		var chunk geminiResponse
+		if err := json.Unmarshal([]byte(strings.TrimSpace(data)), &chunk); err != nil {
+			return nil, &service.AIGeneratorError{
+				Code:    service.ErrCodeInvalidResponse,
+				Message: "Failed to parse stream chunk",
+				Err:     err,
+			}
+		}
+
+		mergeStreamChunk(&combined, &chunk)
+		if len(chunk.Candidates) > 0 {
+			for _, part := range chunk.Candidates[0].Content.Parts {
+				text.WriteString(part.Text)
+			}
+		}
+
+		if summary, ok := partialJSONStringField(text.String(), "summary"); ok && summary != lastSummary {
+			lastSummary = summary
+			service.ReportGenerationProgress(ctx, service.GenerationProgress{
+				Stage: service.GenerationStagePartial,
+				Text:  summary,
+			})
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, &service.AIGeneratorError{
+			Code:    service.ErrCodeNetworkError,
+			Message: "Failed to read stream",
+			Err:     err,
+		}
+	}
+
+	return &combined, nil
+}
+
+// ストリームのチャンクを結合する（テキストは最初の候補に連結し、使用量は最後の値を使用）
+func mergeStreamChunk(combined *geminiResponse, chunk *geminiResponse) {
+	if chunk.UsageMetadata.TotalTokenCount > 0 {
+		combined.UsageMetadata = chunk.UsageMetadata
+	}
+	if len(chunk.Candidates) == 0 {
+		return
+	}
+	if len(combined.Candidates) == 0 {
+		combined.Candidates = chunk.Candidates[:1]
+		return
+	}
+	candidate := &combined.Candidates[0]
+	candidate.Content.Parts = append(candidate.Content.Parts, chunk.Candidates[0].Content.Parts...)
+	if chunk.Candidates[0].FinishReason != "" {
+		candidate.FinishReason = chunk.Candidates[0].FinishReason
+	}
+}
+
+// 生成途中のJSONから文字列フィールドの値を取り出す（閉じられていない値も途中まで返す）
+func partialJSONStringField(text, field string) (string, bool) {
+	key := `"` + field + `"`
+	index := strings.Index(text, key)
+	if index < 0 {
+		return "", false
+	}
+	rest := strings.TrimLeft(text[index+len(key):], " \t\r\n")
+	rest, ok := strings.CutPrefix(rest, ":")
+	if !ok {
+		return "", false
+	}
+	rest = strings.TrimLeft(rest, " \t\r\n")
+	rest, ok = strings.CutPrefix(rest, `"`)
+	if !ok {
+		return "", false
+	}
+
+	// 閉じ引用符までを取り出し、途中で切れたエスケープシーケンスは除く
+	end := len(rest)
+	for i := 0; i < len(rest); i++ {
+		if rest[i] == '\\' {
+			escapeLen := 2
+			if i+1 < len(rest) && rest[i+1] == 'u' {
+				escapeLen = 6
+			}
+			if i+escapeLen > len(rest) {
+				end = i
+				break
+			}
+			i += escapeLen - 1
+			continue
+		}
+		if rest[i] == '"' {
+			end = i
+			break
+		}
+	}
+
+	var value string
+	if err := json.Unmarshal([]byte(`"`+rest[:end]+`"`), &value); err != nil {
+		return "", false
+	}
+	return value, true
+}
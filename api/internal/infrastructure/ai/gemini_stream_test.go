@@ -0,0 +1,126 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"article-manager/internal/domain/service"
+)
+
+func TestGeminiClient_StreamPartialText(t *testing.T) {
+	chunks := []string{
+		`{"candidates": [{"content": {"parts": [{"text": "{\"title\": \"Stream\", \"summ"}]}}]}`,
+		`{"candidates": [{"content": {"parts": [{"text": "ary\": \"Hello, \\\"wor"}]}}]}`,
+		`{"candidates": [{"content": {"parts": [{"text": "ld\\\"\", \"suggestedTags\": [\"go\"]}"}]}, "finishReason": "STOP"}], "usageMetadata": {"promptTokenCount": 10, "candidatesTokenCount": 5, "totalTokenCount": 15}}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.Contains(r.URL.Path, ":streamGenerateContent") || r.URL.Query().Get("alt") != "sse" {
+			t.Errorf("Expected streamGenerateContent with alt=sse, got %s", r.URL.String())
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		for _, chunk := range chunks {
+			fmt.Fprintf(w, "data: %s\r\n\r\n", chunk)
+		}
+	}))
+	defer server.Close()
+
+	config := DefaultGeminiConfig("test-api-key")
+	config.BaseURL = server.URL
+	config.MaxRetries = 0
+	client := NewGeminiClient(config)
+
+	var partials []string
+	ctx := service.WithGenerationProgress(context.Background(), func(progress service.GenerationProgress) {
+		if progress.Stage == service.GenerationStagePartial {
+			partials = append(partials, progress.Text)
+		}
+	})
+
+	result, err := client.GenerateArticleFromURL(ctx, service.ArticleGenerationRequest{URL: "https://example.com/article"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if result.Summary != `Hello, "world"` {
+		t.Errorf("Expected summary 'Hello, \"world\"', got '%s'", result.Summary)
+	}
+	if result.TokenUsed != 15 {
+		t.Errorf("Expected 15 token used, got %d", result.TokenUsed)
+	}
+	expected := []string{`Hello, "wor`, `Hello, "world"`}
+	if strings.Join(partials, "|") != strings.Join(expected, "|") {
+		t.Errorf("Expected partials %q, got %q", expected, partials)
+	}
+}
+
+func TestGeminiClient_ReportsRetryProgress(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"error": {"code": 429, "message": "Rate limit"}}`))
+			return
+		}
+		fmt.Fprint(w, `data: {"candidates": [{"content": {"parts": [{"text": "{\"title\": \"T\", \"summary\": \"S\", \"suggestedTags\": []}"}]}}]}`+"\n\n")
+	}))
+	defer server.Close()
+
+	config := DefaultGeminiConfig("test-api-key")
+	config.BaseURL = server.URL
+	config.MaxRetries = 1
+	config.RetryWaitTime = time.Millisecond
+	client := NewGeminiClient(config)
+
+	var stages []service.GenerationStage
+	ctx := service.WithGenerationProgress(context.Background(), func(progress service.GenerationProgress) {
+		stages = append(stages, progress.Stage)
+		if progress.Stage == service.GenerationStageRetrying && progress.Attempt != 2 {
+			t.Errorf("Expected retry attempt 2, got %d", progress.Attempt)
+		}
+	})
+
+	if _, err := client.GenerateArticleFromURL(ctx, service.ArticleGenerationRequest{URL: "https://example.com/article"}); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	expected := []service.GenerationStage{
+		service.GenerationStageRetrying,
+		service.GenerationStagePartial,
+		service.GenerationStageParsing,
+	}
+	if fmt.Sprint(stages) != fmt.Sprint(expected) {
+		t.Errorf("Expected stages %v, got %v", expected, stages)
+	}
+}
+
+func TestPartialJSONStringField(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+		ok       bool
+	}{
+		{name: "閉じた値", text: `{"summary": "done", "x": 1}`, expected: "done", ok: true},
+		{name: "途中の値", text: `{"title": "t", "summary": "in prog`, expected: "in prog", ok: true},
+		{name: "途中で切れたエスケープ", text: `{"summary": "a\`, expected: "a", ok: true},
+		{name: "途中で切れたUnicodeエスケープ", text: `{"summary": "a\u30`, expected: "a", ok: true},
+		{name: "Unicodeエスケープ", text: `{"summary": "\u3042"`, expected: "あ", ok: true},
+		{name: "値の開始前", text: `{"summary":`, ok: false},
+		{name: "フィールドなし", text: `{"title": "t"`, ok: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, ok := partialJSONStringField(tt.text, "summary")
+			if ok != tt.ok || value != tt.expected {
+				t.Errorf("Expected (%q, %v), got (%q, %v)", tt.expected, tt.ok, value, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,375 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/domain/service"
+)
+
+// フィクスチャを順番に返すテストサーバー
+type fixtureServer struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	requests []geminiRequest
+}
+
+func newFixtureServer(t *testing.T, fixtures ...string) *fixtureServer {
+	t.Helper()
+
+	bodies := make([][]byte, 0, len(fixtures))
+	for _, name := range fixtures {
+		body, err := os.ReadFile(filepath.Join("testdata", name))
+		if err != nil {
+			t.Fatalf("Failed to read fixture %s: %v", name, err)
+		}
+		bodies = append(bodies, body)
+	}
+
+	fs := &fixtureServer{}
+	fs.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req geminiRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Errorf("Failed to decode request: %v", err)
+		}
+
+		// 組み込みツールとスキーマ指定は併用できない
+		if len(req.Tools) > 0 && req.GenerationConfig != nil &&
+			(req.GenerationConfig.ResponseSchema != nil || req.GenerationConfig.ResponseMimeType != "") {
+			t.Errorf("Request must not combine tools with responseSchema or responseMimeType")
+		}
+
+		fs.mu.Lock()
+		index := len(fs.requests)
+		fs.requests = append(fs.requests, req)
+		fs.mu.Unlock()
+
+		if index >= len(bodies) {
+			t.Errorf("Unexpected request #%d", index+1)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write(bodies[index])
+	}))
+	t.Cleanup(fs.Close)
+
+	return fs
+}
+
+func (fs *fixtureServer) client(maxRepairAttempts int) *GeminiClient {
+	config := DefaultGeminiConfig("test-api-key")
+	config.BaseURL = fs.URL
+	config.MaxRetries = 0
+	config.RetryWaitTime = time.Millisecond
+	config.MaxRepairAttempts = maxRepairAttempts
+	return NewGeminiClient(config)
+}
+
+func TestGeminiClient_GenerateArticleFromURL_StructuredOutput(t *testing.T) {
+	fs := newFixtureServer(t, "article_valid.json")
+
+	result, err := fs.client(1).GenerateArticleFromURL(context.Background(), service.ArticleGenerationRequest{URL: "https://example.com/article"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Title != "Go言語入門" {
+		t.Errorf("Expected title 'Go言語入門', got '%s'", result.Title)
+	}
+
+	if len(fs.requests) != 1 {
+		t.Fatalf("Expected 1 request, got %d", len(fs.requests))
+	}
+	request := fs.requests[0]
+	if len(request.Tools) != 1 || request.Tools[0].URLContext == nil {
+		t.Errorf("Expected url_context tool, got %+v", request.Tools)
+	}
+	// URL Contextツールと併用できないため、スキーマはローカルで検証する
+	if request.GenerationConfig.ResponseMimeType != "" || request.GenerationConfig.ResponseSchema != nil {
+		t.Errorf("Expected no responseMimeType and responseSchema with url_context, got %+v", request.GenerationConfig)
+	}
+}
+
+func TestGeminiClient_GenerateArticleFromURL_CodeFence(t *testing.T) {
+	fs := newFixtureServer(t, "article_code_fence.json")
+
+	result, err := fs.client(0).GenerateArticleFromURL(context.Background(), service.ArticleGenerationRequest{URL: "https://example.com/article"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Title != "Go言語入門" || len(result.SuggestedTags) != 2 {
+		t.Errorf("Unexpected result %+v", result)
+	}
+}
+
+func TestGeminiClient_GenerateArticleFromURL_ProseWrapped(t *testing.T) {
+	fs := newFixtureServer(t, "article_prose_wrapped.json")
+
+	result, err := fs.client(0).GenerateArticleFromURL(context.Background(), service.ArticleGenerationRequest{URL: "https://example.com/article"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Title != "Go言語入門" || result.Summary != "Go言語の基本を解説する記事" {
+		t.Errorf("Unexpected result %+v", result)
+	}
+	// 説明文を取り除いて解析できるため修正依頼は送らない
+	if len(fs.requests) != 1 {
+		t.Errorf("Expected 1 request, got %d", len(fs.requests))
+	}
+}
+
+func TestGeminiClient_GenerateArticleFromURL_RepairPass(t *testing.T) {
+	tests := []struct {
+		name          string
+		badFixture    string
+		expectedError string
+	}{
+		{
+			name:          "説明文のみのレスポンス",
+			badFixture:    "article_prose_only.json",
+			expectedError: "invalid JSON",
+		},
+		{
+			name:          "必須項目が欠けたレスポンス",
+			badFixture:    "article_missing_summary.json",
+			expectedError: "summary: required property is missing",
+		},
+		{
+			name:          "型が異なるレスポンス",
+			badFixture:    "article_wrong_type.json",
+			expectedError: "suggestedTags: expected array but got string",
+		},
+		{
+			name:          "途中で切れたレスポンス",
+			badFixture:    "article_truncated.json",
+			expectedError: "invalid JSON",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := newFixtureServer(t, tt.badFixture, "article_valid.json")
+
+			result, err := fs.client(1).GenerateArticleFromURL(context.Background(), service.ArticleGenerationRequest{URL: "https://example.com/article"})
+			if err != nil {
+				t.Fatalf("Expected success after repair, got %v", err)
+			}
+			if result.Summary != "Go言語の基本を解説する記事" {
+				t.Errorf("Unexpected summary '%s'", result.Summary)
+			}
+
+			if len(fs.requests) != 2 {
+				t.Fatalf("Expected 2 requests, got %d", len(fs.requests))
+			}
+
+			// 修正依頼には元の出力とエラー内容が含まれる
+			repair := fs.requests[1].Contents
+			if len(repair) != 3 {
+				t.Fatalf("Expected 3 contents in repair request, got %d", len(repair))
+			}
+			if repair[1].Role != "model" {
+				t.Errorf("Expected model role for previous output, got '%s'", repair[1].Role)
+			}
+			if !strings.Contains(repair[2].Parts[0].Text, tt.expectedError) {
+				t.Errorf("Expected repair prompt to contain '%s', got '%s'", tt.expectedError, repair[2].Parts[0].Text)
+			}
+		})
+	}
+}
+
+func TestGeminiClient_GenerateArticleFromURL_TokenUsedIncludesRepair(t *testing.T) {
+	fs := newFixtureServer(t, "article_missing_summary.json", "article_valid.json")
+
+	result, err := fs.client(1).GenerateArticleFromURL(context.Background(), service.ArticleGenerationRequest{URL: "https://example.com/article"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.TokenUsed != 180 {
+		t.Errorf("Expected 180 tokens used, got %d", result.TokenUsed)
+	}
+}
+
+func TestGeminiClient_GenerateArticleFromURL_EmptyCandidatesRetried(t *testing.T) {
+	fs := newFixtureServer(t, "article_empty_candidates.json", "article_valid.json")
+
+	_, err := fs.client(1).GenerateArticleFromURL(context.Background(), service.ArticleGenerationRequest{URL: "https://example.com/article"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	// 空のレスポンスは会話履歴に加えず同じリクエストを再送する
+	if len(fs.requests[1].Contents) != 1 {
+		t.Errorf("Expected original contents to be resent, got %d contents", len(fs.requests[1].Contents))
+	}
+}
+
+func TestGeminiClient_GenerateArticleFromURL_RepairExhausted(t *testing.T) {
+	fs := newFixtureServer(t, "article_prose_only.json", "article_wrong_type.json")
+
+	_, err := fs.client(1).GenerateArticleFromURL(context.Background(), service.ArticleGenerationRequest{URL: "https://example.com/article"})
+	if err == nil {
+		t.Fatalf("Expected error, got nil")
+	}
+
+	var aiErr *service.AIGeneratorError
+	if !errors.As(err, &aiErr) {
+		t.Fatalf("Expected AIGeneratorError, got %T", err)
+	}
+	if aiErr.Code != service.ErrCodeInvalidResponse {
+		t.Errorf("Expected error code %s, got %s", service.ErrCodeInvalidResponse, aiErr.Code)
+	}
+	if len(fs.requests) != 2 {
+		t.Errorf("Expected 2 requests, got %d", len(fs.requests))
+	}
+}
+
+func TestGeminiClient_GenerateArticleFromURL_RepairDisabled(t *testing.T) {
+	fs := newFixtureServer(t, "article_missing_summary.json")
+
+	_, err := fs.client(0).GenerateArticleFromURL(context.Background(), service.ArticleGenerationRequest{URL: "https://example.com/article"})
+	if err == nil {
+		t.Fatalf("Expected error, got nil")
+	}
+	if len(fs.requests) != 1 {
+		t.Errorf("Expected 1 request, got %d", len(fs.requests))
+	}
+}
+
+func TestGeminiClient_RecommendBooks_StructuredOutput(t *testing.T) {
+	articles := []*entity.Article{
+		{ID: 1, Title: "Go言語入門", Summary: "Go言語の基本", Tags: []string{"Go"}},
+	}
+
+	t.Run("正常系：スキーマ付きで書籍を推薦する", func(t *testing.T) {
+		fs := newFixtureServer(t, "books_valid.json")
+
+		books, err := fs.client(1).RecommendBooks(context.Background(), articles, nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(books) != 1 || books[0].Title != "リーダブルコード" {
+			t.Errorf("Unexpected books %+v", books)
+		}
+		if books[0].Reason == "" || len(books[0].SourceArticleIDs) != 1 || books[0].SourceArticleIDs[0] != 1 {
+			t.Errorf("Expected reason and source article IDs, got %+v", books[0])
+		}
+
+		request := fs.requests[0]
+		if len(request.Tools) != 0 {
+			t.Errorf("Expected no tools for book recommendation, got %d", len(request.Tools))
+		}
+		if request.GenerationConfig.ResponseSchema == nil || request.GenerationConfig.ResponseSchema.Properties["books"] == nil {
+			t.Errorf("Expected book recommendation schema, got %+v", request.GenerationConfig.ResponseSchema)
+		}
+	})
+
+	t.Run("正常系：空のタイトルや空配列は修正を依頼する", func(t *testing.T) {
+		fs := newFixtureServer(t, "books_empty_title.json", "books_no_books.json", "books_valid.json")
+
+		books, err := fs.client(2).RecommendBooks(context.Background(), articles, nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if len(books) != 1 {
+			t.Errorf("Expected 1 book, got %d", len(books))
+		}
+
+		if !strings.Contains(fs.requests[1].Contents[2].Parts[0].Text, "books[0].title: must not be empty") {
+			t.Errorf("Unexpected repair prompt: %s", fs.requests[1].Contents[2].Parts[0].Text)
+		}
+		if !strings.Contains(fs.requests[2].Contents[4].Parts[0].Text, "books: must have at least 1 items") {
+			t.Errorf("Unexpected repair prompt: %s", fs.requests[2].Contents[4].Parts[0].Text)
+		}
+	})
+}
+
+func TestExtractJSONText(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{
+			name:     "正常系：前後に説明文が付いたコードブロック",
+			text:     "以下が結果です。\n```json\n{\"title\": \"t\"}\n```\nご確認ください。",
+			expected: `{"title": "t"}`,
+		},
+		{
+			name:     "正常系：言語指定のないコードブロック",
+			text:     "```\n{\"title\": \"t\"}\n```",
+			expected: `{"title": "t"}`,
+		},
+		{
+			name:     "正常系：コードブロックがない場合は最も外側のオブジェクト",
+			text:     `結果は {"title": "t", "meta": {"a": 1}} です`,
+			expected: `{"title": "t", "meta": {"a": 1}}`,
+		},
+		{
+			name:     "正常系：JSONを含まない場合はそのまま",
+			text:     "取得できませんでした",
+			expected: "取得できませんでした",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := extractJSONText(tt.text); got != tt.expected {
+				t.Errorf("Expected '%s', got '%s'", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestGeminiSchema_ValidateJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		wantErr string
+	}{
+		{
+			name: "正常系：スキーマに適合",
+			text: `{"title": "t", "summary": "s", "suggestedTags": []}`,
+		},
+		{
+			name:    "異常系：JSONの後ろに余分なテキスト",
+			text:    `{"title": "t", "summary": "s", "suggestedTags": []} 以上です`,
+			wantErr: "unexpected text after JSON value",
+		},
+		{
+			name:    "異常系：配列要素の型が異なる",
+			text:    `{"title": "t", "summary": "s", "suggestedTags": ["Go", 1]}`,
+			wantErr: "suggestedTags[1]: expected string but got number",
+		},
+		{
+			name:    "異常系：ルートがオブジェクトでない",
+			text:    `["t"]`,
+			wantErr: "expected object but got array",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := articleResponseSchema.validateJSON(tt.text)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing '%s', got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
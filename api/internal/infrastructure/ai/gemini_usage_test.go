@@ -0,0 +1,112 @@
+package ai
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/domain/service"
+)
+
+// テスト用の使用量トラッカー
+type fakeUsageTracker struct {
+	mu        sync.Mutex
+	usages    []*entity.AIUsage
+	budgetErr error
+}
+
+func (f *fakeUsageTracker) CheckBudget(ctx context.Context) error {
+	return f.budgetErr
+}
+
+func (f *fakeUsageTracker) RecordUsage(ctx context.Context, usage *entity.AIUsage) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.usages = append(f.usages, usage)
+}
+
+func TestGeminiClient_UsageTracking(t *testing.T) {
+	t.Run("正常系：修正依頼を含む各呼び出しが記録される", func(t *testing.T) {
+		fs := newFixtureServer(t, "article_missing_summary.json", "article_valid.json")
+		tracker := &fakeUsageTracker{}
+		client := fs.client(1)
+		client.config.UsageTracker = tracker
+
+		_, err := client.GenerateArticleFromURL(context.Background(), service.ArticleGenerationRequest{URL: "https://example.com/article"})
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+
+		if len(tracker.usages) != 2 {
+			t.Fatalf("Expected 2 usage records, got %d", len(tracker.usages))
+		}
+		last := tracker.usages[1]
+		if last.Operation != entity.AIOperationArticleGeneration {
+			t.Errorf("Expected operation '%s', got '%s'", entity.AIOperationArticleGeneration, last.Operation)
+		}
+		if last.Model != client.config.Model {
+			t.Errorf("Expected model '%s', got '%s'", client.config.Model, last.Model)
+		}
+		if last.PromptTokens != 90 || last.OutputTokens != 30 || last.TotalTokens != 120 {
+			t.Errorf("Unexpected token counts: %+v", last)
+		}
+		if last.Outcome != entity.AIUsageOutcomeSuccess {
+			t.Errorf("Expected success outcome, got '%s'", last.Outcome)
+		}
+	})
+
+	t.Run("正常系：失敗した呼び出しもエラーコード付きで記録される", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"error": {"code": 503, "message": "unavailable"}}`))
+		}))
+		defer server.Close()
+		tracker := &fakeUsageTracker{}
+		config := DefaultGeminiConfig("test-api-key")
+		config.BaseURL = server.URL
+		config.MaxRetries = 0
+		config.UsageTracker = tracker
+		client := NewGeminiClient(config)
+
+		_, err := client.RecommendBooks(context.Background(), []*entity.Article{{Title: "Go", Summary: "Go"}}, nil)
+		if err == nil {
+			t.Fatal("Expected error, got nil")
+		}
+
+		if len(tracker.usages) != 1 {
+			t.Fatalf("Expected 1 usage record, got %d", len(tracker.usages))
+		}
+		usage := tracker.usages[0]
+		if usage.Operation != entity.AIOperationBookRecommendation {
+			t.Errorf("Expected operation '%s', got '%s'", entity.AIOperationBookRecommendation, usage.Operation)
+		}
+		if usage.Outcome != entity.AIUsageOutcomeError || usage.ErrorCode != service.ErrCodeNetworkError {
+			t.Errorf("Expected error outcome with NETWORK_ERROR, got '%s' '%s'", usage.Outcome, usage.ErrorCode)
+		}
+	})
+
+	t.Run("異常系：予算超過の場合はAPIを呼び出さない", func(t *testing.T) {
+		fs := newFixtureServer(t)
+		budgetErr := &service.AIGeneratorError{Code: service.ErrCodeBudgetExceeded, Message: "budget exceeded"}
+		tracker := &fakeUsageTracker{budgetErr: budgetErr}
+		client := fs.client(0)
+		client.config.UsageTracker = tracker
+
+		_, err := client.GenerateArticleFromURL(context.Background(), service.ArticleGenerationRequest{URL: "https://example.com/article"})
+
+		var aiErr *service.AIGeneratorError
+		if !errors.As(err, &aiErr) || aiErr.Code != service.ErrCodeBudgetExceeded {
+			t.Fatalf("Expected budget exceeded error, got %v", err)
+		}
+		if len(fs.requests) != 0 {
+			t.Errorf("Expected no API requests, got %d", len(fs.requests))
+		}
+		if len(tracker.usages) != 0 {
+			t.Errorf("Expected no usage records, got %d", len(tracker.usages))
+		}
+	})
+}
@@ -0,0 +1,283 @@
+package ai
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/domain/service"
+)
+
+//go:embed prompts/*.tmpl
+var defaultPromptsFS embed.FS
+
+// テンプレートファイル名
+const (
+	articlePromptFile            = "article.tmpl"
+	bookRecommendationPromptFile = "book_recommendation.tmpl"
+	repairPromptFile             = "repair.tmpl"
+	answerPromptFile             = "answer.tmpl"
+	digestPromptFile             = "digest.tmpl"
+)
+
+// 生成オプションのデフォルト値
+const (
+	DefaultSummaryLength = 200
+	DefaultLanguage      = "ja"
+	DefaultTagCount      = 5
+	defaultBookCount     = 5
+)
+
+// 言語コードとプロンプト上の表記
+var languageNames = map[string]string{
+	"ja": "日本語",
+	"en": "英語",
+	"zh": "中国語",
+	"ko": "韓国語",
+	"fr": "フランス語",
+	"de": "ドイツ語",
+	"es": "スペイン語",
+}
+
+var promptFuncs = template.FuncMap{
+	"join": strings.Join,
+	"add":  func(a, b int) int { return a + b },
+}
+
+// 記事生成プロンプトに渡すデータ
+type articlePromptData struct {
+	URL           string
+	SummaryLength int
+	Language      string
+	LanguageName  string
+	TagCount      int
+	MinTagCount   int
+	ExistingTags  []string
+}
+
+// 書籍推薦プロンプトに渡すデータ
+type bookRecommendationPromptData struct {
+	Articles   []*entity.Article
+	BookCount  int
+	LikedBooks []*entity.BookFeedback
+	KnownBooks []*entity.BookFeedback // 所有している・読んだ書籍
+}
+
+// 質問応答プロンプトに渡すデータ
+type answerPromptData struct {
+	Question     string
+	Sources      []service.SourceArticle
+	LanguageName string
+}
+
+// ダイジェスト概要プロンプトに渡すデータ
+type digestPromptData struct {
+	Tag          string
+	Articles     []service.SourceArticle
+	LanguageName string
+}
+
+// 修正依頼プロンプトに渡すデータ
+type repairPromptData struct {
+	Error string
+}
+
+// バージョン付きのプロンプトテンプレート
+type promptTemplate struct {
+	tmpl    *template.Template
+	version string
+}
+
+// プロンプトテンプレート一式
+type PromptTemplates struct {
+	article            *promptTemplate
+	bookRecommendation *promptTemplate
+	repair             *promptTemplate
+	answer             *promptTemplate
+	digest             *promptTemplate
+}
+
+// 埋め込みのデフォルトテンプレートを読み込む
+func DefaultPromptTemplates() *PromptTemplates {
+	templates, err := LoadPromptTemplates("")
+	if err != nil {
+		panic(fmt.Sprintf("failed to load default prompt templates: %v", err))
+	}
+	return templates
+}
+
+// テンプレートを読み込む
+// overrideDirが指定された場合、同名のファイルが存在するテンプレートのみ上書きする
+func LoadPromptTemplates(overrideDir string) (*PromptTemplates, error) {
+	defaults, err := fs.Sub(defaultPromptsFS, "prompts")
+	if err != nil {
+		return nil, err
+	}
+
+	load := func(name string) (*promptTemplate, error) {
+		if overrideDir != "" {
+			content, err := os.ReadFile(filepath.Join(overrideDir, name))
+			if err == nil {
+				return parsePromptTemplate(name, content, true)
+			}
+			if !os.IsNotExist(err) {
+				return nil, fmt.Errorf("failed to read prompt template %s: %w", name, err)
+			}
+		}
+		content, err := fs.ReadFile(defaults, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read default prompt template %s: %w", name, err)
+		}
+		return parsePromptTemplate(name, content, false)
+	}
+
+	article, err := load(articlePromptFile)
+	if err != nil {
+		return nil, err
+	}
+	bookRecommendation, err := load(bookRecommendationPromptFile)
+	if err != nil {
+		return nil, err
+	}
+	repair, err := load(repairPromptFile)
+	if err != nil {
+		return nil, err
+	}
+	answer, err := load(answerPromptFile)
+	if err != nil {
+		return nil, err
+	}
+	digest, err := load(digestPromptFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PromptTemplates{
+		article:            article,
+		bookRecommendation: bookRecommendation,
+		repair:             repair,
+		answer:             answer,
+		digest:             digest,
+	}, nil
+}
+
+// テンプレートをパースしてバージョンを決定する
+// テンプレート内に{{define "version"}}があればその値、なければ内容のハッシュをバージョンとする
+// 上書きディレクトリのテンプレートは、バージョンを更新せずに内容だけ変更されても区別できるよう
+// 定義されたバージョンにも内容のハッシュを付加する（例: article-v3+sha256:...）
+func parsePromptTemplate(name string, content []byte, override bool) (*promptTemplate, error) {
+	tmpl, err := template.New(name).Funcs(promptFuncs).Option("missingkey=error").Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse prompt template %s: %w", name, err)
+	}
+
+	version := ""
+	if tmpl.Lookup("version") != nil {
+		var buf bytes.Buffer
+		if err := tmpl.ExecuteTemplate(&buf, "version", nil); err != nil {
+			return nil, fmt.Errorf("failed to execute version of prompt template %s: %w", name, err)
+		}
+		version = strings.TrimSpace(buf.String())
+	}
+	switch {
+	case version == "":
+		version = contentHash(content)
+	case override:
+		version += "+" + contentHash(content)
+	}
+
+	return &promptTemplate{tmpl: tmpl, version: version}, nil
+}
+
+// テンプレートの内容のハッシュ
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return "sha256:" + hex.EncodeToString(sum[:])[:12]
+}
+
+func (t *promptTemplate) execute(data any) (string, error) {
+	var buf bytes.Buffer
+	if err := t.tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to execute prompt template %s: %w", t.tmpl.Name(), err)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// 記事生成プロンプトのバージョン
+func (p *PromptTemplates) ArticleVersion() string {
+	return p.article.version
+}
+
+// 書籍推薦プロンプトのバージョン
+func (p *PromptTemplates) BookRecommendationVersion() string {
+	return p.bookRecommendation.version
+}
+
+// 言語コードをプロンプト上の表記に変換
+func languageName(language string) string {
+	if name, ok := languageNames[language]; ok {
+		return name
+	}
+	return language
+}
+
+// 記事生成プロンプトを構築
+func (p *PromptTemplates) buildArticlePrompt(url string, summaryLength int, language string, tagCount int, existingTags []string) (string, error) {
+	return p.article.execute(articlePromptData{
+		URL:           url,
+		SummaryLength: summaryLength,
+		Language:      language,
+		LanguageName:  languageName(language),
+		TagCount:      tagCount,
+		MinTagCount:   min(3, tagCount),
+		ExistingTags:  existingTags,
+	})
+}
+
+// 書籍推薦プロンプトを構築
+func (p *PromptTemplates) buildBookRecommendationPrompt(articles []*entity.Article, feedback []*entity.BookFeedback) (string, error) {
+	data := bookRecommendationPromptData{
+		Articles:  articles,
+		BookCount: defaultBookCount,
+	}
+	for _, f := range feedback {
+		switch f.State {
+		case entity.BookFeedbackLiked:
+			data.LikedBooks = append(data.LikedBooks, f)
+		case entity.BookFeedbackOwned, entity.BookFeedbackRead:
+			data.KnownBooks = append(data.KnownBooks, f)
+		}
+	}
+	return p.bookRecommendation.execute(data)
+}
+
+// 質問応答プロンプトを構築
+func (p *PromptTemplates) buildAnswerPrompt(question string, sources []service.SourceArticle, language string) (string, error) {
+	return p.answer.execute(answerPromptData{
+		Question:     question,
+		Sources:      sources,
+		LanguageName: languageName(language),
+	})
+}
+
+// ダイジェスト概要プロンプトを構築
+func (p *PromptTemplates) buildDigestPrompt(tag string, articles []service.SourceArticle, language string) (string, error) {
+	return p.digest.execute(digestPromptData{
+		Tag:          tag,
+		Articles:     articles,
+		LanguageName: languageName(language),
+	})
+}
+
+// 修正依頼プロンプトを構築
+func (p *PromptTemplates) buildRepairPrompt(invalidErr error) (string, error) {
+	return p.repair.execute(repairPromptData{Error: invalidErr.Error()})
+}
@@ -0,0 +1,178 @@
+package ai
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/domain/service"
+)
+
+func TestLoadPromptTemplates_Default(t *testing.T) {
+	templates, err := LoadPromptTemplates("")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	if templates.ArticleVersion() != "article-v3" {
+		t.Errorf("Expected version 'article-v3', got '%s'", templates.ArticleVersion())
+	}
+
+	prompt, err := templates.buildArticlePrompt("https://example.com/article", 300, "en", 4, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for _, want := range []string{"https://example.com/article", "300文字以内", "3-4個", "英語で出力"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("Expected prompt to contain '%s', got:\n%s", want, prompt)
+		}
+	}
+	if strings.Contains(prompt, "既存タグ") {
+		t.Errorf("Expected no existing tags section, got:\n%s", prompt)
+	}
+
+	prompt, err = templates.buildArticlePrompt("https://example.com/article", 300, "ja", 5, []string{"Go", "データベース"})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.Contains(prompt, "既存タグ: Go, データベース") {
+		t.Errorf("Expected prompt to contain existing tags, got:\n%s", prompt)
+	}
+
+	prompt, err = templates.buildBookRecommendationPrompt([]*entity.Article{
+		{ID: 7, Title: "Go言語入門", Summary: "Goの基本", Tags: []string{"Go", "入門"}},
+	}, nil)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.Contains(prompt, "1. 記事ID: 7\n   タイトル: Go言語入門") || !strings.Contains(prompt, "タグ: Go, 入門") {
+		t.Errorf("Unexpected book recommendation prompt:\n%s", prompt)
+	}
+	if strings.Contains(prompt, "気に入った書籍】") {
+		t.Errorf("Expected no liked books section, got:\n%s", prompt)
+	}
+
+	prompt, err = templates.buildBookRecommendationPrompt([]*entity.Article{{ID: 7, Title: "Go言語入門"}}, []*entity.BookFeedback{
+		{Title: "リーダブルコード", Author: "Dustin Boswell", State: entity.BookFeedbackLiked},
+		{Title: "プログラミング言語Go", State: entity.BookFeedbackOwned},
+		{Title: "興味のない本", State: entity.BookFeedbackDismissed},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	for _, want := range []string{"【ユーザーが気に入った書籍】\n- リーダブルコード（Dustin Boswell）", "【ユーザーが既に所有している・読んだ書籍】\n- プログラミング言語Go\n"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("Expected prompt to contain '%s', got:\n%s", want, prompt)
+		}
+	}
+	if strings.Contains(prompt, "興味のない本") {
+		t.Errorf("Expected dismissed books to be omitted, got:\n%s", prompt)
+	}
+}
+
+func TestLoadPromptTemplates_Override(t *testing.T) {
+	t.Run("正常系：同名のファイルのみ上書きされる", func(t *testing.T) {
+		dir := t.TempDir()
+		content := `{{define "version"}}article-custom-2{{end}}Summarize {{.URL}} in {{.SummaryLength}} chars.`
+		if err := os.WriteFile(filepath.Join(dir, articlePromptFile), []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		templates, err := LoadPromptTemplates(dir)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		// 上書きしたテンプレートは定義されたバージョンに内容のハッシュを付加する
+		if !strings.HasPrefix(templates.ArticleVersion(), "article-custom-2+sha256:") {
+			t.Errorf("Expected version 'article-custom-2+sha256:...', got '%s'", templates.ArticleVersion())
+		}
+
+		prompt, err := templates.buildArticlePrompt("https://example.com/a", 100, "ja", 5, nil)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if prompt != "Summarize https://example.com/a in 100 chars." {
+			t.Errorf("Unexpected prompt '%s'", prompt)
+		}
+
+		// 上書きしていないテンプレートはデフォルトのまま
+		if templates.bookRecommendation.version != "book-recommendation-v4" {
+			t.Errorf("Expected default book recommendation template, got '%s'", templates.bookRecommendation.version)
+		}
+	})
+
+	t.Run("正常系：バージョンを更新せずに内容を変更してもバージョンが変わる", func(t *testing.T) {
+		versionOf := func(content string) string {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, articlePromptFile), []byte(content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			templates, err := LoadPromptTemplates(dir)
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			return templates.ArticleVersion()
+		}
+
+		before := versionOf(`{{define "version"}}article-v3{{end}}Summarize {{.URL}}`)
+		after := versionOf(`{{define "version"}}article-v3{{end}}Summarize {{.URL}} briefly`)
+		if before == after {
+			t.Errorf("Expected different versions, got '%s' for both", before)
+		}
+	})
+
+	t.Run("正常系：バージョン未定義の場合は内容のハッシュを使う", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, articlePromptFile), []byte("Summarize {{.URL}}"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		templates, err := LoadPromptTemplates(dir)
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if !strings.HasPrefix(templates.ArticleVersion(), "sha256:") {
+			t.Errorf("Expected hash version, got '%s'", templates.ArticleVersion())
+		}
+	})
+
+	t.Run("異常系：テンプレートの構文エラー", func(t *testing.T) {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, articlePromptFile), []byte("Summarize {{.URL"), 0o644); err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := LoadPromptTemplates(dir); err == nil {
+			t.Errorf("Expected error for invalid template, got nil")
+		}
+	})
+}
+
+func TestGeminiClient_GenerateArticleFromURL_Options(t *testing.T) {
+	fs := newFixtureServer(t, "article_valid.json")
+
+	result, err := fs.client(0).GenerateArticleFromURL(context.Background(), service.ArticleGenerationRequest{
+		URL: "https://example.com/article",
+		Options: service.ArticleGenerationOptions{
+			SummaryLength: 500,
+			Language:      "en",
+			TagCount:      8,
+		},
+	})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.PromptVersion != "article-v3" {
+		t.Errorf("Expected prompt version 'article-v3', got '%s'", result.PromptVersion)
+	}
+
+	prompt := fs.requests[0].Contents[0].Parts[0].Text
+	for _, want := range []string{"500文字以内", "3-8個", "英語で出力"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("Expected prompt to contain '%s', got:\n%s", want, prompt)
+		}
+	}
+}
@@ -0,0 +1,214 @@
+package ai
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Gemini APIのresponseSchema（OpenAPI Schemaのサブセット）
+type geminiSchema struct {
+	Type             string                   `json:"type"`
+	Description      string                   `json:"description,omitempty"`
+	Properties       map[string]*geminiSchema `json:"properties,omitempty"`
+	Required         []string                 `json:"required,omitempty"`
+	PropertyOrdering []string                 `json:"propertyOrdering,omitempty"`
+	Items            *geminiSchema            `json:"items,omitempty"`
+	MinItems         int                      `json:"minItems,omitempty"`
+	MaxItems         int                      `json:"maxItems,omitempty"`
+}
+
+// スキーマの型定数
+const (
+	schemaTypeObject  = "OBJECT"
+	schemaTypeArray   = "ARRAY"
+	schemaTypeString  = "STRING"
+	schemaTypeInteger = "INTEGER"
+	schemaTypeNumber  = "NUMBER"
+	schemaTypeBoolean = "BOOLEAN"
+)
+
+// 記事生成レスポンスのスキーマ
+var articleResponseSchema = &geminiSchema{
+	Type: schemaTypeObject,
+	Properties: map[string]*geminiSchema{
+		"title":   {Type: schemaTypeString, Description: "記事のタイトル"},
+		"summary": {Type: schemaTypeString, Description: "記事の要約（200文字以内）"},
+		"suggestedTags": {
+			Type:        schemaTypeArray,
+			Description: "検索しやすく具体的なタグ",
+			Items:       &geminiSchema{Type: schemaTypeString},
+		},
+	},
+	Required:         []string{"title", "summary", "suggestedTags"},
+	PropertyOrdering: []string{"title", "summary", "suggestedTags"},
+}
+
+// 書籍推薦レスポンスのスキーマ
+var bookRecommendationResponseSchema = &geminiSchema{
+	Type: schemaTypeObject,
+	Properties: map[string]*geminiSchema{
+		"books": {
+			Type:     schemaTypeArray,
+			MinItems: 1,
+			Items: &geminiSchema{
+				Type: schemaTypeObject,
+				Properties: map[string]*geminiSchema{
+					"title":  {Type: schemaTypeString, Description: "日本語の書籍タイトル"},
+					"author": {Type: schemaTypeString, Description: "著者名"},
+					"reason": {Type: schemaTypeString, Description: "推薦する理由（100文字以内）"},
+					"sourceArticleIds": {
+						Type:        schemaTypeArray,
+						Description: "推薦のきっかけとなった記事ID",
+						Items:       &geminiSchema{Type: schemaTypeInteger},
+					},
+				},
+				Required:         []string{"title", "author", "reason", "sourceArticleIds"},
+				PropertyOrdering: []string{"title", "author", "reason", "sourceArticleIds"},
+			},
+		},
+	},
+	Required: []string{"books"},
+}
+
+// 質問応答レスポンスのスキーマ
+var answerResponseSchema = &geminiSchema{
+	Type: schemaTypeObject,
+	Properties: map[string]*geminiSchema{
+		"answer": {Type: schemaTypeString, Description: "保存された記事に基づく回答（根拠は[#記事ID]で示す）"},
+		"citations": {
+			Type:        schemaTypeArray,
+			Description: "回答の根拠とした記事ID",
+			Items:       &geminiSchema{Type: schemaTypeInteger},
+		},
+	},
+	Required:         []string{"answer", "citations"},
+	PropertyOrdering: []string{"answer", "citations"},
+}
+
+// ダイジェスト概要レスポンスのスキーマ
+var digestResponseSchema = &geminiSchema{
+	Type: schemaTypeObject,
+	Properties: map[string]*geminiSchema{
+		"overview": {Type: schemaTypeString, Description: "記事群の概要（1段落）"},
+	},
+	Required: []string{"overview"},
+}
+
+// スキーマ検証エラー
+type schemaValidationError struct {
+	Path   string
+	Reason string
+}
+
+func (e *schemaValidationError) Error() string {
+	if e.Path == "" {
+		return e.Reason
+	}
+	return e.Path + ": " + e.Reason
+}
+
+// JSON文字列を厳密にデコードしてスキーマに適合するか検証する
+func (s *geminiSchema) validateJSON(text string) error {
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return &schemaValidationError{Reason: fmt.Sprintf("invalid JSON: %v", err)}
+	}
+	// JSONの後ろに余分なテキストがある場合もエラーとする
+	if decoder.More() {
+		return &schemaValidationError{Reason: "unexpected text after JSON value"}
+	}
+
+	return s.validate("", value)
+}
+
+// デコード済みの値がスキーマに適合するか検証する
+func (s *geminiSchema) validate(path string, value any) error {
+	switch s.Type {
+	case schemaTypeObject:
+		object, ok := value.(map[string]any)
+		if !ok {
+			return typeMismatch(path, "object", value)
+		}
+		for _, name := range s.Required {
+			if _, exists := object[name]; !exists {
+				return &schemaValidationError{Path: joinPath(path, name), Reason: "required property is missing"}
+			}
+		}
+		for name, property := range s.Properties {
+			child, exists := object[name]
+			if !exists {
+				continue
+			}
+			if err := property.validate(joinPath(path, name), child); err != nil {
+				return err
+			}
+		}
+	case schemaTypeArray:
+		array, ok := value.([]any)
+		if !ok {
+			return typeMismatch(path, "array", value)
+		}
+		if s.MinItems > 0 && len(array) < s.MinItems {
+			return &schemaValidationError{Path: path, Reason: fmt.Sprintf("must have at least %d items", s.MinItems)}
+		}
+		if s.MaxItems > 0 && len(array) > s.MaxItems {
+			return &schemaValidationError{Path: path, Reason: fmt.Sprintf("must have at most %d items", s.MaxItems)}
+		}
+		if s.Items != nil {
+			for i, item := range array {
+				if err := s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+					return err
+				}
+			}
+		}
+	case schemaTypeString:
+		if _, ok := value.(string); !ok {
+			return typeMismatch(path, "string", value)
+		}
+	case schemaTypeInteger:
+		number, ok := value.(json.Number)
+		if !ok {
+			return typeMismatch(path, "integer", value)
+		}
+		if _, err := number.Int64(); err != nil {
+			return typeMismatch(path, "integer", value)
+		}
+	case schemaTypeNumber:
+		if _, ok := value.(json.Number); !ok {
+			return typeMismatch(path, "number", value)
+		}
+	case schemaTypeBoolean:
+		if _, ok := value.(bool); !ok {
+			return typeMismatch(path, "boolean", value)
+		}
+	}
+	return nil
+}
+
+func typeMismatch(path, expected string, value any) error {
+	actual := "null"
+	switch value.(type) {
+	case map[string]any:
+		actual = "object"
+	case []any:
+		actual = "array"
+	case string:
+		actual = "string"
+	case json.Number:
+		actual = "number"
+	case bool:
+		actual = "boolean"
+	}
+	return &schemaValidationError{Path: path, Reason: fmt.Sprintf("expected %s but got %s", expected, actual)}
+}
+
+func joinPath(parent, name string) string {
+	if parent == "" {
+		return name
+	}
+	return parent + "." + name
+}
@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+
+	"article-manager/internal/domain/service"
+)
+
+// LRUのエントリ
+type memoryCacheEntry struct {
+	key       string
+	article   service.GeneratedArticle
+	expiresAt time.Time
+}
+
+// メモリ上のLRUによる生成結果キャッシュ
+type MemoryGenerationCache struct {
+	maxEntries int
+	ttl        time.Duration
+	entries    map[string]*list.Element
+	order      *list.List // 先頭が最近使用したエントリ
+	mu         sync.Mutex
+	now        func() time.Time
+}
+
+// maxEntriesが0以下の場合はエントリ数を制限しない
+func NewMemoryGenerationCache(maxEntries int, ttl time.Duration) service.GenerationCache {
+	return &MemoryGenerationCache{
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+		now:        time.Now,
+	}
+}
+
+// キャッシュされた生成結果を取得
+func (c *MemoryGenerationCache) Get(ctx context.Context, key string) (*service.GeneratedArticle, bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, exists := c.entries[key]
+	if !exists {
+		return nil, false, nil
+	}
+
+	entry := element.Value.(*memoryCacheEntry)
+	if !c.now().Before(entry.expiresAt) {
+		c.remove(element)
+		return nil, false, nil
+	}
+
+	c.order.MoveToFront(element)
+	article := entry.article
+	article.SuggestedTags = append([]string(nil), entry.article.SuggestedTags...)
+	return &article, true, nil
+}
+
+// 生成結果を保存（上限を超えた場合は最も使われていないエントリを削除）
+func (c *MemoryGenerationCache) Set(ctx context.Context, key string, article *service.GeneratedArticle) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry := &memoryCacheEntry{
+		key:       key,
+		article:   *article,
+		expiresAt: c.now().Add(c.ttl),
+	}
+	entry.article.SuggestedTags = append([]string(nil), article.SuggestedTags...)
+
+	if element, exists := c.entries[key]; exists {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return nil
+	}
+
+	c.entries[key] = c.order.PushFront(entry)
+	for c.maxEntries > 0 && c.order.Len() > c.maxEntries {
+		c.remove(c.order.Back())
+	}
+	return nil
+}
+
+// キャッシュされているエントリ数
+func (c *MemoryGenerationCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+func (c *MemoryGenerationCache) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*memoryCacheEntry).key)
+}
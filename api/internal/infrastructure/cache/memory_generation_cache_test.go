@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"article-manager/internal/domain/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryGenerationCache(t *testing.T) {
+	ctx := context.Background()
+	newCache := func(maxEntries int) (*MemoryGenerationCache, *time.Time) {
+		cache := NewMemoryGenerationCache(maxEntries, time.Hour).(*MemoryGenerationCache)
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		cache.now = func() time.Time { return now }
+		return cache, &now
+	}
+	article := func(title string) *service.GeneratedArticle {
+		return &service.GeneratedArticle{Title: title, Summary: "要約", SuggestedTags: []string{"Go"}}
+	}
+
+	t.Run("正常系：保存した生成結果を取得できる", func(t *testing.T) {
+		cache, _ := newCache(10)
+		require.NoError(t, cache.Set(ctx, "a", article("A")))
+
+		cached, ok, err := cache.Get(ctx, "a")
+
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "A", cached.Title)
+		assert.Equal(t, []string{"Go"}, cached.SuggestedTags)
+	})
+
+	t.Run("正常系：存在しないキーはキャッシュミス", func(t *testing.T) {
+		cache, _ := newCache(10)
+
+		_, ok, err := cache.Get(ctx, "missing")
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("正常系：TTLを過ぎたエントリはキャッシュミスとなり削除される", func(t *testing.T) {
+		cache, now := newCache(10)
+		require.NoError(t, cache.Set(ctx, "a", article("A")))
+
+		*now = now.Add(time.Hour)
+		_, ok, err := cache.Get(ctx, "a")
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+		assert.Equal(t, 0, cache.Len())
+	})
+
+	t.Run("正常系：上限を超えると最も使われていないエントリを削除する", func(t *testing.T) {
+		cache, _ := newCache(2)
+		require.NoError(t, cache.Set(ctx, "a", article("A")))
+		require.NoError(t, cache.Set(ctx, "b", article("B")))
+		_, _, _ = cache.Get(ctx, "a")
+		require.NoError(t, cache.Set(ctx, "c", article("C")))
+
+		_, okA, _ := cache.Get(ctx, "a")
+		_, okB, _ := cache.Get(ctx, "b")
+		_, okC, _ := cache.Get(ctx, "c")
+
+		assert.True(t, okA)
+		assert.False(t, okB)
+		assert.True(t, okC)
+		assert.Equal(t, 2, cache.Len())
+	})
+
+	t.Run("正常系：取得した結果を変更してもキャッシュに影響しない", func(t *testing.T) {
+		cache, _ := newCache(10)
+		require.NoError(t, cache.Set(ctx, "a", article("A")))
+
+		cached, _, _ := cache.Get(ctx, "a")
+		cached.SuggestedTags[0] = "changed"
+		again, _, _ := cache.Get(ctx, "a")
+
+		assert.Equal(t, []string{"Go"}, again.SuggestedTags)
+	})
+}
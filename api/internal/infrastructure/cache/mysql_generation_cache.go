@@ -0,0 +1,164 @@
+package cache
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/service"
+	"article-manager/internal/infrastructure/logger"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// ai_generation_cacheテーブルに保存する生成結果
+type cachedArticlePayload struct {
+	Title         string    `json:"title"`
+	Summary       string    `json:"summary"`
+	SuggestedTags []string  `json:"suggested_tags"`
+	SourceURL     string    `json:"source_url"`
+	TokenUsed     int       `json:"token_used"`
+	PromptVersion string    `json:"prompt_version"`
+	GeneratedAt   time.Time `json:"generated_at"`
+}
+
+// MySQLによる生成結果キャッシュ（複数インスタンスで共有できる）
+type mysqlGenerationCache struct {
+	db         *sqlx.DB
+	maxEntries int
+	ttl        time.Duration
+	now        func() time.Time
+}
+
+// maxEntriesが0以下の場合はエントリ数を制限しない
+func NewMySQLGenerationCache(db *sqlx.DB, maxEntries int, ttl time.Duration) service.GenerationCache {
+	return &mysqlGenerationCache{
+		db:         db,
+		maxEntries: maxEntries,
+		ttl:        ttl,
+		now:        time.Now,
+	}
+}
+
+// キャッシュされた生成結果を取得
+func (c *mysqlGenerationCache) Get(ctx context.Context, key string) (*service.GeneratedArticle, bool, error) {
+	now := c.now()
+
+	var payload []byte
+	err := c.db.GetContext(ctx, &payload,
+		`SELECT payload FROM ai_generation_cache WHERE cache_key = ? AND expires_at > ?`,
+		key, now,
+	)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		logger.Error("Failed to get generation cache",
+			zap.Error(err),
+		)
+		return nil, false, domainerrors.DatabaseError("get generation cache", err)
+	}
+
+	var cached cachedArticlePayload
+	if err := json.Unmarshal(payload, &cached); err != nil {
+		// 壊れたエントリはキャッシュミスとして扱う
+		logger.Warn("Failed to decode generation cache payload",
+			zap.Error(err),
+		)
+		return nil, false, nil
+	}
+
+	// LRUで削除するための最終アクセス日時を更新
+	if _, err := c.db.ExecContext(ctx,
+		`UPDATE ai_generation_cache SET last_accessed_at = ? WHERE cache_key = ?`,
+		now, key,
+	); err != nil {
+		logger.Warn("Failed to update generation cache access time",
+			zap.Error(err),
+		)
+	}
+
+	return &service.GeneratedArticle{
+		Title:         cached.Title,
+		Summary:       cached.Summary,
+		SuggestedTags: cached.SuggestedTags,
+		SourceURL:     cached.SourceURL,
+		TokenUsed:     cached.TokenUsed,
+		PromptVersion: cached.PromptVersion,
+		GeneratedAt:   cached.GeneratedAt,
+	}, true, nil
+}
+
+// 生成結果を保存し、期限切れと上限を超えたエントリを削除する
+func (c *mysqlGenerationCache) Set(ctx context.Context, key string, article *service.GeneratedArticle) error {
+	payload, err := json.Marshal(cachedArticlePayload{
+		Title:         article.Title,
+		Summary:       article.Summary,
+		SuggestedTags: article.SuggestedTags,
+		SourceURL:     article.SourceURL,
+		TokenUsed:     article.TokenUsed,
+		PromptVersion: article.PromptVersion,
+		GeneratedAt:   article.GeneratedAt,
+	})
+	if err != nil {
+		return domainerrors.InvalidArgumentError("generated_article", err.Error())
+	}
+
+	now := c.now()
+	query := `
+		INSERT INTO ai_generation_cache
+			(cache_key, source_url, prompt_version, payload, created_at, expires_at, last_accessed_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			source_url = VALUES(source_url),
+			prompt_version = VALUES(prompt_version),
+			payload = VALUES(payload),
+			created_at = VALUES(created_at),
+			expires_at = VALUES(expires_at),
+			last_accessed_at = VALUES(last_accessed_at)
+	`
+	if _, err := c.db.ExecContext(ctx, query,
+		key, article.SourceURL, article.PromptVersion, payload, now, now.Add(c.ttl), now,
+	); err != nil {
+		logger.Error("Failed to set generation cache",
+			zap.Error(err),
+			zap.String("url", article.SourceURL),
+		)
+		return domainerrors.DatabaseError("set generation cache", err)
+	}
+
+	c.evict(ctx, now)
+	return nil
+}
+
+// 期限切れと上限を超えたエントリを削除（失敗してもキャッシュの保存は成功とする）
+func (c *mysqlGenerationCache) evict(ctx context.Context, now time.Time) {
+	if _, err := c.db.ExecContext(ctx, `DELETE FROM ai_generation_cache WHERE expires_at <= ?`, now); err != nil {
+		logger.Warn("Failed to delete expired generation cache",
+			zap.Error(err),
+		)
+	}
+
+	if c.maxEntries <= 0 {
+		return
+	}
+
+	query := `
+		DELETE FROM ai_generation_cache
+		WHERE last_accessed_at < (
+			SELECT last_accessed_at FROM (
+				SELECT last_accessed_at FROM ai_generation_cache
+				ORDER BY last_accessed_at DESC
+				LIMIT 1 OFFSET ?
+			) AS boundary
+		)
+	`
+	if _, err := c.db.ExecContext(ctx, query, c.maxEntries-1); err != nil {
+		logger.Warn("Failed to evict generation cache",
+			zap.Error(err),
+		)
+	}
+}
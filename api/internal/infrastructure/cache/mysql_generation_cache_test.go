@@ -0,0 +1,150 @@
+package cache
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"article-manager/internal/domain/service"
+	"article-manager/internal/infrastructure/database"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// テスト用データベースのセットアップ（接続できない場合やテーブルがない場合はスキップ）
+func setupGenerationCacheTestDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+
+	host := getEnv("TEST_DB_HOST", "localhost")
+	port := getEnv("TEST_DB_PORT", "3307")
+	user := getEnv("TEST_DB_USER", "test_user")
+	password := getEnv("TEST_DB_PASSWORD", "test_password")
+	dbname := getEnv("TEST_DB_NAME", "article_manager_test")
+
+	db, err := database.NewMySQL(host, port, user, password, dbname)
+	if err != nil {
+		t.Skipf("テスト用データベースに接続できません： %v", err)
+	}
+
+	var tableExists int
+	err = db.Get(&tableExists, "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = 'ai_generation_cache'")
+	if err != nil || tableExists == 0 {
+		db.Close()
+		t.Skip("ai_generation_cacheテーブルが存在しません")
+	}
+
+	_, err = db.Exec("DELETE FROM ai_generation_cache")
+	require.NoError(t, err, "ai_generation_cacheテーブルのクリーンアップに失敗")
+	return db
+}
+
+// 環境変数を取得
+func getEnv(key, defaultValue string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return defaultValue
+}
+
+func TestMySQLGenerationCache(t *testing.T) {
+	db := setupGenerationCacheTestDB(t)
+	defer db.Close()
+
+	ctx := context.Background()
+	// 操作ごとに1秒進む時計（最終アクセス日時の順序を確定させるため）
+	newCache := func(maxEntries int) (*mysqlGenerationCache, *time.Time) {
+		_, err := db.Exec("DELETE FROM ai_generation_cache")
+		require.NoError(t, err)
+
+		cache := NewMySQLGenerationCache(db, maxEntries, time.Hour).(*mysqlGenerationCache)
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		cache.now = func() time.Time {
+			now = now.Add(time.Second)
+			return now
+		}
+		return cache, &now
+	}
+	article := func(title string) *service.GeneratedArticle {
+		return &service.GeneratedArticle{
+			Title:         title,
+			Summary:       "要約",
+			SuggestedTags: []string{"Go"},
+			SourceURL:     "https://example.com/" + title,
+			PromptVersion: "article-v3",
+		}
+	}
+	count := func() int {
+		var n int
+		require.NoError(t, db.Get(&n, "SELECT COUNT(*) FROM ai_generation_cache"))
+		return n
+	}
+
+	t.Run("正常系：保存した生成結果を取得できる", func(t *testing.T) {
+		cache, _ := newCache(10)
+		require.NoError(t, cache.Set(ctx, "a", article("A")))
+
+		cached, ok, err := cache.Get(ctx, "a")
+
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "A", cached.Title)
+		assert.Equal(t, []string{"Go"}, cached.SuggestedTags)
+		assert.Equal(t, "https://example.com/A", cached.SourceURL)
+		assert.Equal(t, "article-v3", cached.PromptVersion)
+	})
+
+	t.Run("正常系：存在しないキーはキャッシュミス", func(t *testing.T) {
+		cache, _ := newCache(10)
+
+		_, ok, err := cache.Get(ctx, "missing")
+
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("正常系：TTLを過ぎたエントリはキャッシュミスとなり、次の保存時に削除される", func(t *testing.T) {
+		cache, now := newCache(10)
+		require.NoError(t, cache.Set(ctx, "a", article("A")))
+
+		*now = now.Add(time.Hour)
+		_, ok, err := cache.Get(ctx, "a")
+		require.NoError(t, err)
+		assert.False(t, ok)
+
+		require.NoError(t, cache.Set(ctx, "b", article("B")))
+		assert.Equal(t, 1, count())
+	})
+
+	t.Run("正常系：上限を超えると最も使われていないエントリを削除する", func(t *testing.T) {
+		cache, _ := newCache(2)
+		require.NoError(t, cache.Set(ctx, "a", article("A")))
+		require.NoError(t, cache.Set(ctx, "b", article("B")))
+		_, _, err := cache.Get(ctx, "a")
+		require.NoError(t, err)
+		require.NoError(t, cache.Set(ctx, "c", article("C")))
+
+		assert.Equal(t, 2, count())
+		_, okA, _ := cache.Get(ctx, "a")
+		_, okB, _ := cache.Get(ctx, "b")
+		_, okC, _ := cache.Get(ctx, "c")
+		assert.True(t, okA)
+		assert.False(t, okB)
+		assert.True(t, okC)
+	})
+
+	t.Run("正常系：同じキーで保存すると上書きする", func(t *testing.T) {
+		cache, _ := newCache(10)
+		require.NoError(t, cache.Set(ctx, "a", article("A")))
+		require.NoError(t, cache.Set(ctx, "a", article("A2")))
+
+		cached, ok, err := cache.Get(ctx, "a")
+
+		require.NoError(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "A2", cached.Title)
+		assert.Equal(t, 1, count())
+	})
+}
@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS article_link_health (
+    article_id BIGINT UNSIGNED NOT NULL PRIMARY KEY,
+    checked_url VARCHAR(2048) NOT NULL,
+    status_code INT NOT NULL DEFAULT 0,
+    final_url VARCHAR(2048) NOT NULL DEFAULT '',
+    link_status VARCHAR(20) NOT NULL,
+    permanent_redirect BOOLEAN NOT NULL DEFAULT FALSE,
+    error_message TEXT,
+    checked_at DATETIME(6) NOT NULL,
+    CONSTRAINT fk_article_link_health_article_id
+        FOREIGN KEY (article_id)
+        REFERENCES articles(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    INDEX idx_article_link_health_status (link_status)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
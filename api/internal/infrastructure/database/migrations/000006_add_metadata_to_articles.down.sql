@@ -0,0 +1,9 @@
+ALTER TABLE articles
+    DROP COLUMN metadata_fetched_at,
+    DROP COLUMN reading_time_minutes,
+    DROP COLUMN language,
+    DROP COLUMN favicon_url,
+    DROP COLUMN image_url,
+    DROP COLUMN published_at,
+    DROP COLUMN author,
+    DROP COLUMN site_name;
@@ -0,0 +1,9 @@
+ALTER TABLE articles
+    ADD COLUMN site_name VARCHAR(255) NULL AFTER memo,
+    ADD COLUMN author VARCHAR(255) NULL AFTER site_name,
+    ADD COLUMN published_at DATETIME(6) NULL AFTER author,
+    ADD COLUMN image_url VARCHAR(2048) NULL AFTER published_at,
+    ADD COLUMN favicon_url VARCHAR(2048) NULL AFTER image_url,
+    ADD COLUMN language VARCHAR(35) NULL AFTER favicon_url,
+    ADD COLUMN reading_time_minutes INT NULL AFTER language,
+    ADD COLUMN metadata_fetched_at DATETIME(6) NULL AFTER reading_time_minutes;
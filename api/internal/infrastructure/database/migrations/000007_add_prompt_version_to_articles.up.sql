@@ -0,0 +1 @@
+ALTER TABLE articles ADD COLUMN prompt_version VARCHAR(64) NULL AFTER metadata_fetched_at;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS ai_usage (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    operation VARCHAR(50) NOT NULL,
+    model VARCHAR(100) NOT NULL,
+    prompt_tokens INT NOT NULL DEFAULT 0,
+    output_tokens INT NOT NULL DEFAULT 0,
+    total_tokens INT NOT NULL DEFAULT 0,
+    latency_ms BIGINT NOT NULL DEFAULT 0,
+    outcome VARCHAR(20) NOT NULL,
+    error_code VARCHAR(50) NOT NULL DEFAULT '',
+    created_at DATETIME(6) NOT NULL,
+    INDEX idx_ai_usage_created_at (created_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS ai_generation_cache (
+    cache_key CHAR(64) NOT NULL PRIMARY KEY,
+    source_url VARCHAR(2048) NOT NULL,
+    prompt_version VARCHAR(100) NOT NULL DEFAULT '',
+    payload JSON NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    expires_at DATETIME(6) NOT NULL,
+    last_accessed_at DATETIME(6) NOT NULL,
+    INDEX idx_ai_generation_cache_expires_at (expires_at),
+    INDEX idx_ai_generation_cache_last_accessed_at (last_accessed_at)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS digests (
+    id BIGINT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    period_start DATETIME(6) NOT NULL,
+    period_end DATETIME(6) NOT NULL,
+    article_count INT NOT NULL DEFAULT 0,
+    groups_json MEDIUMTEXT NOT NULL,
+    markdown MEDIUMTEXT NOT NULL,
+    html MEDIUMTEXT NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    UNIQUE KEY uk_digests_period (period_start, period_end),
+    INDEX idx_digests_period_end (period_end)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
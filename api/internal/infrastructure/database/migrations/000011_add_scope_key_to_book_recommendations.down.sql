@@ -0,0 +1,3 @@
+ALTER TABLE book_recommendations
+    DROP INDEX idx_scope_key_expires_at,
+    DROP COLUMN scope_key;
@@ -0,0 +1,3 @@
+ALTER TABLE book_recommendations
+    ADD COLUMN scope_key VARCHAR(255) NOT NULL DEFAULT 'all' AFTER id,
+    ADD INDEX idx_scope_key_expires_at (scope_key, expires_at);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS book_feedback (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    feedback_key VARCHAR(512) NOT NULL,
+    isbn13 CHAR(13) NULL,
+    title VARCHAR(500) NOT NULL,
+    author VARCHAR(255) NOT NULL DEFAULT '',
+    state VARCHAR(20) NOT NULL,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL,
+    UNIQUE KEY uk_book_feedback_key (feedback_key),
+    INDEX idx_book_feedback_state (state)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
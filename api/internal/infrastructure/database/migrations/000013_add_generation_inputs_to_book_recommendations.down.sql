@@ -0,0 +1,5 @@
+ALTER TABLE book_recommendations
+    DROP INDEX idx_scope_key_created_at,
+    DROP COLUMN prompt_version,
+    DROP COLUMN model,
+    DROP COLUMN article_count;
@@ -0,0 +1,5 @@
+ALTER TABLE book_recommendations
+    ADD COLUMN article_count INT NOT NULL DEFAULT 0 AFTER recommendations_json,
+    ADD COLUMN model VARCHAR(100) NOT NULL DEFAULT '' AFTER article_count,
+    ADD COLUMN prompt_version VARCHAR(64) NOT NULL DEFAULT '' AFTER model,
+    ADD INDEX idx_scope_key_created_at (scope_key, created_at);
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS reading_list_items (
+    id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+    book_key VARCHAR(512) NOT NULL,
+    title VARCHAR(500) NOT NULL,
+    author VARCHAR(255) NOT NULL DEFAULT '',
+    isbn13 CHAR(13) NULL,
+    cover_image_url VARCHAR(2048) NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL,
+    notes TEXT NULL,
+    rating TINYINT UNSIGNED NOT NULL DEFAULT 0,
+    created_at DATETIME(6) NOT NULL,
+    updated_at DATETIME(6) NOT NULL,
+    UNIQUE KEY uk_reading_list_items_book_key (book_key),
+    INDEX idx_reading_list_items_status (status)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
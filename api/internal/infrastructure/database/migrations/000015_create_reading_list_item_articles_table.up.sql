@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS reading_list_item_articles (
+    item_id BIGINT UNSIGNED NOT NULL,
+    article_id BIGINT UNSIGNED NOT NULL,
+    PRIMARY KEY (item_id, article_id),
+    CONSTRAINT fk_reading_list_item_articles_item_id
+        FOREIGN KEY (item_id)
+        REFERENCES reading_list_items(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    CONSTRAINT fk_reading_list_item_articles_article_id
+        FOREIGN KEY (article_id)
+        REFERENCES articles(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    INDEX idx_reading_list_item_articles_article_id (article_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,3 @@
+ALTER TABLE tags
+    DROP FOREIGN KEY fk_tags_parent_id,
+    DROP COLUMN parent_id;
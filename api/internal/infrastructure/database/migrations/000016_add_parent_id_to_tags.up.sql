@@ -0,0 +1,3 @@
+ALTER TABLE tags
+    ADD COLUMN parent_id BIGINT UNSIGNED NULL AFTER name,
+    ADD CONSTRAINT fk_tags_parent_id FOREIGN KEY (parent_id) REFERENCES tags(id) ON DELETE SET NULL;
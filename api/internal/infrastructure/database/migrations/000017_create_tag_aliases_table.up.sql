@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS tag_aliases (
+    alias VARCHAR(50) NOT NULL PRIMARY KEY,
+    tag_id BIGINT UNSIGNED NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT fk_tag_aliases_tag_id
+        FOREIGN KEY (tag_id)
+        REFERENCES tags(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    INDEX idx_tag_aliases_tag_id (tag_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
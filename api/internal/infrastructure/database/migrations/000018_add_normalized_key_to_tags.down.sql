@@ -0,0 +1,4 @@
+ALTER TABLE tags
+    DROP INDEX uk_tags_normalized_key,
+    DROP COLUMN normalized_key,
+    ADD UNIQUE INDEX name (name);
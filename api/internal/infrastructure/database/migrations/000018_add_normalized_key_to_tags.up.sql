@@ -0,0 +1,4 @@
+ALTER TABLE tags
+    ADD COLUMN normalized_key VARCHAR(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NULL AFTER name,
+    ADD UNIQUE INDEX uk_tags_normalized_key (normalized_key),
+    DROP INDEX name;
@@ -0,0 +1,4 @@
+ALTER TABLE tag_aliases
+    DROP INDEX uk_tag_aliases_normalized_key,
+    DROP COLUMN normalized_key,
+    MODIFY alias VARCHAR(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_unicode_ci NOT NULL;
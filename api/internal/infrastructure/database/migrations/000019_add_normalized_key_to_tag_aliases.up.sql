@@ -0,0 +1,4 @@
+ALTER TABLE tag_aliases
+    MODIFY alias VARCHAR(50) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NOT NULL,
+    ADD COLUMN normalized_key VARCHAR(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_bin NULL AFTER alias,
+    ADD UNIQUE INDEX uk_tag_aliases_normalized_key (normalized_key);
@@ -0,0 +1,3 @@
+ALTER TABLE tags
+    DROP COLUMN description,
+    DROP COLUMN color;
@@ -0,0 +1,3 @@
+ALTER TABLE tags
+    ADD COLUMN color CHAR(7) NULL AFTER parent_id,
+    ADD COLUMN description VARCHAR(500) NULL AFTER color;
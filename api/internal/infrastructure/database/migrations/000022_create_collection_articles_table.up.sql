@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS collection_articles (
+    collection_id BIGINT UNSIGNED NOT NULL,
+    article_id BIGINT UNSIGNED NOT NULL,
+    position INT UNSIGNED NOT NULL,
+    PRIMARY KEY (collection_id, article_id),
+    UNIQUE KEY uk_collection_articles_position (collection_id, position),
+    CONSTRAINT fk_collection_articles_collection_id
+        FOREIGN KEY (collection_id)
+        REFERENCES collections(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    CONSTRAINT fk_collection_articles_article_id
+        FOREIGN KEY (article_id)
+        REFERENCES articles(id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    INDEX idx_collection_articles_article_id (article_id)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -0,0 +1,3 @@
+ALTER TABLE articles
+    DROP COLUMN metadata_failed_at,
+    DROP COLUMN metadata_fetch_failures;
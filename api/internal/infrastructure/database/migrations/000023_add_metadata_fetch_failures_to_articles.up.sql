@@ -0,0 +1,3 @@
+ALTER TABLE articles
+    ADD COLUMN metadata_fetch_failures INT NOT NULL DEFAULT 0 AFTER metadata_fetched_at,
+    ADD COLUMN metadata_failed_at DATETIME(6) NULL AFTER metadata_fetch_failures;
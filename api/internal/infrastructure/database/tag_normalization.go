@@ -0,0 +1,140 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"article-manager/internal/domain/entity"
+)
+
+// 正規化キーの更新対象の行
+type normalizedKeyRow struct {
+	ID            int64          `db:"id"`
+	Name          string         `db:"name"`
+	NormalizedKey sql.NullString `db:"normalized_key"`
+}
+
+// タグ名を正規化し、タグ名と別名の正規化キーを正規化方針に合わせて埋め直して、同じキーになる衝突を報告する
+// SQLではNFKC相当の正規化ができないため、スキーマのマイグレーション後に実行する
+// 衝突したタグはID順で先頭のタグのみが正規化キーを持つ
+// 残りのタグはキーを持たないため、呼び出し元は返した衝突を先頭のタグに統合して解消する
+func (m *MigrationManager) MigrateTagNormalizedKeys(ctx context.Context, policy entity.TagNamePolicy) ([]entity.TagNameCollision, error) {
+	var tagRows []normalizedKeyRow
+	if err := m.db.SelectContext(ctx, &tagRows, `SELECT id, name, normalized_key FROM tags ORDER BY id ASC`); err != nil {
+		return nil, fmt.Errorf("タグの取得に失敗: %w", err)
+	}
+	// 別名は主キーが名前のため、名前をIDの代わりに使う
+	var aliasRows []normalizedKeyRow
+	if err := m.db.SelectContext(ctx, &aliasRows, `SELECT 0 AS id, alias AS name, normalized_key FROM tag_aliases ORDER BY created_at ASC, alias ASC`); err != nil {
+		return nil, fmt.Errorf("タグの別名の取得に失敗: %w", err)
+	}
+
+	tags := make([]*entity.Tag, 0, len(tagRows))
+	for _, row := range tagRows {
+		tags = append(tags, &entity.Tag{ID: row.ID, Name: row.Name})
+	}
+	collisions := policy.FindCollisions(tags)
+	for _, collision := range collisions {
+		names := make([]string, 0, len(collision.Tags))
+		for _, tag := range collision.Tags {
+			names = append(names, fmt.Sprintf("%q(id=%d)", tag.Name, tag.ID))
+		}
+		m.logger.Printf("タグ名の衝突を検出しました(キー: %q): %s\n", collision.Key, strings.Join(names, ", "))
+	}
+
+	// タグの正規化キーを決める（衝突したタグは先頭以外をNULLとする）
+	tagKeys := make(map[int64]sql.NullString, len(tagRows))
+	taken := make(map[string]bool, len(tagRows))
+	for _, row := range tagRows {
+		key := policy.Key(row.Name)
+		if taken[key] {
+			tagKeys[row.ID] = sql.NullString{}
+			continue
+		}
+		taken[key] = true
+		tagKeys[row.ID] = sql.NullString{String: key, Valid: true}
+	}
+
+	// 別名の正規化キーを決める（タグ名や他の別名と衝突する別名はNULLとする）
+	aliasKeys := make(map[string]sql.NullString, len(aliasRows))
+	for _, row := range aliasRows {
+		key := policy.Key(row.Name)
+		if taken[key] {
+			m.logger.Printf("タグの別名%qはタグ名または他の別名と衝突しています(キー: %q)\n", row.Name, key)
+			aliasKeys[row.Name] = sql.NullString{}
+			continue
+		}
+		taken[key] = true
+		aliasKeys[row.Name] = sql.NullString{String: key, Valid: true}
+	}
+
+	tx, err := m.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("トランザクションの開始に失敗: %w", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	// キーを入れ替える場合に一意制約に違反しないよう、変更する行のキーを先に外す
+	updated := 0
+	for _, row := range tagRows {
+		if row.NormalizedKey == tagKeys[row.ID] {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE tags SET normalized_key = NULL WHERE id = ?`, row.ID); err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("タグの正規化キーの初期化に失敗: %w", err)
+		}
+	}
+	for _, row := range aliasRows {
+		if row.NormalizedKey == aliasKeys[row.Name] {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE tag_aliases SET normalized_key = NULL WHERE alias = ?`, row.Name); err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("タグの別名の正規化キーの初期化に失敗: %w", err)
+		}
+	}
+	// 既存のタグ名も正規化する
+	for _, row := range tagRows {
+		key := tagKeys[row.ID]
+		name := entity.NormalizeTagName(row.Name)
+		if row.NormalizedKey == key && row.Name == name {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE tags SET name = ?, normalized_key = ? WHERE id = ?`, name, key, row.ID); err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("タグの正規化キーの更新に失敗: %w", err)
+		}
+		updated++
+	}
+	for _, row := range aliasRows {
+		key := aliasKeys[row.Name]
+		if row.NormalizedKey == key || !key.Valid {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, `UPDATE tag_aliases SET normalized_key = ? WHERE alias = ?`, key, row.Name); err != nil {
+			_ = tx.Rollback()
+			return nil, fmt.Errorf("タグの別名の正規化キーの更新に失敗: %w", err)
+		}
+		updated++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("トランザクションのコミットに失敗: %w", err)
+	}
+
+	if updated > 0 {
+		m.logger.Printf("タグ名と別名の正規化キーを%d件更新しました\n", updated)
+	}
+	if len(collisions) > 0 {
+		m.logger.Printf("タグ名の衝突が%d件あります\n", len(collisions))
+	}
+	return collisions, nil
+}
@@ -3,6 +3,7 @@ package external
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"article-manager/internal/domain/service"
+	"article-manager/internal/infrastructure/resilience"
 )
 
 // Google Books API設定
@@ -20,6 +22,16 @@ type GoogleBooksConfig struct {
 	Timeout       time.Duration
 	MaxRetries    int
 	RetryWaitTime time.Duration
+	// リトライ間隔の上限（指数バックオフ）
+	MaxRetryWaitTime time.Duration
+	// これより長いRetry-Afterを指定された場合はリトライしない
+	MaxRetryAfter time.Duration
+	// 1秒あたりのリクエスト数とバースト（0以下の場合は制限しない）
+	RequestsPerSecond float64
+	RateBurst         int
+	// サーキットを開く連続失敗数と、開いてから試行呼び出しを行うまでの時間
+	BreakerFailureThreshold int
+	BreakerOpenTimeout      time.Duration
 }
 
 // デフォルトGoogle Books API設定
@@ -30,6 +42,13 @@ func DefaultGoogleBooksConfig(apiKey string) *GoogleBooksConfig {
 		Timeout:       10 * time.Second,
 		MaxRetries:    3,
 		RetryWaitTime: 1 * time.Second,
+
+		MaxRetryWaitTime:        10 * time.Second,
+		MaxRetryAfter:           30 * time.Second,
+		RequestsPerSecond:       5,
+		RateBurst:               10,
+		BreakerFailureThreshold: 5,
+		BreakerOpenTimeout:      30 * time.Second,
 	}
 }
 
@@ -37,6 +56,7 @@ func DefaultGoogleBooksConfig(apiKey string) *GoogleBooksConfig {
 type GoogleBooksClient struct {
 	config     *GoogleBooksConfig
 	httpClient *http.Client
+	guard      *resilience.Guard
 }
 
 // 新しいクライアントを作成
@@ -46,9 +66,24 @@ func NewGoogleBooksClient(config *GoogleBooksConfig) *GoogleBooksClient {
 		httpClient: &http.Client{
 			Timeout: config.Timeout,
 		},
+		guard: resilience.NewGuard("google_books", resilience.Config{
+			MaxRetries:        config.MaxRetries,
+			InitialBackoff:    config.RetryWaitTime,
+			MaxBackoff:        config.MaxRetryWaitTime,
+			MaxRetryAfter:     config.MaxRetryAfter,
+			RequestsPerSecond: config.RequestsPerSecond,
+			Burst:             config.RateBurst,
+			FailureThreshold:  config.BreakerFailureThreshold,
+			OpenTimeout:       config.BreakerOpenTimeout,
+		}),
 	}
 }
 
+// Google Books API呼び出しのサーキットブレーカー
+func (c *GoogleBooksClient) CircuitBreaker() *resilience.CircuitBreaker {
+	return c.guard.Breaker()
+}
+
 // 書籍詳細情報
 type BookDetail struct {
 	Title         string
@@ -92,36 +127,41 @@ func (c *GoogleBooksClient) SearchBook(ctx context.Context, title, author string
 		}
 	}
 
-	var lastErr error
-	for attempt := 0; attempt <= c.config.MaxRetries; attempt++ {
-		if attempt > 0 {
-			select {
-			case <-time.After(c.config.RetryWaitTime * time.Duration(attempt)):
-			case <-ctx.Done():
-				return nil, &service.BookRecommendationError{
-					Code:    service.ErrCodeBooksAPIError,
-					Message: "context cancelled",
-					Err:     ctx.Err(),
-				}
-			}
+	var book *BookDetail
+	err := c.guard.Do(ctx, func(ctx context.Context) error {
+		detail, err := c.searchBookRequest(ctx, title, author)
+		if err != nil {
+			return err
 		}
+		book = detail
+		return nil
+	}, c.isRetryable)
+	if err == nil {
+		return book, nil
+	}
 
-		book, err := c.searchBookRequest(ctx, title, author)
-		if err == nil {
-			return book, nil
+	var exhaustedErr *resilience.RetryExhaustedError
+	switch {
+	case errors.As(err, &exhaustedErr):
+		return nil, &service.BookRecommendationError{
+			Code:    service.ErrCodeBooksAPIError,
+			Message: fmt.Sprintf("failed after %d retries", exhaustedErr.Attempts-1),
+			Err:     exhaustedErr.Err,
 		}
-
-		lastErr = err
-		// レート制限エラーやネットワークエラーの場合はリトライ
-		if !c.isRetryable(err) {
-			return nil, err
+	case errors.Is(err, resilience.ErrCircuitOpen):
+		return nil, &service.BookRecommendationError{
+			Code:    service.ErrCodeBooksAPIError,
+			Message: "Google Books API is temporarily unavailable",
+			Err:     err,
 		}
-	}
-
-	return nil, &service.BookRecommendationError{
-		Code:    service.ErrCodeBooksAPIError,
-		Message: fmt.Sprintf("failed after %d retries", c.config.MaxRetries),
-		Err:     lastErr,
+	case errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded):
+		return nil, &service.BookRecommendationError{
+			Code:    service.ErrCodeBooksAPIError,
+			Message: "context cancelled",
+			Err:     err,
+		}
+	default:
+		return nil, err
 	}
 }
 
@@ -170,7 +210,7 @@ func (c *GoogleBooksClient) searchBookRequest(ctx context.Context, title, author
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, c.handleError(resp.StatusCode, body)
+		return nil, resilience.WithRetryAfter(c.handleError(resp.StatusCode, body), resp.Header)
 	}
 
 	var booksResp googleBooksResponse
@@ -299,8 +339,8 @@ func (c *GoogleBooksClient) handleError(statusCode int, body []byte) error {
 
 // リトライ可能なエラーか判定
 func (c *GoogleBooksClient) isRetryable(err error) bool {
-	bookErr, ok := err.(*service.BookRecommendationError)
-	if !ok {
+	var bookErr *service.BookRecommendationError
+	if !errors.As(err, &bookErr) {
 		return false
 	}
 
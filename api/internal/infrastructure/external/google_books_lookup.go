@@ -0,0 +1,103 @@
+package external
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/domain/service"
+	"article-manager/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+	"golang.org/x/text/unicode/norm"
+)
+
+// GoogleBooksClientがBookLookupを満たすことを保証
+var _ service.BookLookup = (*GoogleBooksClient)(nil)
+
+// 書籍を検索して実在を確認する
+// 検索結果のタイトルが一致し、ISBN-13が得られた場合のみ書誌情報を返す
+func (c *GoogleBooksClient) LookupBook(ctx context.Context, title, author string) (*entity.Book, error) {
+	detail, err := c.SearchBook(ctx, title, author)
+	if err != nil {
+		return nil, err
+	}
+
+	isbn13 := toISBN13(detail.ISBN13)
+	if isbn13 == "" {
+		isbn13 = toISBN13(detail.ISBN)
+	}
+	if isbn13 == "" {
+		logger.Debug("Book has no ISBN in Google Books",
+			zap.String("title", title),
+		)
+		return nil, nil
+	}
+	if !titlesMatch(title, detail.Title) {
+		logger.Debug("Google Books returned a different title",
+			zap.String("title", title),
+			zap.String("found_title", detail.Title),
+		)
+		return nil, nil
+	}
+
+	links := c.generatePurchaseLinks("", isbn13)
+	return &entity.Book{
+		Title:         detail.Title,
+		Author:        detail.Author,
+		ISBN13:        isbn13,
+		CoverImageURL: strings.Replace(detail.ImageURL, "http://", "https://", 1),
+		PurchaseLinks: entity.PurchaseLinks{
+			Amazon:  links.Amazon,
+			Rakuten: links.Rakuten,
+		},
+	}, nil
+}
+
+// ISBN-10またはISBN-13をチェックディジットを検証してISBN-13に変換する（不正な場合は空文字）
+func toISBN13(isbn string) string {
+	isbn13, err := entity.ToISBN13(isbn)
+	if err != nil {
+		return ""
+	}
+	return isbn13
+}
+
+// 表記揺れ（大文字小文字・全角半角・空白・記号）を無視して完全一致した場合に一致とみなす
+// 副題の有無による違いを許容するため、副題の区切り記号より前の主題との一致も認める
+// 単純な部分一致は「Go」のような短いタイトルが多くの書籍に一致してしまうため行わない
+func titlesMatch(a, b string) bool {
+	na, nb := normalizeTitle(a), normalizeTitle(b)
+	if na == "" || nb == "" {
+		return false
+	}
+	if na == nb {
+		return true
+	}
+	ma, mb := normalizeTitle(mainTitle(a)), normalizeTitle(mainTitle(b))
+	return (ma != "" && ma == nb) || (mb != "" && mb == na)
+}
+
+// NFKCで全角半角を統一し、小文字の文字と数字のみを残す
+func normalizeTitle(title string) string {
+	var b strings.Builder
+	for _, r := range norm.NFKC.String(title) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+		}
+	}
+	return b.String()
+}
+
+// 副題の区切りとみなす記号（NFKC変換後）
+const subtitleSeparators = ":-‐―—~〜([「『/|"
+
+// 最初の副題の区切り記号より前の主題を返す（区切りがない場合は空文字）
+func mainTitle(title string) string {
+	normalized := norm.NFKC.String(title)
+	if i := strings.IndexAny(normalized, subtitleSeparators); i > 0 {
+		return normalized[:i]
+	}
+	return ""
+}
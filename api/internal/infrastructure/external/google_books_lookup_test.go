@@ -0,0 +1,157 @@
+package external
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 固定のレスポンスを返すGoogle Books APIのモックサーバーでクライアントを作成
+func newLookupTestClient(t *testing.T, body string) *GoogleBooksClient {
+	t.Helper()
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(mockServer.Close)
+
+	config := DefaultGoogleBooksConfig("")
+	config.BaseURL = mockServer.URL
+	return NewGoogleBooksClient(config)
+}
+
+// LookupBookのテスト
+func TestLookupBook(t *testing.T) {
+	t.Run("正常系：書誌情報とISBNから生成した購入リンクを返す", func(t *testing.T) {
+		client := newLookupTestClient(t, `{
+			"items": [{
+				"volumeInfo": {
+					"title": "リーダブルコード",
+					"authors": ["Dustin Boswell", "Trevor Foucher"],
+					"industryIdentifiers": [{"type": "ISBN_13", "identifier": "9784873115658"}],
+					"imageLinks": {"thumbnail": "http://books.google.com/books/content?id=1"}
+				}
+			}]
+		}`)
+
+		book, err := client.LookupBook(context.Background(), "リーダブルコード ―より良いコードを書くためのシンプルで実践的なテクニック", "Dustin Boswell")
+
+		require.NoError(t, err)
+		require.NotNil(t, book)
+		assert.Equal(t, "リーダブルコード", book.Title)
+		assert.Equal(t, "Dustin Boswell, Trevor Foucher", book.Author)
+		assert.Equal(t, "9784873115658", book.ISBN13)
+		assert.Equal(t, "https://books.google.com/books/content?id=1", book.CoverImageURL)
+		assert.Equal(t, "https://www.amazon.co.jp/s?k=9784873115658", book.PurchaseLinks.Amazon)
+		assert.Equal(t, "https://books.rakuten.co.jp/search?sitem=9784873115658", book.PurchaseLinks.Rakuten)
+	})
+
+	t.Run("正常系：ISBN-10のみの場合はISBN-13に変換する", func(t *testing.T) {
+		client := newLookupTestClient(t, `{
+			"items": [{
+				"volumeInfo": {
+					"title": "Clean Architecture",
+					"industryIdentifiers": [{"type": "ISBN_10", "identifier": "4048930656"}]
+				}
+			}]
+		}`)
+
+		book, err := client.LookupBook(context.Background(), "Ｃｌｅａｎ　Ａｒｃｈｉｔｅｃｔｕｒｅ", "")
+
+		require.NoError(t, err)
+		require.NotNil(t, book)
+		assert.Equal(t, "9784048930659", book.ISBN13)
+	})
+
+	t.Run("正常系：検索結果がない書籍は確認できない", func(t *testing.T) {
+		client := newLookupTestClient(t, `{"items": []}`)
+
+		book, err := client.LookupBook(context.Background(), "存在しない書籍", "")
+
+		require.NoError(t, err)
+		assert.Nil(t, book)
+	})
+
+	t.Run("正常系：短いタイトルを含むだけの書籍は確認できない", func(t *testing.T) {
+		client := newLookupTestClient(t, `{
+			"items": [{
+				"volumeInfo": {
+					"title": "Go言語による並行処理",
+					"industryIdentifiers": [{"type": "ISBN_13", "identifier": "9784873118468"}]
+				}
+			}]
+		}`)
+
+		book, err := client.LookupBook(context.Background(), "Go", "")
+
+		require.NoError(t, err)
+		assert.Nil(t, book)
+	})
+
+	t.Run("正常系：タイトルが異なる書籍は確認できない", func(t *testing.T) {
+		client := newLookupTestClient(t, `{
+			"items": [{
+				"volumeInfo": {
+					"title": "まったく別の本",
+					"industryIdentifiers": [{"type": "ISBN_13", "identifier": "9784873115658"}]
+				}
+			}]
+		}`)
+
+		book, err := client.LookupBook(context.Background(), "リーダブルコード", "")
+
+		require.NoError(t, err)
+		assert.Nil(t, book)
+	})
+}
+
+func TestTitlesMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        string
+		b        string
+		expected bool
+	}{
+		{name: "完全一致", a: "リーダブルコード", b: "リーダブルコード", expected: true},
+		{name: "全角英数字と空白を無視", a: "Ｃｌｅａｎ　Ａｒｃｈｉｔｅｃｔｕｒｅ", b: "Clean Architecture", expected: true},
+		{name: "半角カタカナを全角として比較", a: "ﾘｰﾀﾞﾌﾞﾙｺｰﾄﾞ", b: "リーダブルコード", expected: true},
+		{name: "副題付きのタイトル", a: "リーダブルコード ―より良いコードを書くためのシンプルで実践的なテクニック", b: "リーダブルコード", expected: true},
+		{name: "コロン区切りの副題", a: "Clean Code", b: "Clean Code: A Handbook of Agile Software Craftsmanship", expected: true},
+		{name: "短いタイトルは前方一致しない", a: "Go", b: "Go言語による並行処理", expected: false},
+		{name: "短いタイトルは部分一致しない", a: "SQL", b: "達人に学ぶSQL徹底指南書", expected: false},
+		{name: "区切りのない続きは副題とみなさない", a: "SQL", b: "SQL Antipatterns", expected: false},
+		{name: "空のタイトル", a: "", b: "Go", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, titlesMatch(tt.a, tt.b))
+		})
+	}
+}
+
+func TestToISBN13(t *testing.T) {
+	tests := []struct {
+		name     string
+		isbn     string
+		expected string
+	}{
+		{name: "ISBN-13はそのまま", isbn: "9784873115658", expected: "9784873115658"},
+		{name: "ハイフンを除く", isbn: "978-4-87311-565-8", expected: "9784873115658"},
+		{name: "ISBN-10を変換", isbn: "4873115655", expected: "9784873115658"},
+		{name: "チェックディジットがXのISBN-10", isbn: "020161622X", expected: "9780201616224"},
+		{name: "チェックディジットが不正なISBN-13", isbn: "9784873115659", expected: ""},
+		{name: "チェックディジットが不正なISBN-10", isbn: "4873115656", expected: ""},
+		{name: "桁数が不正", isbn: "12345", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, toISBN13(tt.isbn))
+		})
+	}
+}
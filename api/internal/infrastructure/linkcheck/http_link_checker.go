@@ -0,0 +1,198 @@
+package linkcheck
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"article-manager/internal/domain/service"
+)
+
+// リンクチェッカー設定
+type HTTPLinkCheckerConfig struct {
+	Timeout            time.Duration
+	MaxRedirects       int
+	PerHostConcurrency int           // 同一ホストへの同時リクエスト数
+	PerHostInterval    time.Duration // 同一ホストへのリクエスト間隔
+	UserAgent          string
+}
+
+// デフォルトリンクチェッカー設定
+func DefaultHTTPLinkCheckerConfig() *HTTPLinkCheckerConfig {
+	return &HTTPLinkCheckerConfig{
+		Timeout:            10 * time.Second,
+		MaxRedirects:       10,
+		PerHostConcurrency: 1,
+		PerHostInterval:    1 * time.Second,
+		UserAgent:          "ArticleManagerLinkChecker/1.0",
+	}
+}
+
+// HTTPでリンクの死活確認を行うチェッカー
+type HTTPLinkChecker struct {
+	config     *HTTPLinkCheckerConfig
+	httpClient *http.Client
+
+	mu    sync.Mutex
+	hosts map[string]*hostLimiter
+}
+
+// ホスト単位の同時実行数と間隔の制御
+type hostLimiter struct {
+	sem  chan struct{}
+	mu   sync.Mutex
+	last time.Time
+}
+
+// 新しいチェッカーを作成
+func NewHTTPLinkChecker(config *HTTPLinkCheckerConfig) *HTTPLinkChecker {
+	return &HTTPLinkChecker{
+		config: config,
+		httpClient: &http.Client{
+			Timeout: config.Timeout,
+			// リダイレクトは恒久的かどうかを判定するため自前で追跡する
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		hosts: make(map[string]*hostLimiter),
+	}
+}
+
+// リンクを確認
+func (c *HTTPLinkChecker) CheckLink(ctx context.Context, rawURL string) (*service.LinkCheckResult, error) {
+	currentURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid url: %w", err)
+	}
+
+	permanent := true
+	redirected := false
+
+	for hop := 0; hop <= c.config.MaxRedirects; hop++ {
+		statusCode, location, err := c.request(ctx, currentURL)
+		if err != nil {
+			return nil, err
+		}
+
+		if !isRedirect(statusCode) || location == "" {
+			return &service.LinkCheckResult{
+				StatusCode:        statusCode,
+				FinalURL:          currentURL.String(),
+				PermanentRedirect: redirected && permanent,
+			}, nil
+		}
+
+		nextURL, err := currentURL.Parse(location)
+		if err != nil {
+			return nil, fmt.Errorf("invalid redirect location %q: %w", location, err)
+		}
+
+		redirected = true
+		if statusCode != http.StatusMovedPermanently && statusCode != http.StatusPermanentRedirect {
+			permanent = false
+		}
+		currentURL = nextURL
+	}
+
+	return nil, fmt.Errorf("too many redirects (max %d)", c.config.MaxRedirects)
+}
+
+// HEADで確認し、HEADを受け付けないサーバーにはGETで再確認
+func (c *HTTPLinkChecker) request(ctx context.Context, target *url.URL) (int, string, error) {
+	statusCode, location, err := c.do(ctx, http.MethodHead, target)
+	if err != nil {
+		return 0, "", err
+	}
+
+	switch statusCode {
+	case http.StatusMethodNotAllowed, http.StatusNotImplemented, http.StatusForbidden:
+		return c.do(ctx, http.MethodGet, target)
+	}
+
+	return statusCode, location, nil
+}
+
+// ホスト単位の制限を守って単一のリクエストを送信
+func (c *HTTPLinkChecker) do(ctx context.Context, method string, target *url.URL) (int, string, error) {
+	release, err := c.acquire(ctx, target.Host)
+	if err != nil {
+		return 0, "", err
+	}
+	defer release()
+
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), nil)
+	if err != nil {
+		return 0, "", fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.config.UserAgent)
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, "", fmt.Errorf("request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	// コネクション再利用のため本文は一定量だけ読み捨てる
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	return resp.StatusCode, resp.Header.Get("Location"), nil
+}
+
+// ホストのスロットを取得し、前回のリクエストから一定間隔を空ける
+func (c *HTTPLinkChecker) acquire(ctx context.Context, host string) (func(), error) {
+	limiter := c.limiterFor(host)
+
+	select {
+	case limiter.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+
+	limiter.mu.Lock()
+	wait := time.Until(limiter.last.Add(c.config.PerHostInterval))
+	if wait > 0 {
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			limiter.mu.Unlock()
+			<-limiter.sem
+			return nil, ctx.Err()
+		}
+	}
+	limiter.last = time.Now()
+	limiter.mu.Unlock()
+
+	return func() { <-limiter.sem }, nil
+}
+
+func (c *HTTPLinkChecker) limiterFor(host string) *hostLimiter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	limiter, exists := c.hosts[host]
+	if !exists {
+		concurrency := c.config.PerHostConcurrency
+		if concurrency <= 0 {
+			concurrency = 1
+		}
+		limiter = &hostLimiter{sem: make(chan struct{}, concurrency)}
+		c.hosts[host] = limiter
+	}
+	return limiter
+}
+
+func isRedirect(statusCode int) bool {
+	switch statusCode {
+	case http.StatusMovedPermanently, http.StatusFound, http.StatusSeeOther,
+		http.StatusTemporaryRedirect, http.StatusPermanentRedirect:
+		return true
+	}
+	return false
+}
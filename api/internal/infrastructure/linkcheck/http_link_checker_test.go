@@ -0,0 +1,171 @@
+package linkcheck
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestChecker() *HTTPLinkChecker {
+	config := DefaultHTTPLinkCheckerConfig()
+	config.PerHostInterval = 0
+	return NewHTTPLinkChecker(config)
+}
+
+func TestHTTPLinkChecker_CheckLink(t *testing.T) {
+	t.Run("正常系：200を返すURL", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodHead, r.Method)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		result, err := newTestChecker().CheckLink(context.Background(), server.URL+"/article")
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, result.StatusCode)
+		assert.Equal(t, server.URL+"/article", result.FinalURL)
+		assert.False(t, result.PermanentRedirect)
+	})
+
+	t.Run("正常系：404を返すURL", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		result, err := newTestChecker().CheckLink(context.Background(), server.URL+"/missing")
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNotFound, result.StatusCode)
+	})
+
+	t.Run("正常系：HEAD非対応のサーバーにはGETで再確認", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		result, err := newTestChecker().CheckLink(context.Background(), server.URL)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, result.StatusCode)
+	})
+
+	t.Run("正常系：恒久的なリダイレクトを追跡", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/old":
+				http.Redirect(w, r, "/middle", http.StatusMovedPermanently)
+			case "/middle":
+				http.Redirect(w, r, "/new", http.StatusPermanentRedirect)
+			default:
+				w.WriteHeader(http.StatusOK)
+			}
+		}))
+		defer server.Close()
+
+		result, err := newTestChecker().CheckLink(context.Background(), server.URL+"/old")
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, result.StatusCode)
+		assert.Equal(t, server.URL+"/new", result.FinalURL)
+		assert.True(t, result.PermanentRedirect)
+	})
+
+	t.Run("正常系：一時的なリダイレクトを含む場合は恒久的とみなさない", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/old":
+				http.Redirect(w, r, "/middle", http.StatusMovedPermanently)
+			case "/middle":
+				http.Redirect(w, r, "/new", http.StatusFound)
+			default:
+				w.WriteHeader(http.StatusOK)
+			}
+		}))
+		defer server.Close()
+
+		result, err := newTestChecker().CheckLink(context.Background(), server.URL+"/old")
+
+		require.NoError(t, err)
+		assert.Equal(t, server.URL+"/new", result.FinalURL)
+		assert.False(t, result.PermanentRedirect)
+	})
+
+	t.Run("異常系：リダイレクトが多すぎる場合", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, r.URL.Path, http.StatusFound)
+		}))
+		defer server.Close()
+
+		config := DefaultHTTPLinkCheckerConfig()
+		config.PerHostInterval = 0
+		config.MaxRedirects = 3
+		_, err := NewHTTPLinkChecker(config).CheckLink(context.Background(), server.URL+"/loop")
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "too many redirects")
+	})
+
+	t.Run("異常系：接続できないURL", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		serverURL := server.URL
+		server.Close()
+
+		_, err := newTestChecker().CheckLink(context.Background(), serverURL)
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "request failed")
+	})
+}
+
+func TestHTTPLinkChecker_PerHostPoliteness(t *testing.T) {
+	t.Run("正常系：同一ホストへのリクエストは同時実行数と間隔が制限される", func(t *testing.T) {
+		var inFlight, maxInFlight int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			current := atomic.AddInt32(&inFlight, 1)
+			for {
+				max := atomic.LoadInt32(&maxInFlight)
+				if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+			w.WriteHeader(http.StatusOK)
+		}))
+		defer server.Close()
+
+		config := DefaultHTTPLinkCheckerConfig()
+		config.PerHostConcurrency = 1
+		config.PerHostInterval = 20 * time.Millisecond
+		checker := NewHTTPLinkChecker(config)
+
+		start := time.Now()
+		done := make(chan struct{})
+		for i := 0; i < 3; i++ {
+			go func() {
+				_, err := checker.CheckLink(context.Background(), server.URL)
+				assert.NoError(t, err)
+				done <- struct{}{}
+			}()
+		}
+		for i := 0; i < 3; i++ {
+			<-done
+		}
+
+		assert.Equal(t, int32(1), atomic.LoadInt32(&maxInFlight))
+		assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+	})
+}
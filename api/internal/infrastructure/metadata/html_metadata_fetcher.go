@@ -0,0 +1,295 @@
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+
+	"article-manager/internal/domain/entity"
+
+	"golang.org/x/text/encoding/htmlindex"
+)
+
+// メタデータ取得設定
+type HTMLMetadataFetcherConfig struct {
+	Timeout      time.Duration
+	MaxBodyBytes int64 // 読み込むHTMLの最大サイズ
+	UserAgent    string
+}
+
+// デフォルトメタデータ取得設定
+func DefaultHTMLMetadataFetcherConfig() *HTMLMetadataFetcherConfig {
+	return &HTMLMetadataFetcherConfig{
+		Timeout:      15 * time.Second,
+		MaxBodyBytes: 2 << 20,
+		UserAgent:    "ArticleManagerMetadataFetcher/1.0",
+	}
+}
+
+// 読了時間の推定に用いる1分あたりの文字数・単語数
+const (
+	cjkCharsPerMinute = 500
+	wordsPerMinute    = 200
+)
+
+var (
+	tagPattern       = regexp.MustCompile(`(?is)<(meta|link|html)\b([^>]*)>`)
+	attrPattern      = regexp.MustCompile(`(?s)([a-zA-Z_:-]+)\s*=\s*(?:"([^"]*)"|'([^']*)'|([^\s"'>]+))`)
+	nonTextPattern   = regexp.MustCompile(`(?is)<(script|style|noscript|template|svg)\b.*?</(script|style|noscript|template|svg)>`)
+	commentPattern   = regexp.MustCompile(`(?s)<!--.*?-->`)
+	bodyPattern      = regexp.MustCompile(`(?is)<body\b[^>]*>(.*)</body>`)
+	anyTagPattern    = regexp.MustCompile(`(?s)<[^>]*>`)
+	charsetPattern   = regexp.MustCompile(`(?is)<meta\b[^>]*\bcharset\s*=\s*["']?([\w.:-]+)`)
+	publishedLayouts = []string{
+		time.RFC3339,
+		"2006-01-02T15:04:05Z0700",
+		"2006-01-02T15:04:05",
+		"2006-01-02 15:04:05",
+		"2006-01-02",
+	}
+)
+
+// HTMLを取得してメタデータを抽出するフェッチャー
+type HTMLMetadataFetcher struct {
+	config     *HTMLMetadataFetcherConfig
+	httpClient *http.Client
+}
+
+// 新しいフェッチャーを作成
+func NewHTMLMetadataFetcher(config *HTMLMetadataFetcherConfig) *HTMLMetadataFetcher {
+	return &HTMLMetadataFetcher{
+		config: config,
+		httpClient: &http.Client{
+			Timeout: config.Timeout,
+		},
+	}
+}
+
+// URLのページからメタデータを取得
+func (f *HTMLMetadataFetcher) FetchMetadata(ctx context.Context, rawURL string) (*entity.ArticleMetadata, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", f.config.UserAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch page: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+	contentType := resp.Header.Get("Content-Type")
+	if contentType != "" && !strings.Contains(contentType, "html") {
+		return nil, fmt.Errorf("unsupported content type: %s", contentType)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, f.config.MaxBodyBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read body: %w", err)
+	}
+
+	// リダイレクト後のURLを相対パス解決の基準とする
+	return ParseHTMLMetadata(resp.Request.URL, decodeHTML(body, contentType)), nil
+}
+
+// HTML仕様の文字コードの事前走査と同じく、先頭1024バイトの<meta>を対象とする
+const charsetPrescanBytes = 1024
+
+// Content-Typeのcharset、なければ<meta>の文字コード指定に従ってUTF-8の文字列に変換する
+// 指定がない場合や未対応の文字コードの場合はUTF-8とみなす
+func decodeHTML(body []byte, contentType string) string {
+	name := ""
+	if _, params, err := mime.ParseMediaType(contentType); err == nil {
+		name = params["charset"]
+	}
+	if name == "" {
+		if match := charsetPattern.FindSubmatch(body[:min(len(body), charsetPrescanBytes)]); match != nil {
+			name = string(match[1])
+		}
+	}
+	if name == "" {
+		return string(body)
+	}
+
+	encoding, err := htmlindex.Get(name)
+	if err != nil {
+		return string(body)
+	}
+	if canonical, _ := htmlindex.Name(encoding); canonical == "utf-8" {
+		return string(body)
+	}
+	decoded, err := encoding.NewDecoder().Bytes(body)
+	if err != nil {
+		return string(body)
+	}
+	return string(decoded)
+}
+
+// HTMLからメタデータを抽出
+func ParseHTMLMetadata(baseURL *url.URL, document string) *entity.ArticleMetadata {
+	metas := make(map[string]string)
+	var icon, lang string
+
+	for _, match := range tagPattern.FindAllStringSubmatch(document, -1) {
+		attrs := parseAttributes(match[2])
+		switch strings.ToLower(match[1]) {
+		case "html":
+			if lang == "" {
+				lang = attrs["lang"]
+			}
+		case "meta":
+			key := attrs["property"]
+			if key == "" {
+				key = attrs["name"]
+			}
+			key = strings.ToLower(key)
+			if key == "" || attrs["content"] == "" {
+				continue
+			}
+			// 同じキーが複数ある場合は最初の値を優先
+			if _, exists := metas[key]; !exists {
+				metas[key] = attrs["content"]
+			}
+		case "link":
+			// rel="icon" と rel="shortcut icon" の両方を対象とする
+			for _, rel := range strings.Fields(strings.ToLower(attrs["rel"])) {
+				if rel == "icon" && icon == "" {
+					icon = attrs["href"]
+				}
+			}
+		}
+	}
+
+	metadata := &entity.ArticleMetadata{
+		SiteName: firstNonEmpty(metas["og:site_name"], metas["application-name"]),
+		Author:   firstNonEmpty(metas["author"], nonURL(metas["article:author"]), metas["twitter:creator"]),
+		ImageURL: resolveURL(baseURL, firstNonEmpty(metas["og:image"], metas["og:image:url"], metas["twitter:image"])),
+		Language: normalizeLanguage(firstNonEmpty(lang, metas["og:locale"])),
+		PublishedAt: parsePublishedAt(
+			firstNonEmpty(metas["article:published_time"], metas["date"], metas["pubdate"]),
+		),
+	}
+
+	metadata.FaviconURL = resolveURL(baseURL, icon)
+	if metadata.FaviconURL == "" && baseURL != nil {
+		metadata.FaviconURL = baseURL.Scheme + "://" + baseURL.Host + "/favicon.ico"
+	}
+
+	metadata.ReadingTimeMinutes = EstimateReadingTime(extractText(document))
+
+	return metadata
+}
+
+// 本文テキストから読了時間（分）を推定
+// 日本語等のCJK文字は文字数、それ以外は単語数で換算する
+func EstimateReadingTime(text string) int {
+	cjk := 0
+	words := 0
+	inWord := false
+
+	for _, r := range text {
+		switch {
+		case unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana, unicode.Hangul):
+			cjk++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			if !inWord {
+				words++
+				inWord = true
+			}
+		default:
+			inWord = false
+		}
+	}
+
+	if cjk == 0 && words == 0 {
+		return 0
+	}
+
+	minutes := float64(cjk)/cjkCharsPerMinute + float64(words)/wordsPerMinute
+	return max(1, int(math.Ceil(minutes)))
+}
+
+func parseAttributes(raw string) map[string]string {
+	attrs := make(map[string]string)
+	for _, match := range attrPattern.FindAllStringSubmatch(raw, -1) {
+		name := strings.ToLower(match[1])
+		value := match[2] + match[3] + match[4]
+		attrs[name] = strings.TrimSpace(html.UnescapeString(value))
+	}
+	return attrs
+}
+
+func extractText(document string) string {
+	if match := bodyPattern.FindStringSubmatch(document); match != nil {
+		document = match[1]
+	}
+	document = commentPattern.ReplaceAllString(document, " ")
+	document = nonTextPattern.ReplaceAllString(document, " ")
+	document = anyTagPattern.ReplaceAllString(document, " ")
+	return html.UnescapeString(document)
+}
+
+func resolveURL(baseURL *url.URL, ref string) string {
+	if ref == "" {
+		return ""
+	}
+	parsed, err := url.Parse(ref)
+	if err != nil {
+		return ""
+	}
+	if baseURL != nil {
+		parsed = baseURL.ResolveReference(parsed)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return ""
+	}
+	return parsed.String()
+}
+
+func parsePublishedAt(value string) *time.Time {
+	if value == "" {
+		return nil
+	}
+	for _, layout := range publishedLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return &t
+		}
+	}
+	return nil
+}
+
+// "ja_JP"のようなロケール表記を"ja-JP"に揃える
+func normalizeLanguage(lang string) string {
+	return strings.ReplaceAll(strings.TrimSpace(lang), "_", "-")
+}
+
+func nonURL(value string) string {
+	if strings.HasPrefix(value, "http://") || strings.HasPrefix(value, "https://") {
+		return ""
+	}
+	return value
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,147 @@
+package metadata
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testArticleHTML = `<!DOCTYPE html>
+<html lang="ja">
+<head>
+  <meta charset="utf-8">
+  <title>テスト記事</title>
+  <meta property="og:site_name" content="Example &amp; Blog">
+  <meta content="/images/thumb.png" property="og:image">
+  <meta name="author" content="山田 太郎">
+  <meta property="article:published_time" content="2024-03-01T10:00:00+09:00">
+  <link rel="shortcut icon" href="/static/favicon.png">
+  <script>var ignored = "script text";</script>
+</head>
+<body>
+  <p>これはテスト記事の本文です。</p>
+</body>
+</html>`
+
+func TestHTMLMetadataFetcher_FetchMetadata(t *testing.T) {
+	t.Run("正常系：Open Graph等のメタデータを取得できる", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			_, _ = w.Write([]byte(testArticleHTML))
+		}))
+		defer server.Close()
+
+		fetcher := NewHTMLMetadataFetcher(DefaultHTMLMetadataFetcherConfig())
+		metadata, err := fetcher.FetchMetadata(context.Background(), server.URL+"/posts/1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "Example & Blog", metadata.SiteName)
+		assert.Equal(t, "山田 太郎", metadata.Author)
+		assert.Equal(t, server.URL+"/images/thumb.png", metadata.ImageURL)
+		assert.Equal(t, server.URL+"/static/favicon.png", metadata.FaviconURL)
+		assert.Equal(t, "ja", metadata.Language)
+		assert.Equal(t, 1, metadata.ReadingTimeMinutes)
+		require.NotNil(t, metadata.PublishedAt)
+		assert.Equal(t, "2024-03-01T01:00:00Z", metadata.PublishedAt.UTC().Format("2006-01-02T15:04:05Z07:00"))
+	})
+
+	t.Run("正常系：faviconの指定がない場合は/favicon.icoを使う", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write([]byte(`<html><head><meta property="og:locale" content="en_US"></head><body>hello</body></html>`))
+		}))
+		defer server.Close()
+
+		fetcher := NewHTMLMetadataFetcher(DefaultHTMLMetadataFetcherConfig())
+		metadata, err := fetcher.FetchMetadata(context.Background(), server.URL)
+
+		require.NoError(t, err)
+		assert.Equal(t, server.URL+"/favicon.ico", metadata.FaviconURL)
+		assert.Equal(t, "en-US", metadata.Language)
+		assert.Empty(t, metadata.ImageURL)
+	})
+
+	t.Run("正常系：Shift_JISのページを<meta>の文字コード指定に従って変換する", func(t *testing.T) {
+		body, err := os.ReadFile(filepath.Join("testdata", "shift_jis.html"))
+		require.NoError(t, err)
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html")
+			_, _ = w.Write(body)
+		}))
+		defer server.Close()
+
+		fetcher := NewHTMLMetadataFetcher(DefaultHTMLMetadataFetcherConfig())
+		metadata, err := fetcher.FetchMetadata(context.Background(), server.URL)
+
+		require.NoError(t, err)
+		assert.Equal(t, "日本語のブログ", metadata.SiteName)
+		assert.Equal(t, "山田 太郎", metadata.Author)
+		assert.Equal(t, 1, metadata.ReadingTimeMinutes)
+	})
+
+	t.Run("正常系：Content-Typeのcharsetを<meta>より優先する", func(t *testing.T) {
+		body, err := os.ReadFile(filepath.Join("testdata", "shift_jis.html"))
+		require.NoError(t, err)
+		// <meta>の指定が誤っていてもヘッダーの指定で変換する
+		body = []byte(strings.Replace(string(body), "charset=Shift_JIS", "charset=utf-8", 1))
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "text/html; charset=Shift_JIS")
+			_, _ = w.Write(body)
+		}))
+		defer server.Close()
+
+		fetcher := NewHTMLMetadataFetcher(DefaultHTMLMetadataFetcherConfig())
+		metadata, err := fetcher.FetchMetadata(context.Background(), server.URL)
+
+		require.NoError(t, err)
+		assert.Equal(t, "日本語のブログ", metadata.SiteName)
+	})
+
+	t.Run("異常系：HTML以外のレスポンス", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Content-Type", "application/pdf")
+			_, _ = w.Write([]byte("%PDF"))
+		}))
+		defer server.Close()
+
+		fetcher := NewHTMLMetadataFetcher(DefaultHTMLMetadataFetcherConfig())
+		metadata, err := fetcher.FetchMetadata(context.Background(), server.URL)
+
+		require.Error(t, err)
+		assert.Nil(t, metadata)
+	})
+
+	t.Run("異常系：ステータスコードが200以外", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+		}))
+		defer server.Close()
+
+		fetcher := NewHTMLMetadataFetcher(DefaultHTMLMetadataFetcherConfig())
+		metadata, err := fetcher.FetchMetadata(context.Background(), server.URL)
+
+		require.Error(t, err)
+		assert.Nil(t, metadata)
+	})
+}
+
+func TestEstimateReadingTime(t *testing.T) {
+	t.Run("正常系：日本語は500文字で1分", func(t *testing.T) {
+		assert.Equal(t, 2, EstimateReadingTime(strings.Repeat("あ", 501)))
+	})
+
+	t.Run("正常系：英語は200単語で1分", func(t *testing.T) {
+		assert.Equal(t, 3, EstimateReadingTime(strings.Repeat("word ", 401)))
+	})
+
+	t.Run("正常系：本文がない場合は0", func(t *testing.T) {
+		assert.Equal(t, 0, EstimateReadingTime("  \n "))
+	})
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/domain/repository"
+)
+
+// メモリ上でAI使用量を管理するリポジトリ
+type MemoryAIUsageRepository struct {
+	usages []*entity.AIUsage
+	nextID int64
+	mu     sync.RWMutex
+}
+
+// 新しいインメモリリポジトリの作成
+func NewMemoryAIUsageRepository() repository.AIUsageRepository {
+	return &MemoryAIUsageRepository{
+		usages: make([]*entity.AIUsage, 0),
+		nextID: 1,
+	}
+}
+
+// 使用量を記録
+func (r *MemoryAIUsageRepository) Create(ctx context.Context, usage *entity.AIUsage) (*entity.AIUsage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saved := *usage
+	saved.ID = r.nextID
+	r.nextID++
+	r.usages = append(r.usages, &saved)
+
+	result := saved
+	return &result, nil
+}
+
+// 期間内の使用量を期間と操作ごとに集計
+func (r *MemoryAIUsageRepository) SummarizeByPeriod(ctx context.Context, from, to time.Time, period entity.AIUsagePeriod, location *time.Location) ([]*entity.AIUsageSummary, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	type summaryKey struct {
+		period    string
+		operation string
+	}
+	summaries := make(map[summaryKey]*entity.AIUsageSummary)
+	latencies := make(map[summaryKey]time.Duration)
+
+	for _, usage := range r.usages {
+		if !inPeriod(usage.CreatedAt, from, to) {
+			continue
+		}
+		key := summaryKey{
+			period:    usage.CreatedAt.In(location).Format(period.Layout()),
+			operation: usage.Operation,
+		}
+		summary, exists := summaries[key]
+		if !exists {
+			summary = &entity.AIUsageSummary{Period: key.period, Operation: key.operation}
+			summaries[key] = summary
+		}
+		summary.Calls++
+		if usage.Outcome == entity.AIUsageOutcomeError {
+			summary.Errors++
+		}
+		summary.PromptTokens += int64(usage.PromptTokens)
+		summary.OutputTokens += int64(usage.OutputTokens)
+		summary.TotalTokens += int64(usage.TotalTokens)
+		latencies[key] += usage.Latency
+	}
+
+	result := make([]*entity.AIUsageSummary, 0, len(summaries))
+	for key, summary := range summaries {
+		summary.AverageLatency = latencies[key] / time.Duration(summary.Calls)
+		result = append(result, summary)
+	}
+	slices.SortFunc(result, func(a, b *entity.AIUsageSummary) int {
+		if c := cmp.Compare(a.Period, b.Period); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Operation, b.Operation)
+	})
+	return result, nil
+}
+
+// 期間内の合計トークン数を取得
+func (r *MemoryAIUsageRepository) SumTotalTokens(ctx context.Context, from, to time.Time) (int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var total int64
+	for _, usage := range r.usages {
+		if inPeriod(usage.CreatedAt, from, to) {
+			total += int64(usage.TotalTokens)
+		}
+	}
+	return total, nil
+}
+
+func inPeriod(t, from, to time.Time) bool {
+	return !t.Before(from) && t.Before(to)
+}
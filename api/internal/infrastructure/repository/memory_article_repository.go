@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	"article-manager/internal/domain/entity"
 	domainerrors "article-manager/internal/domain/errors"
@@ -88,6 +89,52 @@ func (r *MemoryArticleRepository) Update(ctx context.Context, article *entity.Ar
 	return &updated, nil
 }
 
+// 記事のURLのみを更新し、メタデータを破棄（URLがpreviousURLから変わっていた場合は更新しない）
+func (r *MemoryArticleRepository) UpdateURL(ctx context.Context, article *entity.Article, previousURL string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.articles[article.ID]
+	if !exists || stored.URL != previousURL {
+		return domainerrors.NotFoundError("article", "article not found")
+	}
+
+	stored.URL = article.URL
+	stored.Metadata = entity.ArticleMetadata{}
+	stored.UpdatedAt = article.UpdatedAt
+	return nil
+}
+
+// 記事のメタデータのみを更新（取得中にURLが変わっていた場合は更新しない）
+func (r *MemoryArticleRepository) UpdateMetadata(ctx context.Context, id int64, url string, metadata entity.ArticleMetadata) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	article, exists := r.articles[id]
+	if !exists || article.URL != url {
+		return domainerrors.NotFoundError("article", "article not found")
+	}
+
+	metadata.FetchFailures = 0
+	metadata.LastFailedAt = nil
+	article.Metadata = metadata
+	return nil
+}
+
+// メタデータの取得失敗を記録（取得中にURLが変わっていた場合は記録しない）
+func (r *MemoryArticleRepository) RecordMetadataFetchFailure(ctx context.Context, id int64, url string, failedAt time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	article, exists := r.articles[id]
+	if !exists || article.URL != url {
+		return domainerrors.NotFoundError("article", "article not found")
+	}
+
+	article.RecordMetadataFetchFailure(failedAt)
+	return nil
+}
+
 // 指定されたIDの記事を削除
 func (r *MemoryArticleRepository) Delete(ctx context.Context, id int64) error {
 	r.mu.Lock()
@@ -152,3 +199,40 @@ func (r *MemoryArticleRepository) Search(ctx context.Context, keyword string) ([
 
 	return result, nil
 }
+
+// 作成日時が期間内の記事を古い順に取得
+func (r *MemoryArticleRepository) FindByCreatedAtRange(ctx context.Context, from, to time.Time) ([]*entity.Article, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	result := make([]*entity.Article, 0)
+	for _, article := range r.articles {
+		if article.CreatedAt.Before(from) || !article.CreatedAt.Before(to) {
+			continue
+		}
+		copied := *article
+		result = append(result, &copied)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.Before(result[j].CreatedAt)
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
+
+// タグの共起グラフを集計
+func (r *MemoryArticleRepository) FindTagGraph(ctx context.Context, opts entity.TagGraphOptions) (*entity.TagGraph, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	articleTags := make([][]string, 0, len(r.articles))
+	for _, article := range r.articles {
+		articleTags = append(articleTags, article.Tags)
+	}
+
+	return entity.BuildTagGraph(articleTags, opts), nil
+}
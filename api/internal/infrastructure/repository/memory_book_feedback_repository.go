@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+)
+
+// メモリ上で書籍フィードバックを管理するリポジトリ
+type MemoryBookFeedbackRepository struct {
+	feedback map[int64]*entity.BookFeedback
+	nextID   int64
+	mu       sync.RWMutex
+}
+
+// 新しいインメモリリポジトリの作成
+func NewMemoryBookFeedbackRepository() repository.BookFeedbackRepository {
+	return &MemoryBookFeedbackRepository{
+		feedback: make(map[int64]*entity.BookFeedback),
+		nextID:   1,
+	}
+}
+
+// 新しいフィードバックを保存
+func (r *MemoryBookFeedbackRepository) Create(ctx context.Context, feedback *entity.BookFeedback) (*entity.BookFeedback, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.feedback {
+		if existing.Key == feedback.Key {
+			return nil, domainerrors.AlreadyExistsError("book_feedback", feedback.Key)
+		}
+	}
+
+	saved := *feedback
+	saved.ID = r.nextID
+	r.nextID++
+	r.feedback[saved.ID] = &saved
+
+	result := saved
+	return &result, nil
+}
+
+// 指定されたIDのフィードバックを取得
+func (r *MemoryBookFeedbackRepository) FindByID(ctx context.Context, id int64) (*entity.BookFeedback, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	feedback, exists := r.feedback[id]
+	if !exists {
+		return nil, domainerrors.NotFoundError("book_feedback", id)
+	}
+
+	result := *feedback
+	return &result, nil
+}
+
+// すべてのフィードバックを更新の新しい順に取得
+func (r *MemoryBookFeedbackRepository) FindAll(ctx context.Context) ([]*entity.BookFeedback, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entity.BookFeedback, 0, len(r.feedback))
+	for _, feedback := range r.feedback {
+		copied := *feedback
+		result = append(result, &copied)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].UpdatedAt.Equal(result[j].UpdatedAt) {
+			return result[i].UpdatedAt.After(result[j].UpdatedAt)
+		}
+		return result[i].ID > result[j].ID
+	})
+
+	return result, nil
+}
+
+// フィードバックを更新
+func (r *MemoryBookFeedbackRepository) Update(ctx context.Context, feedback *entity.BookFeedback) (*entity.BookFeedback, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.feedback[feedback.ID]; !exists {
+		return nil, domainerrors.NotFoundError("book_feedback", feedback.ID)
+	}
+
+	saved := *feedback
+	r.feedback[saved.ID] = &saved
+
+	result := saved
+	return &result, nil
+}
+
+// 指定されたIDのフィードバックを削除
+func (r *MemoryBookFeedbackRepository) Delete(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.feedback[id]; !exists {
+		return domainerrors.NotFoundError("book_feedback", id)
+	}
+
+	delete(r.feedback, id)
+	return nil
+}
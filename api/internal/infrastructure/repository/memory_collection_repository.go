@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+)
+
+// メモリ上でコレクションを管理するリポジトリ
+type MemoryCollectionRepository struct {
+	collections map[int64]*entity.Collection
+	nextID      int64
+	mu          sync.RWMutex
+}
+
+// 新しいインメモリリポジトリの作成
+func NewMemoryCollectionRepository() repository.CollectionRepository {
+	return &MemoryCollectionRepository{
+		collections: make(map[int64]*entity.Collection),
+		nextID:      1,
+	}
+}
+
+// 新しいコレクションを保存
+func (r *MemoryCollectionRepository) Create(ctx context.Context, collection *entity.Collection) (*entity.Collection, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.nameTaken(collection.Name, 0) {
+		return nil, domainerrors.AlreadyExistsError("collection", collection.Name)
+	}
+
+	saved := copyCollection(collection)
+	saved.ID = r.nextID
+	r.nextID++
+	r.collections[saved.ID] = saved
+
+	return copyCollection(saved), nil
+}
+
+// 指定されたIDのコレクションを取得
+func (r *MemoryCollectionRepository) FindByID(ctx context.Context, id int64) (*entity.Collection, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	collection, exists := r.collections[id]
+	if !exists {
+		return nil, domainerrors.NotFoundError("collection", id)
+	}
+
+	return copyCollection(collection), nil
+}
+
+// すべてのコレクションを名前順に取得
+func (r *MemoryCollectionRepository) FindAll(ctx context.Context) ([]*entity.Collection, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entity.Collection, 0, len(r.collections))
+	for _, collection := range r.collections {
+		result = append(result, copyCollection(collection))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return result, nil
+}
+
+// コレクションの名前と説明を更新（掲載する記事は変更しない）
+func (r *MemoryCollectionRepository) Update(ctx context.Context, collection *entity.Collection) (*entity.Collection, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := r.validateUpdate(collection); err != nil {
+		return nil, err
+	}
+
+	saved := copyCollection(r.collections[collection.ID])
+	saved.Name = collection.Name
+	saved.Description = collection.Description
+	saved.UpdatedAt = collection.UpdatedAt
+	r.collections[saved.ID] = saved
+
+	return copyCollection(saved), nil
+}
+
+// 指定されたIDのコレクションを読み込み、modifyで変更した掲載する記事を保存する
+func (r *MemoryCollectionRepository) UpdateArticles(ctx context.Context, id int64, modify func(collection *entity.Collection) error) (*entity.Collection, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	stored, exists := r.collections[id]
+	if !exists {
+		return nil, domainerrors.NotFoundError("collection", id)
+	}
+
+	collection := copyCollection(stored)
+	if err := modify(collection); err != nil {
+		return nil, err
+	}
+	r.storeArticles(collection)
+
+	return copyCollection(r.collections[id]), nil
+}
+
+// 2つのコレクションを読み込み、modifyで記事を移した結果を保存（modifyが失敗した場合はどちらも更新しない）
+func (r *MemoryCollectionRepository) Move(ctx context.Context, sourceID, targetID int64, modify func(source, target *entity.Collection) error) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if sourceID == targetID {
+		return domainerrors.InvalidArgumentError("id", "ids must be different")
+	}
+	for _, id := range []int64{sourceID, targetID} {
+		if _, exists := r.collections[id]; !exists {
+			return domainerrors.NotFoundError("collection", id)
+		}
+	}
+
+	source, target := copyCollection(r.collections[sourceID]), copyCollection(r.collections[targetID])
+	if err := modify(source, target); err != nil {
+		return err
+	}
+	r.storeArticles(source)
+	r.storeArticles(target)
+	return nil
+}
+
+// 指定されたIDのコレクションを削除
+func (r *MemoryCollectionRepository) Delete(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.collections[id]; !exists {
+		return domainerrors.NotFoundError("collection", id)
+	}
+
+	delete(r.collections, id)
+	return nil
+}
+
+func (r *MemoryCollectionRepository) validateUpdate(collection *entity.Collection) error {
+	if _, exists := r.collections[collection.ID]; !exists {
+		return domainerrors.NotFoundError("collection", collection.ID)
+	}
+	if r.nameTaken(collection.Name, collection.ID) {
+		return domainerrors.AlreadyExistsError("collection", collection.Name)
+	}
+	return nil
+}
+
+// 掲載する記事と更新日時のみを保存する
+func (r *MemoryCollectionRepository) storeArticles(collection *entity.Collection) {
+	saved := copyCollection(r.collections[collection.ID])
+	saved.ArticleIDs = slices.Clone(collection.ArticleIDs)
+	saved.UpdatedAt = collection.UpdatedAt
+	r.collections[saved.ID] = saved
+}
+
+// 他のコレクションが同じ名前を使っているか
+func (r *MemoryCollectionRepository) nameTaken(name string, excludeID int64) bool {
+	for id, collection := range r.collections {
+		if id != excludeID && collection.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// 呼び出し元の変更がリポジトリに影響しないよう、記事のIDも含めてコピーする
+func copyCollection(collection *entity.Collection) *entity.Collection {
+	copied := *collection
+	copied.ArticleIDs = slices.Clone(collection.ArticleIDs)
+	if copied.ArticleIDs == nil {
+		copied.ArticleIDs = []int64{}
+	}
+	return &copied
+}
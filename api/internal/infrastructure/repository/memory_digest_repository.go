@@ -0,0 +1,91 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+)
+
+// メモリ上でダイジェストを管理するリポジトリ
+type MemoryDigestRepository struct {
+	digests map[int64]*entity.Digest
+	nextID  int64
+	mu      sync.RWMutex
+}
+
+// 新しいインメモリリポジトリの作成
+func NewMemoryDigestRepository() repository.DigestRepository {
+	return &MemoryDigestRepository{
+		digests: make(map[int64]*entity.Digest),
+		nextID:  1,
+	}
+}
+
+// ダイジェストを保存
+func (r *MemoryDigestRepository) Create(ctx context.Context, digest *entity.Digest) (*entity.Digest, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.digests {
+		if existing.PeriodStart.Equal(digest.PeriodStart) && existing.PeriodEnd.Equal(digest.PeriodEnd) {
+			return nil, domainerrors.AlreadyExistsError("digest", digest.PeriodStart.Format(time.RFC3339))
+		}
+	}
+
+	saved := *digest
+	saved.ID = r.nextID
+	r.nextID++
+	r.digests[saved.ID] = &saved
+
+	result := saved
+	return &result, nil
+}
+
+// 指定されたIDのダイジェストを取得
+func (r *MemoryDigestRepository) FindByID(ctx context.Context, id int64) (*entity.Digest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	digest, exists := r.digests[id]
+	if !exists {
+		return nil, domainerrors.NotFoundError("digest", id)
+	}
+
+	result := *digest
+	return &result, nil
+}
+
+// すべてのダイジェストを新しい期間順に取得
+func (r *MemoryDigestRepository) FindAll(ctx context.Context) ([]*entity.Digest, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entity.Digest, 0, len(r.digests))
+	for _, digest := range r.digests {
+		copied := *digest
+		result = append(result, &copied)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].PeriodEnd.Equal(result[j].PeriodEnd) {
+			return result[i].PeriodEnd.After(result[j].PeriodEnd)
+		}
+		return result[i].ID > result[j].ID
+	})
+
+	return result, nil
+}
+
+// 最も新しい期間のダイジェストを取得
+func (r *MemoryDigestRepository) FindLatest(ctx context.Context) (*entity.Digest, error) {
+	digests, err := r.FindAll(ctx)
+	if err != nil || len(digests) == 0 {
+		return nil, err
+	}
+	return digests[0], nil
+}
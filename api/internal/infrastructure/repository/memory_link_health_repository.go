@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+)
+
+// メモリ上でリンクヘルスを管理するリポジトリ
+type MemoryLinkHealthRepository struct {
+	healths map[int64]*entity.LinkHealth
+	mu      sync.RWMutex
+}
+
+// 新しいインメモリリポジトリの作成
+func NewMemoryLinkHealthRepository() repository.LinkHealthRepository {
+	return &MemoryLinkHealthRepository{
+		healths: make(map[int64]*entity.LinkHealth),
+	}
+}
+
+// リンクヘルスを保存
+func (r *MemoryLinkHealthRepository) Save(ctx context.Context, health *entity.LinkHealth) (*entity.LinkHealth, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	saved := *health
+	r.healths[saved.ArticleID] = &saved
+
+	result := saved
+	return &result, nil
+}
+
+// 指定された記事IDのリンクヘルスを取得
+func (r *MemoryLinkHealthRepository) FindByArticleID(ctx context.Context, articleID int64) (*entity.LinkHealth, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	health, exists := r.healths[articleID]
+	if !exists {
+		return nil, domainerrors.NotFoundError("link_health", articleID)
+	}
+
+	result := *health
+	return &result, nil
+}
+
+// すべてのリンクヘルスを取得
+func (r *MemoryLinkHealthRepository) FindAll(ctx context.Context) ([]*entity.LinkHealth, error) {
+	return r.filter(func(health *entity.LinkHealth) bool { return true }), nil
+}
+
+// 指定された状態のリンクヘルスを取得
+func (r *MemoryLinkHealthRepository) FindByStatus(ctx context.Context, status entity.LinkStatus) ([]*entity.LinkHealth, error) {
+	return r.filter(func(health *entity.LinkHealth) bool { return health.Status == status }), nil
+}
+
+func (r *MemoryLinkHealthRepository) filter(match func(health *entity.LinkHealth) bool) []*entity.LinkHealth {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entity.LinkHealth, 0, len(r.healths))
+	for _, health := range r.healths {
+		if match(health) {
+			copied := *health
+			result = append(result, &copied)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ArticleID < result[j].ArticleID
+	})
+
+	return result
+}
@@ -0,0 +1,120 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"sync"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+)
+
+// メモリ上で読書リストを管理するリポジトリ
+type MemoryReadingListRepository struct {
+	items  map[int64]*entity.ReadingListItem
+	nextID int64
+	mu     sync.RWMutex
+}
+
+// 新しいインメモリリポジトリの作成
+func NewMemoryReadingListRepository() repository.ReadingListRepository {
+	return &MemoryReadingListRepository{
+		items:  make(map[int64]*entity.ReadingListItem),
+		nextID: 1,
+	}
+}
+
+// 新しい書籍を保存
+func (r *MemoryReadingListRepository) Create(ctx context.Context, item *entity.ReadingListItem) (*entity.ReadingListItem, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.items {
+		if existing.Key == item.Key {
+			return nil, domainerrors.AlreadyExistsError("reading_list_item", item.Key)
+		}
+	}
+
+	saved := copyReadingListItem(item)
+	saved.ID = r.nextID
+	r.nextID++
+	r.items[saved.ID] = saved
+
+	return copyReadingListItem(saved), nil
+}
+
+// 指定されたIDの書籍を取得
+func (r *MemoryReadingListRepository) FindByID(ctx context.Context, id int64) (*entity.ReadingListItem, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	item, exists := r.items[id]
+	if !exists {
+		return nil, domainerrors.NotFoundError("reading_list_item", id)
+	}
+
+	return copyReadingListItem(item), nil
+}
+
+// 書籍を追加の新しい順に取得
+func (r *MemoryReadingListRepository) FindAll(ctx context.Context, status entity.ReadingStatus) ([]*entity.ReadingListItem, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entity.ReadingListItem, 0, len(r.items))
+	for _, item := range r.items {
+		if status != "" && item.Status != status {
+			continue
+		}
+		result = append(result, copyReadingListItem(item))
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].CreatedAt.Equal(result[j].CreatedAt) {
+			return result[i].CreatedAt.After(result[j].CreatedAt)
+		}
+		return result[i].ID > result[j].ID
+	})
+
+	return result, nil
+}
+
+// 書籍を更新
+func (r *MemoryReadingListRepository) Update(ctx context.Context, item *entity.ReadingListItem) (*entity.ReadingListItem, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.items[item.ID]; !exists {
+		return nil, domainerrors.NotFoundError("reading_list_item", item.ID)
+	}
+
+	saved := copyReadingListItem(item)
+	r.items[saved.ID] = saved
+
+	return copyReadingListItem(saved), nil
+}
+
+// 指定されたIDの書籍を削除
+func (r *MemoryReadingListRepository) Delete(ctx context.Context, id int64) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.items[id]; !exists {
+		return domainerrors.NotFoundError("reading_list_item", id)
+	}
+
+	delete(r.items, id)
+	return nil
+}
+
+// 呼び出し元の変更がリポジトリに影響しないよう、関連する記事のIDも含めてコピーする
+func copyReadingListItem(item *entity.ReadingListItem) *entity.ReadingListItem {
+	copied := *item
+	copied.ArticleIDs = slices.Clone(item.ArticleIDs)
+	if copied.ArticleIDs == nil {
+		copied.ArticleIDs = []int64{}
+	}
+	return &copied
+}
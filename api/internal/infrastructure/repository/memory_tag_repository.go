@@ -3,7 +3,9 @@ package repository
 import (
 	"context"
 	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	"article-manager/internal/domain/entity"
 	domainerrors "article-manager/internal/domain/errors"
@@ -12,16 +14,20 @@ import (
 
 // メモリ上でタグを管理するリポジトリ
 type MemoryTagRepository struct {
-	tags   map[int64]*entity.Tag
-	nextID int64
-	mu     sync.RWMutex
+	tags    map[int64]*entity.Tag
+	aliases map[string]*entity.TagAlias // 正規化キー → 別名
+	policy  entity.TagNamePolicy
+	nextID  int64
+	mu      sync.RWMutex
 }
 
 // 新しいインメモリリポジトリの作成
-func NewMemoryTagRepository() repository.TagRepository {
+func NewMemoryTagRepository(policy entity.TagNamePolicy) repository.TagRepository {
 	return &MemoryTagRepository{
-		tags:   make(map[int64]*entity.Tag),
-		nextID: 1,
+		tags:    make(map[int64]*entity.Tag),
+		aliases: make(map[string]*entity.TagAlias),
+		policy:  policy,
+		nextID:  1,
 	}
 }
 
@@ -30,6 +36,10 @@ func (r *MemoryTagRepository) Create(ctx context.Context, tag *entity.Tag) (*ent
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	if r.nameTaken(tag.Name, 0) {
+		return nil, domainerrors.AlreadyExistsError("tag", tag.Name)
+	}
+
 	// IDを自動裁判
 	tag.ID = r.nextID
 	r.nextID++
@@ -59,8 +69,17 @@ func (r *MemoryTagRepository) FindByName(ctx context.Context, name string) (*ent
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	key := r.policy.Key(name)
 	for _, tag := range r.tags {
-		if tag.Name == name {
+		if r.policy.Key(tag.Name) == key {
+			result := *tag
+			return &result, nil
+		}
+	}
+
+	// 別名の場合は代表のタグを返す
+	if alias, exists := r.aliases[key]; exists {
+		if tag, exists := r.tags[alias.TagID]; exists {
 			result := *tag
 			return &result, nil
 		}
@@ -87,6 +106,31 @@ func (r *MemoryTagRepository) FindAll(ctx context.Context) ([]*entity.Tag, error
 	return result, nil
 }
 
+// 名前が前方一致するタグを名前順に最大limit件取得（大文字小文字は区別しない）
+func (r *MemoryTagRepository) FindByNamePrefix(ctx context.Context, prefix string, limit int) ([]*entity.Tag, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prefix = strings.ToLower(prefix)
+	result := make([]*entity.Tag, 0)
+	for _, tag := range r.tags {
+		if !strings.HasPrefix(strings.ToLower(tag.Name), prefix) {
+			continue
+		}
+		copied := *tag
+		result = append(result, &copied)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	if len(result) > limit {
+		result = result[:limit]
+	}
+
+	return result, nil
+}
+
 // タグを更新
 func (r *MemoryTagRepository) Update(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
 	r.mu.Lock()
@@ -95,6 +139,9 @@ func (r *MemoryTagRepository) Update(ctx context.Context, tag *entity.Tag) (*ent
 	if _, exists := r.tags[tag.ID]; !exists {
 		return nil, domainerrors.NotFoundError("tag", "tag not found")
 	}
+	if r.nameTaken(tag.Name, tag.ID) {
+		return nil, domainerrors.AlreadyExistsError("tag", tag.Name)
+	}
 
 	updated := *tag
 	r.tags[updated.ID] = &updated
@@ -112,5 +159,186 @@ func (r *MemoryTagRepository) Delete(ctx context.Context, id int64) error {
 	}
 
 	delete(r.tags, id)
+
+	// 子タグは親なしとし、別名は削除する
+	for _, tag := range r.tags {
+		if tag.ParentID == id {
+			tag.ParentID = 0
+		}
+	}
+	for key, alias := range r.aliases {
+		if alias.TagID == id {
+			delete(r.aliases, key)
+		}
+	}
 	return nil
 }
+
+// 統合元のタグを統合先のタグに統合（記事の関連付けは記事側がタグ名で保持するため移さない）
+func (r *MemoryTagRepository) Merge(ctx context.Context, targetID int64, sourceIDs []int64) (*entity.Tag, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	target, exists := r.tags[targetID]
+	if !exists {
+		return nil, domainerrors.NotFoundError("tag", "tag not found")
+	}
+	sources := make(map[int64]bool, len(sourceIDs))
+	for _, id := range sourceIDs {
+		if _, exists := r.tags[id]; !exists {
+			return nil, domainerrors.NotFoundError("tag", "tag not found")
+		}
+		sources[id] = true
+	}
+
+	for _, tag := range r.tags {
+		if sources[tag.ParentID] {
+			if tag.ID == targetID {
+				tag.ParentID = 0
+			} else {
+				tag.ParentID = targetID
+			}
+		}
+	}
+	for _, alias := range r.aliases {
+		if sources[alias.TagID] {
+			alias.TagID = targetID
+		}
+	}
+	for id := range sources {
+		name := r.tags[id].Name
+		key := r.policy.Key(name)
+		if _, exists := r.aliases[key]; !exists && key != r.policy.Key(target.Name) {
+			r.aliases[key] = &entity.TagAlias{Alias: name, TagID: targetID, CreatedAt: time.Now()}
+		}
+		delete(r.tags, id)
+	}
+
+	result := *target
+	return &result, nil
+}
+
+// 別名を保存
+func (r *MemoryTagRepository) CreateAlias(ctx context.Context, alias *entity.TagAlias) (*entity.TagAlias, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.tags[alias.TagID]; !exists {
+		return nil, domainerrors.NotFoundError("tag", "tag not found")
+	}
+	key := r.policy.Key(alias.Alias)
+	for _, tag := range r.tags {
+		if r.policy.Key(tag.Name) == key {
+			return nil, domainerrors.AlreadyExistsError("tag", alias.Alias)
+		}
+	}
+	if _, exists := r.aliases[key]; exists {
+		return nil, domainerrors.AlreadyExistsError("tag_alias", alias.Alias)
+	}
+
+	saved := *alias
+	r.aliases[key] = &saved
+
+	result := saved
+	return &result, nil
+}
+
+// 指定されたタグの別名を名前順に取得
+func (r *MemoryTagRepository) FindAliases(ctx context.Context, tagID int64) ([]*entity.TagAlias, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	result := make([]*entity.TagAlias, 0)
+	for _, alias := range r.aliases {
+		if alias.TagID == tagID {
+			copied := *alias
+			result = append(result, &copied)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Alias < result[j].Alias
+	})
+
+	return result, nil
+}
+
+// 指定されたタグの別名を削除
+func (r *MemoryTagRepository) DeleteAlias(ctx context.Context, tagID int64, alias string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := r.policy.Key(alias)
+	existing, exists := r.aliases[key]
+	if !exists || existing.TagID != tagID {
+		return domainerrors.NotFoundError("tag_alias", alias)
+	}
+
+	delete(r.aliases, key)
+	return nil
+}
+
+// タグごとの使用状況を取得（記事の関連付けは記事側がタグ名で保持するため、常に空）
+func (r *MemoryTagRepository) FindUsages(ctx context.Context) ([]entity.TagUsage, error) {
+	return []entity.TagUsage{}, nil
+}
+
+// 指定されたタグの使用状況を取得（記事の関連付けを保持しないため、常に空）
+func (r *MemoryTagRepository) FindUsagesByTagIDs(ctx context.Context, tagIDs []int64) ([]entity.TagUsage, error) {
+	return []entity.TagUsage{}, nil
+}
+
+// 月ごとの記事数を取得（記事の関連付けを保持しないため、常に空）
+func (r *MemoryTagRepository) FindMonthlyArticleCounts(ctx context.Context, tagID int64) ([]entity.TagMonthlyCount, error) {
+	return []entity.TagMonthlyCount{}, nil
+}
+
+// 子タグのないタグを削除（記事の関連付けを保持しないため、記事の有無は考慮しない）
+func (r *MemoryTagRepository) DeleteUnused(ctx context.Context) ([]*entity.Tag, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	parents := make(map[int64]bool, len(r.tags))
+	for _, tag := range r.tags {
+		parents[tag.ParentID] = true
+	}
+
+	deleted := make([]*entity.Tag, 0)
+	for id, tag := range r.tags {
+		if parents[id] {
+			continue
+		}
+		copied := *tag
+		deleted = append(deleted, &copied)
+		delete(r.tags, id)
+	}
+	for key, alias := range r.aliases {
+		if _, exists := r.tags[alias.TagID]; !exists {
+			delete(r.aliases, key)
+		}
+	}
+
+	sort.Slice(deleted, func(i, j int) bool {
+		return deleted[i].Name < deleted[j].Name
+	})
+	return deleted, nil
+}
+
+// 正規化キーが他のタグ名または別名と重複するか（excludeIDのタグは除く）
+func (r *MemoryTagRepository) nameTaken(name string, excludeID int64) bool {
+	key := r.policy.Key(name)
+	if _, exists := r.aliases[key]; exists {
+		return true
+	}
+	for _, tag := range r.tags {
+		if tag.ID != excludeID && r.policy.Key(tag.Name) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// タグ名の一意性の判定に使う正規化方針を取得
+func (r *MemoryTagRepository) NamePolicy() entity.TagNamePolicy {
+	return r.policy
+}
@@ -0,0 +1,163 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/infrastructure/logger"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// 期間・操作ごとの集計結果とのマッピング
+type aiUsageSummaryRow struct {
+	Period           string  `db:"period"`
+	Operation        string  `db:"operation"`
+	Calls            int     `db:"calls"`
+	Errors           int     `db:"errors"`
+	PromptTokens     int64   `db:"prompt_tokens"`
+	OutputTokens     int64   `db:"output_tokens"`
+	TotalTokens      int64   `db:"total_tokens"`
+	AverageLatencyMs float64 `db:"average_latency_ms"`
+}
+
+// 集計単位ごとのDATE_FORMATの書式
+var aiUsagePeriodFormats = map[entity.AIUsagePeriod]string{
+	entity.AIUsagePeriodDay:   "%Y-%m-%d",
+	entity.AIUsagePeriodMonth: "%Y-%m",
+}
+
+// AIUsageRepositoryのMySQL実装
+type mysqlAIUsageRepository struct {
+	db *sqlx.DB
+}
+
+// コンストラクタ
+func NewMySQLAIUsageRepository(db *sqlx.DB) repository.AIUsageRepository {
+	return &mysqlAIUsageRepository{db: db}
+}
+
+// 使用量を記録
+func (r *mysqlAIUsageRepository) Create(ctx context.Context, usage *entity.AIUsage) (*entity.AIUsage, error) {
+	if usage == nil {
+		logger.Error("Attempted to create nil AI usage")
+		return nil, domainerrors.InvalidArgumentError("ai_usage", "ai usage cannot be nil")
+	}
+
+	query := `
+		INSERT INTO ai_usage
+			(operation, model, prompt_tokens, output_tokens, total_tokens, latency_ms, outcome, error_code, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		usage.Operation,
+		usage.Model,
+		usage.PromptTokens,
+		usage.OutputTokens,
+		usage.TotalTokens,
+		usage.Latency.Milliseconds(),
+		string(usage.Outcome),
+		usage.ErrorCode,
+		usage.CreatedAt,
+	)
+	if err != nil {
+		logger.Error("Failed to create AI usage",
+			zap.Error(err),
+			zap.String("operation", usage.Operation),
+		)
+		return nil, domainerrors.DatabaseError("create ai usage", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		logger.Error("Failed to get last insert ID for AI usage",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("get last insert id", err)
+	}
+
+	created := *usage
+	created.ID = id
+	return &created, nil
+}
+
+// 期間内の使用量を期間と操作ごとに集計
+// created_atはUTCで保存されるため、locationの時差を足してから日・月に区切る
+// （時差はfrom時点のものを使う。JSTは夏時間がないため期間内で一定）
+func (r *mysqlAIUsageRepository) SummarizeByPeriod(ctx context.Context, from, to time.Time, period entity.AIUsagePeriod, location *time.Location) ([]*entity.AIUsageSummary, error) {
+	format, ok := aiUsagePeriodFormats[period]
+	if !ok {
+		return nil, domainerrors.InvalidArgumentError("period", "period must be day or month")
+	}
+	_, offset := from.In(location).Zone()
+
+	query := fmt.Sprintf(`
+		SELECT
+			DATE_FORMAT(DATE_ADD(created_at, INTERVAL ? SECOND), '%s') AS period,
+			operation,
+			COUNT(*) AS calls,
+			COALESCE(SUM(outcome = ?), 0) AS errors,
+			COALESCE(SUM(prompt_tokens), 0) AS prompt_tokens,
+			COALESCE(SUM(output_tokens), 0) AS output_tokens,
+			COALESCE(SUM(total_tokens), 0) AS total_tokens,
+			COALESCE(AVG(latency_ms), 0) AS average_latency_ms
+		FROM ai_usage
+		WHERE created_at >= ? AND created_at < ?
+		GROUP BY period, operation
+		ORDER BY period ASC, operation ASC
+	`, format)
+
+	var rows []aiUsageSummaryRow
+	if err := r.db.SelectContext(ctx, &rows, query, offset, string(entity.AIUsageOutcomeError), from, to); err != nil {
+		logger.Error("Failed to summarize AI usage by period",
+			zap.Error(err),
+			zap.Time("from", from),
+			zap.Time("to", to),
+			zap.String("period", string(period)),
+		)
+		return nil, domainerrors.DatabaseError("summarize ai usage by period", err)
+	}
+
+	summaries := make([]*entity.AIUsageSummary, 0, len(rows))
+	for _, row := range rows {
+		summaries = append(summaries, &entity.AIUsageSummary{
+			Period:         row.Period,
+			Operation:      row.Operation,
+			Calls:          row.Calls,
+			Errors:         row.Errors,
+			PromptTokens:   row.PromptTokens,
+			OutputTokens:   row.OutputTokens,
+			TotalTokens:    row.TotalTokens,
+			AverageLatency: time.Duration(row.AverageLatencyMs * float64(time.Millisecond)),
+		})
+	}
+
+	return summaries, nil
+}
+
+// 期間内の合計トークン数を取得
+func (r *mysqlAIUsageRepository) SumTotalTokens(ctx context.Context, from, to time.Time) (int64, error) {
+	query := `
+		SELECT COALESCE(SUM(total_tokens), 0)
+		FROM ai_usage
+		WHERE created_at >= ? AND created_at < ?
+	`
+
+	var total int64
+	if err := r.db.GetContext(ctx, &total, query, from, to); err != nil {
+		logger.Error("Failed to sum AI usage tokens",
+			zap.Error(err),
+			zap.Time("from", from),
+			zap.Time("to", to),
+		)
+		return 0, domainerrors.DatabaseError("sum ai usage tokens", err)
+	}
+
+	return total, nil
+}
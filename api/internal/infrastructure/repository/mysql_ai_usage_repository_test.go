@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"article-manager/internal/domain/entity"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ai_usageテーブルがない場合はスキップし、テーブルを空にする
+func setupAIUsageTestDB(t *testing.T) *sqlx.DB {
+	t.Helper()
+	db := setupTestDB(t)
+
+	var tableExists int
+	err := db.Get(&tableExists, "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = 'ai_usage'")
+	if err != nil || tableExists == 0 {
+		db.Close()
+		t.Skip("ai_usageテーブルが存在しません")
+	}
+
+	_, err = db.Exec("DELETE FROM ai_usage")
+	require.NoError(t, err, "ai_usageテーブルのクリーンアップに失敗")
+	return db
+}
+
+func TestMySQLAIUsageRepository_SummarizeByPeriod(t *testing.T) {
+	db := setupAIUsageTestDB(t)
+	defer db.Close()
+
+	jst, err := time.LoadLocation("Asia/Tokyo")
+	require.NoError(t, err)
+	at := func(s string) time.Time {
+		parsed, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err)
+		return parsed
+	}
+
+	repo := NewMySQLAIUsageRepository(db)
+	ctx := context.Background()
+	for _, usage := range []*entity.AIUsage{
+		// JSTでは2025-02-01
+		{Operation: entity.AIOperationArticleGeneration, Model: "gemini-test", PromptTokens: 100, OutputTokens: 50, TotalTokens: 150, Latency: time.Second, Outcome: entity.AIUsageOutcomeSuccess, CreatedAt: at("2025-01-31T16:00:00Z")},
+		{Operation: entity.AIOperationArticleGeneration, Model: "gemini-test", PromptTokens: 80, OutputTokens: 20, TotalTokens: 100, Latency: 3 * time.Second, Outcome: entity.AIUsageOutcomeSuccess, CreatedAt: at("2025-03-14T01:00:00Z")},
+		{Operation: entity.AIOperationArticleGeneration, Model: "gemini-test", Latency: time.Second, Outcome: entity.AIUsageOutcomeError, ErrorCode: "TIMEOUT", CreatedAt: at("2025-03-14T02:00:00Z")},
+		{Operation: entity.AIOperationBookRecommendation, Model: "gemini-test", PromptTokens: 300, OutputTokens: 200, TotalTokens: 500, Latency: 2 * time.Second, Outcome: entity.AIUsageOutcomeSuccess, CreatedAt: at("2025-03-15T00:00:00Z")},
+	} {
+		_, err := repo.Create(ctx, usage)
+		require.NoError(t, err)
+	}
+
+	t.Run("正常系：JSTの日ごと・操作ごとに集計する", func(t *testing.T) {
+		from := time.Date(2025, 3, 9, 0, 0, 0, 0, jst)
+		to := time.Date(2025, 3, 16, 0, 0, 0, 0, jst)
+
+		summaries, err := repo.SummarizeByPeriod(ctx, from, to, entity.AIUsagePeriodDay, jst)
+
+		require.NoError(t, err)
+		require.Len(t, summaries, 2)
+		assert.Equal(t, &entity.AIUsageSummary{
+			Period:         "2025-03-14",
+			Operation:      entity.AIOperationArticleGeneration,
+			Calls:          2,
+			Errors:         1,
+			PromptTokens:   80,
+			OutputTokens:   20,
+			TotalTokens:    100,
+			AverageLatency: 2 * time.Second,
+		}, summaries[0])
+		assert.Equal(t, "2025-03-15", summaries[1].Period)
+		assert.Equal(t, entity.AIOperationBookRecommendation, summaries[1].Operation)
+	})
+
+	t.Run("正常系：JSTの月ごとに集計する", func(t *testing.T) {
+		from := time.Date(2025, 1, 1, 0, 0, 0, 0, jst)
+		to := time.Date(2025, 3, 16, 0, 0, 0, 0, jst)
+
+		summaries, err := repo.SummarizeByPeriod(ctx, from, to, entity.AIUsagePeriodMonth, jst)
+
+		require.NoError(t, err)
+		require.Len(t, summaries, 3)
+		assert.Equal(t, "2025-02", summaries[0].Period)
+		assert.Equal(t, int64(150), summaries[0].TotalTokens)
+		assert.Equal(t, "2025-03", summaries[1].Period)
+		assert.Equal(t, 2, summaries[1].Calls)
+	})
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 	"strings"
+	"time"
 
 	"article-manager/internal/domain/entity"
 	domainerrors "article-manager/internal/domain/errors"
@@ -17,34 +19,53 @@ import (
 
 // articlesテーブルとのマッピング
 type articleRow struct {
-	ID        int64          `db:"id"`
-	Title     string         `db:"title"`
-	URL       string         `db:"url"`
-	Summary   string         `db:"summary"`
-	Memo      sql.NullString `db:"memo"`
-	CreatedAt sql.NullTime   `db:"created_at"`
-	UpdatedAt sql.NullTime   `db:"updated_at"`
+	ID            int64          `db:"id"`
+	Title         string         `db:"title"`
+	URL           string         `db:"url"`
+	Summary       string         `db:"summary"`
+	Memo          sql.NullString `db:"memo"`
+	PromptVersion sql.NullString `db:"prompt_version"`
+	CreatedAt     sql.NullTime   `db:"created_at"`
+	UpdatedAt     sql.NullTime   `db:"updated_at"`
+	articleMetadataRow
+}
+
+// 記事メタデータカラムとのマッピング
+type articleMetadataRow struct {
+	SiteName           sql.NullString `db:"site_name"`
+	Author             sql.NullString `db:"author"`
+	PublishedAt        sql.NullTime   `db:"published_at"`
+	ImageURL           sql.NullString `db:"image_url"`
+	FaviconURL         sql.NullString `db:"favicon_url"`
+	Language           sql.NullString `db:"language"`
+	ReadingTimeMinutes sql.NullInt64  `db:"reading_time_minutes"`
+	MetadataFetchedAt  sql.NullTime   `db:"metadata_fetched_at"`
+	FetchFailures      int            `db:"metadata_fetch_failures"`
+	FailedAt           sql.NullTime   `db:"metadata_failed_at"`
 }
 
 type articleWithTagRow struct {
-	ID        int64          `db:"id"`
-	Title     string         `db:"title"`
-	URL       string         `db:"url"`
-	Summary   string         `db:"summary"`
-	Memo      sql.NullString `db:"memo"`
-	CreatedAt sql.NullTime   `db:"created_at"`
-	UpdatedAt sql.NullTime   `db:"updated_at"`
-	TagName   sql.NullString `db:"tag_name"`
+	ID            int64          `db:"id"`
+	Title         string         `db:"title"`
+	URL           string         `db:"url"`
+	Summary       string         `db:"summary"`
+	Memo          sql.NullString `db:"memo"`
+	PromptVersion sql.NullString `db:"prompt_version"`
+	CreatedAt     sql.NullTime   `db:"created_at"`
+	UpdatedAt     sql.NullTime   `db:"updated_at"`
+	TagName       sql.NullString `db:"tag_name"`
+	articleMetadataRow
 }
 
 // ArticeleRepositoryのMySQL実装
 type mysqlArticleRepository struct {
-	db *sqlx.DB
+	db        *sqlx.DB
+	tagPolicy entity.TagNamePolicy
 }
 
-// コンストラクタ
-func NewMySQLArticleRepository(db *sqlx.DB) repository.ArticleRepository {
-	return &mysqlArticleRepository{db: db}
+// コンストラクタ（記事のタグはtagPolicyによる正規化キーで既存のタグに対応付ける）
+func NewMySQLArticleRepository(db *sqlx.DB, tagPolicy entity.TagNamePolicy) repository.ArticleRepository {
+	return &mysqlArticleRepository{db: db, tagPolicy: tagPolicy}
 }
 
 // 新しい記事を保存
@@ -79,9 +100,22 @@ func (r *mysqlArticleRepository) Create(ctx context.Context, article *entity.Art
 		memo = sql.NullString{String: article.Memo, Valid: true}
 	}
 
-	query := `INSERT INTO articles (title, url, summary, memo, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?)`
+	metadata := metadataToRow(article.Metadata)
+
+	query := `
+		INSERT INTO articles (
+			title, url, summary, memo,
+			site_name, author, published_at, image_url, favicon_url, language, reading_time_minutes, metadata_fetched_at, prompt_version,
+			created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
 
-	result, err := tx.ExecContext(ctx, query, article.Title, article.URL, article.Summary, memo, article.CreatedAt, article.UpdatedAt)
+	result, err := tx.ExecContext(ctx, query,
+		article.Title, article.URL, article.Summary, memo,
+		metadata.SiteName, metadata.Author, metadata.PublishedAt, metadata.ImageURL, metadata.FaviconURL,
+		metadata.Language, metadata.ReadingTimeMinutes, metadata.MetadataFetchedAt, nullString(article.PromptVersion),
+		article.CreatedAt, article.UpdatedAt,
+	)
 	if err != nil {
 		_ = tx.Rollback()
 		logger.Error("Failed to insert article",
@@ -137,7 +171,14 @@ func (r *mysqlArticleRepository) FindByID(ctx context.Context, id int64) (*entit
 		zap.Int64("id", id),
 	)
 
-	query := `SELECT id, title, url, summary, memo, created_at, updated_at FROM articles WHERE id = ?`
+	query := `
+		SELECT
+			id, title, url, summary, memo,
+			site_name, author, published_at, image_url, favicon_url, language, reading_time_minutes, metadata_fetched_at, prompt_version,
+			metadata_fetch_failures, metadata_failed_at, created_at, updated_at
+		FROM articles
+		WHERE id = ?
+	`
 
 	var row articleRow
 	err := r.db.GetContext(ctx, &row, query, id)
@@ -179,6 +220,17 @@ func (r *mysqlArticleRepository) FindAll(ctx context.Context) ([]*entity.Article
 				a.url,
 				a.summary,
 				a.memo,
+				a.site_name,
+				a.author,
+				a.published_at,
+				a.image_url,
+				a.favicon_url,
+				a.language,
+				a.reading_time_minutes,
+				a.metadata_fetched_at,
+				a.metadata_fetch_failures,
+				a.metadata_failed_at,
+				a.prompt_version,
 				a.created_at,
 				a.updated_at,
 				t.name AS tag_name
@@ -197,40 +249,7 @@ func (r *mysqlArticleRepository) FindAll(ctx context.Context) ([]*entity.Article
 		return nil, domainerrors.DatabaseError("find all articles", err)
 	}
 
-	articleMap := make(map[int64]*entity.Article)
-	var articleOrder []int64
-
-	for _, row := range rows {
-		article, exists := articleMap[row.ID]
-		if !exists {
-			memo := ""
-			if row.Memo.Valid {
-				memo = row.Memo.String
-			}
-
-			article = &entity.Article{
-				ID:        row.ID,
-				Title:     row.Title,
-				URL:       row.URL,
-				Summary:   row.Summary,
-				Tags:      []string{},
-				Memo:      memo,
-				CreatedAt: row.CreatedAt.Time,
-				UpdatedAt: row.UpdatedAt.Time,
-			}
-			articleMap[row.ID] = article
-			articleOrder = append(articleOrder, row.ID)
-		}
-
-		if row.TagName.Valid && row.TagName.String != "" {
-			article.Tags = append(article.Tags, row.TagName.String)
-		}
-	}
-
-	articles := make([]*entity.Article, 0, len(articleOrder))
-	for _, id := range articleOrder {
-		articles = append(articles, articleMap[id])
-	}
+	articles := articlesFromTagRows(rows)
 
 	logger.Debug("Successfully found all articles",
 		zap.Int("count", len(articles)),
@@ -277,9 +296,23 @@ func (r *mysqlArticleRepository) Update(ctx context.Context, article *entity.Art
 		memo = sql.NullString{String: article.Memo, Valid: true}
 	}
 
-	query := `UPDATE articles SET title = ?, url = ?, summary = ?, memo = ?, updated_at = ? WHERE id = ?`
+	metadata := metadataToRow(article.Metadata)
+
+	query := `
+		UPDATE articles SET
+			title = ?, url = ?, summary = ?, memo = ?,
+			site_name = ?, author = ?, published_at = ?, image_url = ?, favicon_url = ?,
+			language = ?, reading_time_minutes = ?, metadata_fetched_at = ?, prompt_version = ?,
+			metadata_fetch_failures = ?, metadata_failed_at = ?, updated_at = ?
+		WHERE id = ?
+	`
 
-	result, err := tx.ExecContext(ctx, query, article.Title, article.URL, article.Summary, memo, article.UpdatedAt, article.ID)
+	result, err := tx.ExecContext(ctx, query,
+		article.Title, article.URL, article.Summary, memo,
+		metadata.SiteName, metadata.Author, metadata.PublishedAt, metadata.ImageURL, metadata.FaviconURL,
+		metadata.Language, metadata.ReadingTimeMinutes, metadata.MetadataFetchedAt, nullString(article.PromptVersion),
+		metadata.FetchFailures, metadata.FailedAt, article.UpdatedAt, article.ID,
+	)
 	if err != nil {
 		_ = tx.Rollback()
 		logger.Error("Failed to update article",
@@ -339,6 +372,98 @@ func (r *mysqlArticleRepository) Update(ctx context.Context, article *entity.Art
 	return r.FindByID(ctx, article.ID)
 }
 
+// 記事のURLのみを更新し、旧URLのメタデータを破棄
+// 他のカラムは書き換えず、URLがpreviousURLから変わっていた場合は更新しない
+func (r *mysqlArticleRepository) UpdateURL(ctx context.Context, article *entity.Article, previousURL string) error {
+	query := `
+		UPDATE articles SET
+			url = ?,
+			site_name = NULL, author = NULL, published_at = NULL, image_url = NULL, favicon_url = NULL,
+			language = NULL, reading_time_minutes = NULL, metadata_fetched_at = NULL,
+			metadata_fetch_failures = 0, metadata_failed_at = NULL,
+			updated_at = ?
+		WHERE id = ? AND url = ?
+	`
+
+	result, err := r.db.ExecContext(ctx, query, article.URL, article.UpdatedAt, article.ID, previousURL)
+	if err != nil {
+		logger.Error("Failed to update article url",
+			zap.Error(err),
+			zap.Int64("id", article.ID),
+		)
+		return domainerrors.DatabaseError("update article url", err)
+	}
+
+	return checkArticleRowsAffected(result, article.ID)
+}
+
+// 記事のメタデータのみを更新
+// 他のカラムは書き換えず、取得中にURLが変わっていた場合は更新しない
+func (r *mysqlArticleRepository) UpdateMetadata(ctx context.Context, id int64, url string, metadata entity.ArticleMetadata) error {
+	row := metadataToRow(metadata)
+
+	query := `
+		UPDATE articles SET
+			site_name = ?, author = ?, published_at = ?, image_url = ?, favicon_url = ?,
+			language = ?, reading_time_minutes = ?, metadata_fetched_at = ?,
+			metadata_fetch_failures = 0, metadata_failed_at = NULL
+		WHERE id = ? AND url = ?
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		row.SiteName, row.Author, row.PublishedAt, row.ImageURL, row.FaviconURL,
+		row.Language, row.ReadingTimeMinutes, row.MetadataFetchedAt,
+		id, url,
+	)
+	if err != nil {
+		logger.Error("Failed to update article metadata",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return domainerrors.DatabaseError("update article metadata", err)
+	}
+
+	return checkArticleRowsAffected(result, id)
+}
+
+// メタデータの取得失敗を記録（取得中にURLが変わっていた場合は記録しない）
+func (r *mysqlArticleRepository) RecordMetadataFetchFailure(ctx context.Context, id int64, url string, failedAt time.Time) error {
+	query := `
+		UPDATE articles SET
+			metadata_fetch_failures = metadata_fetch_failures + 1, metadata_failed_at = ?
+		WHERE id = ? AND url = ?
+	`
+
+	result, err := r.db.ExecContext(ctx, query, failedAt, id, url)
+	if err != nil {
+		logger.Error("Failed to record metadata fetch failure",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return domainerrors.DatabaseError("record metadata fetch failure", err)
+	}
+
+	return checkArticleRowsAffected(result, id)
+}
+
+// 更新対象の記事が存在したかを確認
+func checkArticleRowsAffected(result sql.Result, id int64) error {
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("Failed to get rows affected",
+			zap.Error(err),
+		)
+		return domainerrors.DatabaseError("get rows affected", err)
+	}
+	if rowsAffected == 0 {
+		logger.Debug("Article not found or url changed",
+			zap.Int64("id", id),
+		)
+		return domainerrors.NotFoundError("article", id)
+	}
+	return nil
+}
+
 // 指定されたIDの記事を削除
 func (r *mysqlArticleRepository) Delete(ctx context.Context, id int64) error {
 	if id <= 0 {
@@ -425,16 +550,22 @@ func (r *mysqlArticleRepository) insertArticleTags(ctx context.Context, tx *sqlx
 		return nil
 	}
 
+	// タグ名を正規化キーに変換
+	tagKeys := make([]string, 0, len(tagNames))
+	for _, tagName := range tagNames {
+		tagKeys = append(tagKeys, r.tagPolicy.Key(tagName))
+	}
+
 	// 既存タグを一括取得
-	query, args, err := sqlx.In(`SELECT id, name FROM tags WHERE name IN (?)`, tagNames)
+	query, args, err := sqlx.In(`SELECT id, normalized_key FROM tags WHERE normalized_key IN (?)`, tagKeys)
 	if err != nil {
 		return domainerrors.DatabaseError("prepare tag query", err)
 	}
 	query = tx.Rebind(query)
 
 	type tagResult struct {
-		ID   int64  `db:"id"`
-		Name string `db:"name"`
+		ID  int64  `db:"id"`
+		Key string `db:"normalized_key"`
 	}
 	var existingTags []tagResult
 	err = tx.SelectContext(ctx, &existingTags, query, args...)
@@ -445,18 +576,44 @@ func (r *mysqlArticleRepository) insertArticleTags(ctx context.Context, tx *sqlx
 		return domainerrors.DatabaseError("fetch existing tags", err)
 	}
 
-	// タグ名からIDへのマッピングを作成
+	// 正規化キーからIDへのマッピングを作成
 	tagIDMap := make(map[string]int64, len(existingTags))
 	for _, tag := range existingTags {
-		tagIDMap[tag.Name] = tag.ID
+		tagIDMap[tag.Key] = tag.ID
+	}
+
+	// 別名として登録されているタグ名は代表のタグに対応付ける
+	aliasQuery, aliasArgs, err := sqlx.In(`SELECT normalized_key, tag_id FROM tag_aliases WHERE normalized_key IN (?)`, tagKeys)
+	if err != nil {
+		return domainerrors.DatabaseError("prepare tag alias query", err)
 	}
 
-	// 存在しないタグを抽出して一括作成
+	type tagAliasResult struct {
+		Key   string `db:"normalized_key"`
+		TagID int64  `db:"tag_id"`
+	}
+	var aliases []tagAliasResult
+	if err := tx.SelectContext(ctx, &aliases, tx.Rebind(aliasQuery), aliasArgs...); err != nil {
+		logger.Error("Failed to fetch tag aliases",
+			zap.Error(err),
+		)
+		return domainerrors.DatabaseError("fetch tag aliases", err)
+	}
+	for _, alias := range aliases {
+		if _, exists := tagIDMap[alias.Key]; !exists {
+			tagIDMap[alias.Key] = alias.TagID
+		}
+	}
+
+	// 存在しないタグを抽出して一括作成（同じ正規化キーのタグ名は最初の表記で作成する）
 	var missingTags []string
-	for _, tagName := range tagNames {
-		if _, exists := tagIDMap[tagName]; !exists {
-			missingTags = append(missingTags, tagName)
+	var missingKeys []string
+	for i, tagName := range tagNames {
+		if _, exists := tagIDMap[tagKeys[i]]; exists || slices.Contains(missingKeys, tagKeys[i]) {
+			continue
 		}
+		missingTags = append(missingTags, tagName)
+		missingKeys = append(missingKeys, tagKeys[i])
 	}
 
 	if len(missingTags) > 0 {
@@ -464,13 +621,13 @@ func (r *mysqlArticleRepository) insertArticleTags(ctx context.Context, tx *sqlx
 		valueArgs := make([]interface{}, 0, len(missingTags))
 		now := "NOW()"
 
-		for _, tagName := range missingTags {
-			valueStrings = append(valueStrings, "(?, "+now+", "+now+")")
-			valueArgs = append(valueArgs, tagName)
+		for i, tagName := range missingTags {
+			valueStrings = append(valueStrings, "(?, ?, "+now+", "+now+")")
+			valueArgs = append(valueArgs, tagName, missingKeys[i])
 		}
 
 		buldInsertQuery := fmt.Sprintf(
-			"INSERT INTO tags (name, created_at, updated_at) VALUES %s",
+			"INSERT INTO tags (name, normalized_key, created_at, updated_at) VALUES %s",
 			strings.Join(valueStrings, ","),
 		)
 
@@ -489,8 +646,8 @@ func (r *mysqlArticleRepository) insertArticleTags(ctx context.Context, tx *sqlx
 		}
 
 		// 新規タグのIDをマッピングに追加
-		for i, tagName := range missingTags {
-			tagIDMap[tagName] = lastID + int64(i)
+		for i, key := range missingKeys {
+			tagIDMap[key] = lastID + int64(i)
 		}
 
 		logger.Debug("Inserted new tags",
@@ -503,8 +660,14 @@ func (r *mysqlArticleRepository) insertArticleTags(ctx context.Context, tx *sqlx
 		valueStrings := make([]string, 0, len(tagNames))
 		valueArgs := make([]interface{}, 0, len(tagNames)*2)
 
-		for _, tagName := range tagNames {
-			tagID := tagIDMap[tagName]
+		// 別名と代表のタグ名を同時に指定した場合は1つの関連付けにまとめる
+		insertedTagIDs := make(map[int64]bool, len(tagNames))
+		for _, key := range tagKeys {
+			tagID := tagIDMap[key]
+			if insertedTagIDs[tagID] {
+				continue
+			}
+			insertedTagIDs[tagID] = true
 			valueStrings = append(valueStrings, "(?, ?)")
 			valueArgs = append(valueArgs, articleID, tagID)
 		}
@@ -626,6 +789,17 @@ func (r *mysqlArticleRepository) Search(ctx context.Context, keyword string) ([]
 			a.url,
 			a.summary,
 			a.memo,
+			a.site_name,
+			a.author,
+			a.published_at,
+			a.image_url,
+			a.favicon_url,
+			a.language,
+			a.reading_time_minutes,
+			a.metadata_fetched_at,
+			a.metadata_fetch_failures,
+			a.metadata_failed_at,
+			a.prompt_version,
 			a.created_at,
 			a.updated_at,
 			t.name AS tag_name
@@ -646,6 +820,66 @@ func (r *mysqlArticleRepository) Search(ctx context.Context, keyword string) ([]
 		return nil, domainerrors.DatabaseError("search articles", err)
 	}
 
+	articles := articlesFromTagRows(rows)
+
+	logger.Info("Successfully searched articles",
+		zap.String("keyword", trimmedKeyword),
+		zap.Int("count", len(articles)),
+	)
+
+	return articles, nil
+}
+
+// 作成日時が期間内の記事を古い順に取得
+func (r *mysqlArticleRepository) FindByCreatedAtRange(ctx context.Context, from, to time.Time) ([]*entity.Article, error) {
+	logger.Debug("Finding articles by created_at range",
+		zap.Time("from", from),
+		zap.Time("to", to),
+	)
+
+	query := `
+		SELECT
+			a.id,
+			a.title,
+			a.url,
+			a.summary,
+			a.memo,
+			a.site_name,
+			a.author,
+			a.published_at,
+			a.image_url,
+			a.favicon_url,
+			a.language,
+			a.reading_time_minutes,
+			a.metadata_fetched_at,
+			a.metadata_fetch_failures,
+			a.metadata_failed_at,
+			a.prompt_version,
+			a.created_at,
+			a.updated_at,
+			t.name AS tag_name
+		FROM articles a
+		LEFT JOIN article_tags at ON a.id = at.article_id
+		LEFT JOIN tags t ON at.tag_id = t.id
+		WHERE a.created_at >= ? AND a.created_at < ?
+		ORDER BY a.created_at ASC, a.id ASC, t.name ASC
+	`
+
+	var rows []articleWithTagRow
+	if err := r.db.SelectContext(ctx, &rows, query, from, to); err != nil {
+		logger.Error("Failed to find articles by created_at range",
+			zap.Error(err),
+			zap.Time("from", from),
+			zap.Time("to", to),
+		)
+		return nil, domainerrors.DatabaseError("find articles by created_at range", err)
+	}
+
+	return articlesFromTagRows(rows), nil
+}
+
+// 記事とタグを結合した行を記事ごとにまとめる（行の順序を維持する）
+func articlesFromTagRows(rows []articleWithTagRow) []*entity.Article {
 	articleMap := make(map[int64]*entity.Article)
 	var articleOrder []int64
 
@@ -658,14 +892,16 @@ func (r *mysqlArticleRepository) Search(ctx context.Context, keyword string) ([]
 			}
 
 			article = &entity.Article{
-				ID:        row.ID,
-				Title:     row.Title,
-				URL:       row.URL,
-				Summary:   row.Summary,
-				Tags:      []string{},
-				Memo:      memo,
-				CreatedAt: row.CreatedAt.Time,
-				UpdatedAt: row.UpdatedAt.Time,
+				ID:            row.ID,
+				Title:         row.Title,
+				URL:           row.URL,
+				Summary:       row.Summary,
+				Tags:          []string{},
+				Memo:          memo,
+				Metadata:      rowToMetadata(&row.articleMetadataRow),
+				PromptVersion: row.PromptVersion.String,
+				CreatedAt:     row.CreatedAt.Time,
+				UpdatedAt:     row.UpdatedAt.Time,
 			}
 			articleMap[row.ID] = article
 			articleOrder = append(articleOrder, row.ID)
@@ -680,13 +916,7 @@ func (r *mysqlArticleRepository) Search(ctx context.Context, keyword string) ([]
 	for _, id := range articleOrder {
 		articles = append(articles, articleMap[id])
 	}
-
-	logger.Info("Successfully searched articles",
-		zap.String("keyword", trimmedKeyword),
-		zap.Int("count", len(articles)),
-	)
-
-	return articles, nil
+	return articles
 }
 
 // artibleRowをentity.Articleに変換
@@ -697,15 +927,160 @@ func rowToEntity(row *articleRow, tags []string) (*entity.Article, error) {
 	}
 
 	article := &entity.Article{
-		ID:        row.ID,
-		Title:     row.Title,
-		URL:       row.URL,
-		Summary:   row.Summary,
-		Tags:      tags,
-		Memo:      memo,
-		CreatedAt: row.CreatedAt.Time,
-		UpdatedAt: row.UpdatedAt.Time,
+		ID:            row.ID,
+		Title:         row.Title,
+		URL:           row.URL,
+		Summary:       row.Summary,
+		Tags:          tags,
+		Memo:          memo,
+		Metadata:      rowToMetadata(&row.articleMetadataRow),
+		PromptVersion: row.PromptVersion.String,
+		CreatedAt:     row.CreatedAt.Time,
+		UpdatedAt:     row.UpdatedAt.Time,
 	}
 
 	return article, nil
 }
+
+// articleMetadataRowをentity.ArticleMetadataに変換
+func rowToMetadata(row *articleMetadataRow) entity.ArticleMetadata {
+	metadata := entity.ArticleMetadata{
+		SiteName:           row.SiteName.String,
+		Author:             row.Author.String,
+		ImageURL:           row.ImageURL.String,
+		FaviconURL:         row.FaviconURL.String,
+		Language:           row.Language.String,
+		ReadingTimeMinutes: int(row.ReadingTimeMinutes.Int64),
+	}
+	if row.PublishedAt.Valid {
+		publishedAt := row.PublishedAt.Time
+		metadata.PublishedAt = &publishedAt
+	}
+	if row.MetadataFetchedAt.Valid {
+		fetchedAt := row.MetadataFetchedAt.Time
+		metadata.FetchedAt = &fetchedAt
+	}
+	metadata.FetchFailures = row.FetchFailures
+	if row.FailedAt.Valid {
+		failedAt := row.FailedAt.Time
+		metadata.LastFailedAt = &failedAt
+	}
+	return metadata
+}
+
+// entity.ArticleMetadataをarticleMetadataRowに変換
+func metadataToRow(metadata entity.ArticleMetadata) articleMetadataRow {
+	row := articleMetadataRow{
+		SiteName:   nullString(metadata.SiteName),
+		Author:     nullString(metadata.Author),
+		ImageURL:   nullString(metadata.ImageURL),
+		FaviconURL: nullString(metadata.FaviconURL),
+		Language:   nullString(metadata.Language),
+	}
+	if metadata.ReadingTimeMinutes > 0 {
+		row.ReadingTimeMinutes = sql.NullInt64{Int64: int64(metadata.ReadingTimeMinutes), Valid: true}
+	}
+	if metadata.PublishedAt != nil {
+		row.PublishedAt = sql.NullTime{Time: *metadata.PublishedAt, Valid: true}
+	}
+	if metadata.FetchedAt != nil {
+		row.MetadataFetchedAt = sql.NullTime{Time: *metadata.FetchedAt, Valid: true}
+	}
+	row.FetchFailures = metadata.FetchFailures
+	if metadata.LastFailedAt != nil {
+		row.FailedAt = sql.NullTime{Time: *metadata.LastFailedAt, Valid: true}
+	}
+	return row
+}
+
+// 空文字をNULLとして扱う
+func nullString(s string) sql.NullString {
+	if s == "" {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: s, Valid: true}
+}
+
+// タグの共起グラフを集計（上位のタグを選んでから、そのタグ同士の辺のみを集計する）
+func (r *mysqlArticleRepository) FindTagGraph(ctx context.Context, opts entity.TagGraphOptions) (*entity.TagGraph, error) {
+	logger.Debug("Finding tag graph",
+		zap.Int("min_weight", opts.MinWeight),
+		zap.Int("limit", opts.Limit),
+	)
+
+	nodeQuery := `
+		SELECT t.id, t.name, COUNT(*) AS article_count
+		FROM article_tags at
+		INNER JOIN tags t ON t.id = at.tag_id
+		GROUP BY t.id, t.name
+		ORDER BY article_count DESC, t.name ASC
+	`
+	nodeArgs := []interface{}{}
+	if opts.Limit > 0 {
+		nodeQuery += ` LIMIT ?`
+		nodeArgs = append(nodeArgs, opts.Limit)
+	}
+
+	type tagGraphNodeRow struct {
+		ID           int64  `db:"id"`
+		Name         string `db:"name"`
+		ArticleCount int    `db:"article_count"`
+	}
+	var nodeRows []tagGraphNodeRow
+	if err := r.db.SelectContext(ctx, &nodeRows, nodeQuery, nodeArgs...); err != nil {
+		logger.Error("Failed to find tag graph nodes",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("find tag graph nodes", err)
+	}
+	if len(nodeRows) == 0 {
+		return entity.NewTagGraph(nil, nil), nil
+	}
+
+	nodes := make([]entity.TagGraphNode, 0, len(nodeRows))
+	names := make(map[int64]string, len(nodeRows))
+	tagIDs := make([]int64, 0, len(nodeRows))
+	for _, row := range nodeRows {
+		nodes = append(nodes, entity.TagGraphNode{TagID: row.ID, Name: row.Name, ArticleCount: row.ArticleCount})
+		names[row.ID] = row.Name
+		tagIDs = append(tagIDs, row.ID)
+	}
+
+	// 同じ記事に付いたタグの組を主キー（article_id, tag_id）で結合して数える
+	edgeQuery, edgeArgs, err := sqlx.In(`
+		SELECT a.tag_id AS source_id, b.tag_id AS target_id, COUNT(*) AS weight
+		FROM article_tags a
+		INNER JOIN article_tags b ON b.article_id = a.article_id AND a.tag_id < b.tag_id
+		WHERE a.tag_id IN (?) AND b.tag_id IN (?)
+		GROUP BY a.tag_id, b.tag_id
+		HAVING COUNT(*) >= ?
+	`, tagIDs, tagIDs, opts.MinWeight)
+	if err != nil {
+		return nil, domainerrors.DatabaseError("prepare tag graph edge query", err)
+	}
+
+	type tagGraphEdgeRow struct {
+		SourceID int64 `db:"source_id"`
+		TargetID int64 `db:"target_id"`
+		Weight   int   `db:"weight"`
+	}
+	var edgeRows []tagGraphEdgeRow
+	if err := r.db.SelectContext(ctx, &edgeRows, r.db.Rebind(edgeQuery), edgeArgs...); err != nil {
+		logger.Error("Failed to find tag graph edges",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("find tag graph edges", err)
+	}
+
+	edges := make([]entity.TagGraphEdge, 0, len(edgeRows))
+	for _, row := range edgeRows {
+		edges = append(edges, entity.TagGraphEdge{Source: names[row.SourceID], Target: names[row.TargetID], Weight: row.Weight})
+	}
+
+	logger.Debug("Successfully found tag graph",
+		zap.Int("node_count", len(nodes)),
+		zap.Int("edge_count", len(edges)),
+	)
+
+	return entity.NewTagGraph(nodes, edges), nil
+}
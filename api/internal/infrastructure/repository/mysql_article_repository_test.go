@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
 	"article-manager/internal/infrastructure/database"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -76,12 +77,13 @@ func ensureTagsExist(t *testing.T, db *sqlx.DB, tagNames []string) map[string]in
 
 	for _, tagName := range tagNames {
 		var tagID int64
-		query := `SELECT id FROM tags WHERE name = ?`
-		err := db.Get(&tagID, query, tagName)
+		key := entity.DefaultTagNamePolicy().Key(tagName)
+		query := `SELECT id FROM tags WHERE normalized_key = ?`
+		err := db.Get(&tagID, query, key)
 
 		if err == sql.ErrNoRows {
-			insertQuery := `INSERT INTO tags (name) VALUES (?)`
-			result, err := db.Exec(insertQuery, tagName)
+			insertQuery := `INSERT INTO tags (name, normalized_key) VALUES (?, ?)`
+			result, err := db.Exec(insertQuery, tagName, key)
 			require.NoError(t, err, "タグの挿入に失敗: %s", tagName)
 
 			tagID, err = result.LastInsertId()
@@ -143,7 +145,7 @@ func TestMySQLArticleRepository_Create(t *testing.T) {
 
 		ensureTagsExist(t, db, []string{"Go", "プログラミング"})
 
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go", "プログラミング"}, "後で読む")
 
 		ctx := context.Background()
@@ -163,7 +165,7 @@ func TestMySQLArticleRepository_Create(t *testing.T) {
 
 	t.Run("正常系：タグが空配列の記事を作成できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{}, "")
 
@@ -181,7 +183,7 @@ func TestMySQLArticleRepository_Create(t *testing.T) {
 
 		ensureTagsExist(t, db, []string{"Go"})
 
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 
 		ctx := context.Background()
@@ -197,7 +199,7 @@ func TestMySQLArticleRepository_Create(t *testing.T) {
 
 		ensureTagsExist(t, db, []string{"tag1", "tag2"})
 
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 		article1 := createTestArticle(t, "記事1", "https://example.com/1", "要約1", []string{"tag1"}, "")
 		article2 := createTestArticle(t, "記事2", "https://example.com/2", "要約2", []string{"tag2"}, "")
 
@@ -213,7 +215,7 @@ func TestMySQLArticleRepository_Create(t *testing.T) {
 
 	t.Run("異常系：nilの記事を作成しようとするとエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		created, err := repo.Create(ctx, nil)
@@ -227,7 +229,7 @@ func TestMySQLArticleRepository_Create(t *testing.T) {
 
 		ensureTagsExist(t, db, []string{"Go"})
 
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 
 		ctx, cancel := context.WithCancel(context.Background())
@@ -246,7 +248,7 @@ func TestMySQLArticleRepository_FindByID(t *testing.T) {
 
 	t.Run("正常系：IDで記事を取得できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go", "プログラミング"}, "後で読む")
 		id := insertArticleDirectly(t, db, article)
@@ -266,7 +268,7 @@ func TestMySQLArticleRepository_FindByID(t *testing.T) {
 
 	t.Run("正常系：タグが空配列の記事を取得できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{}, "")
 		id := insertArticleDirectly(t, db, article)
@@ -281,7 +283,7 @@ func TestMySQLArticleRepository_FindByID(t *testing.T) {
 
 	t.Run("正常系：メモが空の記事を取得できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		id := insertArticleDirectly(t, db, article)
@@ -296,7 +298,7 @@ func TestMySQLArticleRepository_FindByID(t *testing.T) {
 
 	t.Run("異常系：存在しないIDで記事を取得するとエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		found, err := repo.FindByID(ctx, 99999)
@@ -308,7 +310,7 @@ func TestMySQLArticleRepository_FindByID(t *testing.T) {
 
 	t.Run("異常系：負のIDで記事を取得するとエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		found, err := repo.FindByID(ctx, -1)
@@ -319,7 +321,7 @@ func TestMySQLArticleRepository_FindByID(t *testing.T) {
 
 	t.Run("異常系：キャンセルされたコンテキストではエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		id := insertArticleDirectly(t, db, article)
@@ -340,7 +342,7 @@ func TestMySQLArticleRepository_FindAll(t *testing.T) {
 
 	t.Run("正常系：複数の記事を取得できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "記事1", "https://example.com/1", "要約1", []string{"tag1"}, "メモ1")
 		insertArticleDirectly(t, db, article1)
@@ -369,7 +371,7 @@ func TestMySQLArticleRepository_FindAll(t *testing.T) {
 
 	t.Run("正常系：記事が0件の場合は空配列を返す", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		articles, err := repo.FindAll(ctx)
@@ -381,7 +383,7 @@ func TestMySQLArticleRepository_FindAll(t *testing.T) {
 
 	t.Run("正常系：1件の記事を取得できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		insertArticleDirectly(t, db, article)
@@ -397,7 +399,7 @@ func TestMySQLArticleRepository_FindAll(t *testing.T) {
 
 	t.Run("正常系：タグが空配列の記事も取得できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{}, "")
 		insertArticleDirectly(t, db, article)
@@ -413,7 +415,7 @@ func TestMySQLArticleRepository_FindAll(t *testing.T) {
 
 	t.Run("正常系：複数のタグを持つ記事を取得できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go", "プログラミング", "バックエンド"}, "")
 		insertArticleDirectly(t, db, article)
@@ -429,7 +431,7 @@ func TestMySQLArticleRepository_FindAll(t *testing.T) {
 
 	t.Run("異常系：キャンセルされたコンテキストではエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
@@ -450,7 +452,7 @@ func TestMySQLArticleRepository_Update(t *testing.T) {
 
 		ensureTagsExist(t, db, []string{"Go", "完全ガイド"})
 
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "後で読む")
 		id := insertArticleDirectly(t, db, article)
 
@@ -474,7 +476,7 @@ func TestMySQLArticleRepository_Update(t *testing.T) {
 
 	t.Run("正常系：タグを空配列に更新できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go", "プログラミング"}, "")
 		id := insertArticleDirectly(t, db, article)
@@ -493,7 +495,7 @@ func TestMySQLArticleRepository_Update(t *testing.T) {
 
 	t.Run("正常系：メモを空文字列に更新できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "後で読む")
 		id := insertArticleDirectly(t, db, article)
@@ -512,7 +514,7 @@ func TestMySQLArticleRepository_Update(t *testing.T) {
 
 	t.Run("正常系：UpdatedAtが更新される", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		id := insertArticleDirectly(t, db, article)
@@ -535,7 +537,7 @@ func TestMySQLArticleRepository_Update(t *testing.T) {
 
 	t.Run("異常系：存在しないIDの記事を更新しようとするとエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		article.ID = 99999
@@ -550,7 +552,7 @@ func TestMySQLArticleRepository_Update(t *testing.T) {
 
 	t.Run("異常系：IDが0の記事を更新しようとするとエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		article.ID = 0
@@ -564,7 +566,7 @@ func TestMySQLArticleRepository_Update(t *testing.T) {
 
 	t.Run("異常系：nilの記事を更新しようとするとエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		updated, err := repo.Update(ctx, nil)
@@ -575,7 +577,7 @@ func TestMySQLArticleRepository_Update(t *testing.T) {
 
 	t.Run("異常系：キャンセルされたコンテキストではエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		id := insertArticleDirectly(t, db, article)
@@ -591,13 +593,133 @@ func TestMySQLArticleRepository_Update(t *testing.T) {
 	})
 }
 
+func TestMySQLArticleRepository_UpdateURL(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	t.Run("正常系：URLのみを更新し、メタデータを破棄する", func(t *testing.T) {
+		cleanupTable(t, db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
+		article := createTestArticle(t, "Go言語入門", "https://old.example.com/go", "Go言語の基本", []string{"Go"}, "後で読む")
+		id := insertArticleDirectly(t, db, article)
+		ctx := context.Background()
+		_, err := db.Exec(`UPDATE articles SET site_name = ?, metadata_fetched_at = ?, title = ? WHERE id = ?`, "Old", time.Now(), "編集後のタイトル", id)
+		require.NoError(t, err)
+
+		article.ID = id
+		require.NoError(t, article.ChangeURL("https://new.example.com/go"))
+		require.NoError(t, repo.UpdateURL(ctx, article, "https://old.example.com/go"))
+
+		found, err := repo.FindByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "https://new.example.com/go", found.URL)
+		assert.Equal(t, "編集後のタイトル", found.Title)
+		assert.Equal(t, []string{"Go"}, found.Tags)
+		assert.Equal(t, "後で読む", found.Memo)
+		assert.Empty(t, found.Metadata.SiteName)
+		assert.False(t, found.Metadata.IsFetched())
+	})
+
+	t.Run("異常系：URLが変わっていた場合は更新しない", func(t *testing.T) {
+		cleanupTable(t, db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
+		article := createTestArticle(t, "Go言語入門", "https://edited.example.com/go", "Go言語の基本", nil, "")
+		id := insertArticleDirectly(t, db, article)
+
+		article.ID = id
+		require.NoError(t, article.ChangeURL("https://new.example.com/go"))
+		err := repo.UpdateURL(context.Background(), article, "https://old.example.com/go")
+
+		assert.True(t, domainerrors.IsNotFoundError(err))
+		found, err := repo.FindByID(context.Background(), id)
+		require.NoError(t, err)
+		assert.Equal(t, "https://edited.example.com/go", found.URL)
+	})
+}
+
+func TestMySQLArticleRepository_UpdateMetadata(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	t.Run("正常系：メタデータのみを更新し、失敗の記録をリセットする", func(t *testing.T) {
+		cleanupTable(t, db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
+		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "後で読む")
+		id := insertArticleDirectly(t, db, article)
+		ctx := context.Background()
+		require.NoError(t, repo.RecordMetadataFetchFailure(ctx, id, article.URL, time.Now()))
+
+		// 取得中にユーザーがタイトルを編集した
+		_, err := db.Exec(`UPDATE articles SET title = ? WHERE id = ?`, "編集後のタイトル", id)
+		require.NoError(t, err)
+
+		fetchedAt := time.Now()
+		err = repo.UpdateMetadata(ctx, id, article.URL, entity.ArticleMetadata{SiteName: "Example", FetchedAt: &fetchedAt})
+		require.NoError(t, err)
+
+		found, err := repo.FindByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, "編集後のタイトル", found.Title)
+		assert.Equal(t, []string{"Go"}, found.Tags)
+		assert.Equal(t, "Example", found.Metadata.SiteName)
+		assert.True(t, found.Metadata.IsFetched())
+		assert.Equal(t, 0, found.Metadata.FetchFailures)
+		assert.Nil(t, found.Metadata.LastFailedAt)
+	})
+
+	t.Run("異常系：URLが変わっていた場合は更新しない", func(t *testing.T) {
+		cleanupTable(t, db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
+		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", nil, "")
+		id := insertArticleDirectly(t, db, article)
+
+		fetchedAt := time.Now()
+		err := repo.UpdateMetadata(context.Background(), id, "https://example.com/old", entity.ArticleMetadata{SiteName: "Example", FetchedAt: &fetchedAt})
+
+		assert.True(t, domainerrors.IsNotFoundError(err))
+		found, err := repo.FindByID(context.Background(), id)
+		require.NoError(t, err)
+		assert.False(t, found.Metadata.IsFetched())
+	})
+}
+
+func TestMySQLArticleRepository_RecordMetadataFetchFailure(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	t.Run("正常系：失敗回数と最後に失敗した日時を記録する", func(t *testing.T) {
+		cleanupTable(t, db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
+		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", nil, "")
+		id := insertArticleDirectly(t, db, article)
+		ctx := context.Background()
+
+		require.NoError(t, repo.RecordMetadataFetchFailure(ctx, id, article.URL, time.Now()))
+		require.NoError(t, repo.RecordMetadataFetchFailure(ctx, id, article.URL, time.Now()))
+
+		found, err := repo.FindByID(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, 2, found.Metadata.FetchFailures)
+		assert.NotNil(t, found.Metadata.LastFailedAt)
+	})
+
+	t.Run("異常系：存在しない記事", func(t *testing.T) {
+		cleanupTable(t, db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
+
+		err := repo.RecordMetadataFetchFailure(context.Background(), 99999, "https://example.com/go", time.Now())
+
+		assert.True(t, domainerrors.IsNotFoundError(err))
+	})
+}
+
 func TestMySQLArticleRepository_Delete(t *testing.T) {
 	db := setupTestDB(t)
 	defer db.Close()
 
 	t.Run("正常系：記事を削除できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		id := insertArticleDirectly(t, db, article)
@@ -615,7 +737,7 @@ func TestMySQLArticleRepository_Delete(t *testing.T) {
 
 	t.Run("正常系：記事を削除するとarticle_tagsも削除される", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go", "プログラミング"}, "")
 		id := insertArticleDirectly(t, db, article)
@@ -633,7 +755,7 @@ func TestMySQLArticleRepository_Delete(t *testing.T) {
 
 	t.Run("正常系：複数の記事のうち1つを削除できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "記事1", "https://example.com/1", "要約1", []string{"tag1"}, "")
 		article2 := createTestArticle(t, "記事2", "https://example.com/2", "要約2", []string{"tag2"}, "")
@@ -654,7 +776,7 @@ func TestMySQLArticleRepository_Delete(t *testing.T) {
 
 	t.Run("異常系：存在しないIDの記事を削除しようとするとエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		err := repo.Delete(ctx, 99999)
@@ -665,7 +787,7 @@ func TestMySQLArticleRepository_Delete(t *testing.T) {
 
 	t.Run("異常系：IDが0の記事を削除しようとするとエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		err := repo.Delete(ctx, 0)
@@ -675,7 +797,7 @@ func TestMySQLArticleRepository_Delete(t *testing.T) {
 
 	t.Run("異常系：負のIDの記事を削除しようとするとエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		err := repo.Delete(ctx, -1)
@@ -685,7 +807,7 @@ func TestMySQLArticleRepository_Delete(t *testing.T) {
 
 	t.Run("異常系：キャンセルされたコンテキストではエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		id := insertArticleDirectly(t, db, article)
@@ -700,7 +822,7 @@ func TestMySQLArticleRepository_Delete(t *testing.T) {
 
 	t.Run("異常系：同じIDを2回削除しようとすると2回目はエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		id := insertArticleDirectly(t, db, article)
@@ -724,7 +846,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：単一キーワードでタイトルを検索できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本を学ぶ", []string{"Go"}, "")
 		article2 := createTestArticle(t, "Python入門", "https://example.com/python", "Pythonの基本を学ぶ", []string{"Python"}, "")
@@ -747,7 +869,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：単一キーワードで要約（Summary）を検索できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "プログラミング入門", "https://example.com/prog1", "Go言語とPythonの比較", []string{"Go", "Python"}, "")
 		article2 := createTestArticle(t, "Web開発入門", "https://example.com/web", "JavaScriptでWeb開発", []string{"JavaScript"}, "")
@@ -769,7 +891,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：複数キーワード（2単語）でAND検索できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本を学ぶ", []string{"Go"}, "")
 		article2 := createTestArticle(t, "Go言語完全ガイド", "https://example.com/go-guide", "Go言語の完全版", []string{"Go"}, "")
@@ -793,7 +915,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：複数キーワード（3単語）でAND検索できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本を学ぶ", []string{"Go"}, "")
 		article2 := createTestArticle(t, "Go言語完全ガイド", "https://example.com/go-guide", "Go言語の基本から応用まで完全網羅", []string{"Go"}, "")
@@ -815,7 +937,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：タイトルと要約の両方を検索対象とする", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		// タイトルにのみ"Go言語"を含む記事
 		article1 := createTestArticle(t, "Go言語入門", "https://example.com/go1", "プログラミングの基礎", []string{"Go"}, "")
@@ -841,7 +963,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：複数キーワードがタイトルと要約に分散している場合もマッチする", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		// "Go言語"はタイトル、"パフォーマンス"は要約に存在
 		article1 := createTestArticle(t, "Go言語入門", "https://example.com/go1", "パフォーマンスの最適化", []string{"Go"}, "")
@@ -868,7 +990,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：検索キーワードが空文字列の場合は全件取得と同じ", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		article2 := createTestArticle(t, "Python入門", "https://example.com/python", "Pythonの基本", []string{"Python"}, "")
@@ -887,7 +1009,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：検索キーワードがスペースのみの場合は全件取得と同じ", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		article2 := createTestArticle(t, "Python入門", "https://example.com/python", "Pythonの基本", []string{"Python"}, "")
@@ -906,7 +1028,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：マッチする記事が0件の場合は空配列を返す", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		article2 := createTestArticle(t, "Python入門", "https://example.com/python", "Pythonの基本", []string{"Python"}, "")
@@ -924,7 +1046,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：記事が0件の状態で検索しても空配列を返す", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		results, err := repo.Search(ctx, "Go言語")
@@ -936,7 +1058,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：大文字小文字を区別せず検索する", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "Go Language Tutorial", "https://example.com/go", "Learn GO programming", []string{"Go"}, "")
 		article2 := createTestArticle(t, "python tutorial", "https://example.com/python", "Learn python", []string{"Python"}, "")
@@ -955,7 +1077,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：前後の空白を無視して検索する", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		article2 := createTestArticle(t, "Python入門", "https://example.com/python", "Pythonの基本", []string{"Python"}, "")
@@ -974,7 +1096,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：複数の連続するスペースも1つの区切りとして扱う", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "Go言語完全ガイド", "https://example.com/go", "Go言語の基本から応用まで", []string{"Go"}, "")
 		article2 := createTestArticle(t, "Go言語入門", "https://example.com/go2", "初心者向けの内容", []string{"Go"}, "")
@@ -994,7 +1116,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：部分一致で検索できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		article2 := createTestArticle(t, "Python入門", "https://example.com/python", "Pythonの基本", []string{"Python"}, "")
@@ -1014,7 +1136,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：結果はcreated_atの降順でソートされる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "Go言語入門", "https://example.com/go1", "Go言語の基本", []string{"Go"}, "")
 		article2 := createTestArticle(t, "Go言語応用", "https://example.com/go2", "Go言語の応用", []string{"Go"}, "")
@@ -1040,7 +1162,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：検索結果にタグも含まれる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go", "プログラミング", "入門"}, "")
 		insertArticleDirectly(t, db, article1)
@@ -1056,7 +1178,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：検索結果にメモも含まれる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "後で読む")
 		insertArticleDirectly(t, db, article1)
@@ -1072,7 +1194,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("異常系：キャンセルされたコンテキストではエラー", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article := createTestArticle(t, "Go言語入門", "https://example.com/go", "Go言語の基本", []string{"Go"}, "")
 		insertArticleDirectly(t, db, article)
@@ -1088,7 +1210,7 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 
 	t.Run("正常系：特殊文字を含むキーワードでも検索できる", func(t *testing.T) {
 		cleanupTable(t, db)
-		repo := NewMySQLArticleRepository(db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
 
 		article1 := createTestArticle(t, "C++プログラミング", "https://example.com/cpp", "C++の基本", []string{"C++"}, "")
 		article2 := createTestArticle(t, "C#プログラミング", "https://example.com/csharp", "C#の基本", []string{"C#"}, "")
@@ -1105,3 +1227,44 @@ func TestMySQLArticleRepository_Search(t *testing.T) {
 		assert.Equal(t, "C++プログラミング", results[0].Title)
 	})
 }
+
+func TestMySQLArticleRepository_FindTagGraph(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	t.Run("正常系：タグの記事数と共起数を集計できる", func(t *testing.T) {
+		cleanupTable(t, db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
+
+		insertArticleDirectly(t, db, createTestArticle(t, "記事1", "https://example.com/1", "要約", []string{"Go", "Docker"}, ""))
+		insertArticleDirectly(t, db, createTestArticle(t, "記事2", "https://example.com/2", "要約", []string{"Go", "Docker", "AWS"}, ""))
+		insertArticleDirectly(t, db, createTestArticle(t, "記事3", "https://example.com/3", "要約", []string{"Go"}, ""))
+
+		graph, err := repo.FindTagGraph(context.Background(), entity.TagGraphOptions{MinWeight: 1})
+
+		require.NoError(t, err)
+		require.Len(t, graph.Nodes, 3)
+		assert.Equal(t, "Go", graph.Nodes[0].Name)
+		assert.Equal(t, 3, graph.Nodes[0].ArticleCount)
+		assert.NotZero(t, graph.Nodes[0].TagID)
+		assert.Equal(t, []entity.TagGraphEdge{
+			{Source: "Docker", Target: "Go", Weight: 2},
+			{Source: "AWS", Target: "Docker", Weight: 1},
+			{Source: "AWS", Target: "Go", Weight: 1},
+		}, graph.Edges)
+	})
+
+	t.Run("正常系：最小の重みと上位の件数で絞り込める", func(t *testing.T) {
+		cleanupTable(t, db)
+		repo := NewMySQLArticleRepository(db, entity.DefaultTagNamePolicy())
+
+		insertArticleDirectly(t, db, createTestArticle(t, "記事1", "https://example.com/1", "要約", []string{"Go", "Docker"}, ""))
+		insertArticleDirectly(t, db, createTestArticle(t, "記事2", "https://example.com/2", "要約", []string{"Go", "Docker", "AWS"}, ""))
+
+		graph, err := repo.FindTagGraph(context.Background(), entity.TagGraphOptions{MinWeight: 2, Limit: 2})
+
+		require.NoError(t, err)
+		require.Len(t, graph.Nodes, 2)
+		assert.Equal(t, []entity.TagGraphEdge{{Source: "Docker", Target: "Go", Weight: 2}}, graph.Edges)
+	})
+}
@@ -0,0 +1,256 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/infrastructure/logger"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// book_feedbackテーブルとのマッピング
+type bookFeedbackRow struct {
+	ID          int64          `db:"id"`
+	FeedbackKey string         `db:"feedback_key"`
+	ISBN13      sql.NullString `db:"isbn13"`
+	Title       string         `db:"title"`
+	Author      string         `db:"author"`
+	State       string         `db:"state"`
+	CreatedAt   sql.NullTime   `db:"created_at"`
+	UpdatedAt   sql.NullTime   `db:"updated_at"`
+}
+
+// BookFeedbackRepositoryのMySQL実装
+type mysqlBookFeedbackRepository struct {
+	db *sqlx.DB
+}
+
+// コンストラクタ
+func NewMySQLBookFeedbackRepository(db *sqlx.DB) repository.BookFeedbackRepository {
+	return &mysqlBookFeedbackRepository{db: db}
+}
+
+// 新しいフィードバックを保存
+func (r *mysqlBookFeedbackRepository) Create(ctx context.Context, feedback *entity.BookFeedback) (*entity.BookFeedback, error) {
+	if feedback == nil {
+		logger.Error("Attempted to create nil book feedback")
+		return nil, domainerrors.InvalidArgumentError("feedback", "feedback cannot be nil")
+	}
+
+	logger.Debug("Creating book feedback in database",
+		zap.String("key", feedback.Key),
+		zap.String("state", string(feedback.State)),
+	)
+
+	query := `
+		INSERT INTO book_feedback (feedback_key, isbn13, title, author, state, created_at, updated_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		feedback.Key,
+		sql.NullString{String: feedback.ISBN13, Valid: feedback.ISBN13 != ""},
+		feedback.Title,
+		feedback.Author,
+		string(feedback.State),
+		feedback.CreatedAt,
+		feedback.UpdatedAt,
+	)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+			if mysqlErr.Number == 1062 {
+				logger.Debug("Book feedback already exists",
+					zap.String("key", feedback.Key),
+				)
+				return nil, domainerrors.AlreadyExistsError("book_feedback", feedback.Key)
+			}
+		}
+		logger.Error("Failed to insert book feedback",
+			zap.Error(err),
+			zap.String("key", feedback.Key),
+		)
+		return nil, domainerrors.DatabaseError("insert book feedback", err)
+	}
+
+	feedbackID, err := result.LastInsertId()
+	if err != nil {
+		logger.Error("Failed to get last insert ID",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("get last insert id", err)
+	}
+
+	logger.Info("Successfully created book feedback in database",
+		zap.Int64("id", feedbackID),
+		zap.String("key", feedback.Key),
+	)
+
+	return r.FindByID(ctx, feedbackID)
+}
+
+// 指定されたIDのフィードバックを取得
+func (r *mysqlBookFeedbackRepository) FindByID(ctx context.Context, id int64) (*entity.BookFeedback, error) {
+	if id <= 0 {
+		logger.Warn("Invalid book feedback ID",
+			zap.Int64("id", id),
+		)
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	query := `
+		SELECT id, feedback_key, isbn13, title, author, state, created_at, updated_at
+		FROM book_feedback
+		WHERE id = ?
+	`
+
+	var row bookFeedbackRow
+	err := r.db.GetContext(ctx, &row, query, id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			logger.Debug("Book feedback not found",
+				zap.Int64("id", id),
+			)
+			return nil, domainerrors.NotFoundError("book_feedback", id)
+		}
+		logger.Error("Failed to find book feedback",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return nil, domainerrors.DatabaseError("find book feedback", err)
+	}
+
+	return bookFeedbackRowToEntity(&row), nil
+}
+
+// すべてのフィードバックを更新の新しい順に取得
+func (r *mysqlBookFeedbackRepository) FindAll(ctx context.Context) ([]*entity.BookFeedback, error) {
+	query := `
+		SELECT id, feedback_key, isbn13, title, author, state, created_at, updated_at
+		FROM book_feedback
+		ORDER BY updated_at DESC, id DESC
+	`
+
+	var rows []bookFeedbackRow
+	if err := r.db.SelectContext(ctx, &rows, query); err != nil {
+		logger.Error("Failed to find all book feedback",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("find all book feedback", err)
+	}
+
+	feedback := make([]*entity.BookFeedback, 0, len(rows))
+	for i := range rows {
+		feedback = append(feedback, bookFeedbackRowToEntity(&rows[i]))
+	}
+
+	logger.Debug("Successfully found all book feedback",
+		zap.Int("count", len(feedback)),
+	)
+
+	return feedback, nil
+}
+
+// フィードバックを更新
+func (r *mysqlBookFeedbackRepository) Update(ctx context.Context, feedback *entity.BookFeedback) (*entity.BookFeedback, error) {
+	if feedback == nil {
+		logger.Error("Attempted to update nil book feedback")
+		return nil, domainerrors.InvalidArgumentError("feedback", "feedback cannot be nil")
+	}
+	if feedback.ID <= 0 {
+		logger.Warn("Invalid book feedback ID for update",
+			zap.Int64("id", feedback.ID),
+		)
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	query := `UPDATE book_feedback SET state = ?, updated_at = ? WHERE id = ?`
+
+	result, err := r.db.ExecContext(ctx, query, string(feedback.State), feedback.UpdatedAt, feedback.ID)
+	if err != nil {
+		logger.Error("Failed to update book feedback",
+			zap.Error(err),
+			zap.Int64("id", feedback.ID),
+		)
+		return nil, domainerrors.DatabaseError("update book feedback", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("Failed to get rows affected",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("get rows affected", err)
+	}
+	if rowsAffected == 0 {
+		logger.Debug("Book feedback not found for update",
+			zap.Int64("id", feedback.ID),
+		)
+		return nil, domainerrors.NotFoundError("book_feedback", feedback.ID)
+	}
+
+	logger.Info("Successfully updated book feedback in database",
+		zap.Int64("id", feedback.ID),
+		zap.String("state", string(feedback.State)),
+	)
+
+	return r.FindByID(ctx, feedback.ID)
+}
+
+// 指定されたIDのフィードバックを削除
+func (r *mysqlBookFeedbackRepository) Delete(ctx context.Context, id int64) error {
+	if id <= 0 {
+		logger.Warn("Invalid book feedback ID for deletion",
+			zap.Int64("id", id),
+		)
+		return domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM book_feedback WHERE id = ?`, id)
+	if err != nil {
+		logger.Error("Failed to delete book feedback",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return domainerrors.DatabaseError("delete book feedback", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("Failed to get rows affected",
+			zap.Error(err),
+		)
+		return domainerrors.DatabaseError("get rows affected", err)
+	}
+	if rowsAffected == 0 {
+		logger.Debug("Book feedback not found for deletion",
+			zap.Int64("id", id),
+		)
+		return domainerrors.NotFoundError("book_feedback", id)
+	}
+
+	logger.Info("Successfully deleted book feedback from database",
+		zap.Int64("id", id),
+	)
+
+	return nil
+}
+
+// bookFeedbackRowをentity.BookFeedbackに変換
+func bookFeedbackRowToEntity(row *bookFeedbackRow) *entity.BookFeedback {
+	return &entity.BookFeedback{
+		ID:        row.ID,
+		Key:       row.FeedbackKey,
+		ISBN13:    row.ISBN13.String,
+		Title:     row.Title,
+		Author:    row.Author,
+		State:     entity.BookFeedbackState(row.State),
+		CreatedAt: row.CreatedAt.Time,
+		UpdatedAt: row.UpdatedAt.Time,
+	}
+}
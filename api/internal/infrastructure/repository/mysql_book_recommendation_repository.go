@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"time"
 
 	"article-manager/internal/domain/entity"
 	domainerrors "article-manager/internal/domain/errors"
@@ -17,7 +18,11 @@ import (
 // book_recommendationsテーブルとのマッピング
 type bookRecommendationRow struct {
 	ID                  int64        `db:"id"`
+	ScopeKey            string       `db:"scope_key"`
 	RecommendationsJSON string       `db:"recommendations_json"`
+	ArticleCount        int          `db:"article_count"`
+	Model               string       `db:"model"`
+	PromptVersion       string       `db:"prompt_version"`
 	CreatedAt           sql.NullTime `db:"created_at"`
 	ExpiresAt           sql.NullTime `db:"expires_at"`
 }
@@ -32,25 +37,27 @@ func NewMySQLBookRecommendationRepository(db *sqlx.DB) repository.BookRecommenda
 	return &mysqlBookRecommendationRepository{db: db}
 }
 
-// 最新の有効なキャッシュを取得
-func (r *mysqlBookRecommendationRepository) FindLatestValid(ctx context.Context) (*entity.BookRecommendationCache, error) {
-	logger.Debug("Finding latest valid book recommendation cache")
+// 指定された対象範囲の最新の有効なキャッシュを取得
+func (r *mysqlBookRecommendationRepository) FindLatestValid(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
+	logger.Debug("Finding latest valid book recommendation cache",
+		zap.String("scope_key", scopeKey),
+	)
 
 	// 現在時刻より後に期限切れとなるキャッシュ(有効なキャッシュ)を取得
 	query := `
-		SELECT id, recommendations_json, created_at, expires_at
+		SELECT id, scope_key, recommendations_json, article_count, model, prompt_version, created_at, expires_at
 		FROM book_recommendations
-		WHERE expires_at > NOW()
+		WHERE scope_key = ? AND expires_at > NOW()
 		ORDER BY created_at DESC
 		LIMIT 1
     `
 
 	var row bookRecommendationRow
-	err := r.db.GetContext(ctx, &row, query)
+	err := r.db.GetContext(ctx, &row, query, scopeKey)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			logger.Debug("No valid book recommendation cache found")
-			return nil, domainerrors.NotFoundError("book_recommendation_cache", "valid cache")
+			return nil, domainerrors.NotFoundError("book_recommendation_cache", scopeKey)
 		}
 		logger.Error("Failed to find book recommendation cache",
 			zap.Error(err),
@@ -71,6 +78,103 @@ func (r *mysqlBookRecommendationRepository) FindLatestValid(ctx context.Context)
 	return cache, nil
 }
 
+// 指定されたIDの推薦を取得
+func (r *mysqlBookRecommendationRepository) FindByID(ctx context.Context, id int64) (*entity.BookRecommendationCache, error) {
+	query := `
+		SELECT id, scope_key, recommendations_json, article_count, model, prompt_version, created_at, expires_at
+		FROM book_recommendations
+		WHERE id = ?
+    `
+
+	var row bookRecommendationRow
+	if err := r.db.GetContext(ctx, &row, query, id); err != nil {
+		if err == sql.ErrNoRows {
+			logger.Debug("Book recommendation not found",
+				zap.Int64("id", id),
+			)
+			return nil, domainerrors.NotFoundError("book_recommendation", id)
+		}
+		logger.Error("Failed to find book recommendation",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return nil, domainerrors.DatabaseError("find book recommendation", err)
+	}
+
+	return rowToBookRecommendationCache(&row)
+}
+
+// 有効期限がbeforeまでに切れる、対象範囲ごとの最新の有効なキャッシュを期限の近い順に取得
+func (r *mysqlBookRecommendationRepository) FindValidExpiringBefore(ctx context.Context, before time.Time) ([]*entity.BookRecommendationCache, error) {
+	query := `
+		SELECT br.id, br.scope_key, br.recommendations_json, br.article_count, br.model, br.prompt_version, br.created_at, br.expires_at
+		FROM book_recommendations br
+		WHERE br.expires_at > NOW() AND br.expires_at <= ?
+		AND br.id = (SELECT MAX(latest.id) FROM book_recommendations latest WHERE latest.scope_key = br.scope_key)
+		ORDER BY br.expires_at ASC
+    `
+
+	var rows []bookRecommendationRow
+	if err := r.db.SelectContext(ctx, &rows, query, before); err != nil {
+		logger.Error("Failed to find expiring book recommendation caches",
+			zap.Error(err),
+			zap.Time("before", before),
+		)
+		return nil, domainerrors.DatabaseError("find expiring book recommendation caches", err)
+	}
+
+	caches := make([]*entity.BookRecommendationCache, 0, len(rows))
+	for i := range rows {
+		cache, err := rowToBookRecommendationCache(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		caches = append(caches, cache)
+	}
+
+	return caches, nil
+}
+
+// 指定された対象範囲の推薦の履歴を新しい順に取得
+func (r *mysqlBookRecommendationRepository) FindHistory(ctx context.Context, scopeKey string, limit int) ([]*entity.BookRecommendationCache, error) {
+	if limit <= 0 {
+		return nil, domainerrors.InvalidArgumentError("limit", "limit must be positive")
+	}
+
+	logger.Debug("Finding book recommendation history",
+		zap.String("scope_key", scopeKey),
+		zap.Int("limit", limit),
+	)
+
+	query := `
+		SELECT id, scope_key, recommendations_json, article_count, model, prompt_version, created_at, expires_at
+		FROM book_recommendations
+		WHERE scope_key = ?
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?
+    `
+
+	var rows []bookRecommendationRow
+	if err := r.db.SelectContext(ctx, &rows, query, scopeKey, limit); err != nil {
+		logger.Error("Failed to find book recommendation history",
+			zap.Error(err),
+			zap.String("scope_key", scopeKey),
+		)
+		return nil, domainerrors.DatabaseError("find book recommendation history", err)
+	}
+
+	history := make([]*entity.BookRecommendationCache, 0, len(rows))
+	for i := range rows {
+		cache, err := rowToBookRecommendationCache(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, cache)
+	}
+
+	return history, nil
+}
+
 // キャッシュを保存
 func (r *mysqlBookRecommendationRepository) Save(ctx context.Context, cache *entity.BookRecommendationCache) (*entity.BookRecommendationCache, error) {
 	if cache == nil {
@@ -79,6 +183,7 @@ func (r *mysqlBookRecommendationRepository) Save(ctx context.Context, cache *ent
 	}
 
 	logger.Debug("Saving book recommendation cache",
+		zap.String("scope_key", cache.ScopeKey),
 		zap.Int("book_count", len(cache.Books)),
 		zap.Time("expires_at", cache.ExpiresAt),
 	)
@@ -92,42 +197,22 @@ func (r *mysqlBookRecommendationRepository) Save(ctx context.Context, cache *ent
 		return nil, domainerrors.DatabaseError("marshal books", err)
 	}
 
-	// トランザクション開始
-	tx, err := r.db.BeginTxx(ctx, nil)
-	if err != nil {
-		logger.Error("Failed to begin transaction",
-			zap.Error(err),
-			zap.String("operation", "Save"),
-		)
-		return nil, domainerrors.DatabaseError("begin transaction", err)
-	}
-	defer func() {
-		if p := recover(); p != nil {
-			_ = tx.Rollback()
-			panic(p)
-		}
-	}()
-
-	// 既存のキャッシュを全て削除
-	deleteQuery := `DELETE FROM book_recommendations`
-	_, err = tx.ExecContext(ctx, deleteQuery)
-	if err != nil {
-		_ = tx.Rollback()
-		logger.Error("Failed to delete old cache",
-			zap.Error(err),
-		)
-		return nil, domainerrors.DatabaseError("delete old cache", err)
-	}
-
-	// 新しいキャッシュを挿入
+	// 過去の推薦は履歴として残すため、新しい推薦を追加するのみ
 	insertQuery := `
-		INSERT INTO book_recommendations (recommendations_json, created_at, expires_at)
-		VALUES (?, ?, ?)
+		INSERT INTO book_recommendations (scope_key, recommendations_json, article_count, model, prompt_version, created_at, expires_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
     `
 
-	result, err := tx.ExecContext(ctx, insertQuery, string(booksJSON), cache.GeneratedAt, cache.ExpiresAt)
+	result, err := r.db.ExecContext(ctx, insertQuery,
+		cache.ScopeKey,
+		string(booksJSON),
+		cache.ArticleCount,
+		cache.Model,
+		cache.PromptVersion,
+		cache.GeneratedAt,
+		cache.ExpiresAt,
+	)
 	if err != nil {
-		_ = tx.Rollback()
 		logger.Error("Failed to insert book recommendation cache",
 			zap.Error(err),
 		)
@@ -136,24 +221,15 @@ func (r *mysqlBookRecommendationRepository) Save(ctx context.Context, cache *ent
 
 	cacheID, err := result.LastInsertId()
 	if err != nil {
-		_ = tx.Rollback()
 		logger.Error("Failed to get last insert ID",
 			zap.Error(err),
 		)
 		return nil, domainerrors.DatabaseError("get last insert id", err)
 	}
 
-	// トランザクションコミット
-	if err := tx.Commit(); err != nil {
-		logger.Error("Failed to commit transaction",
-			zap.Error(err),
-			zap.Int64("cache_id", cacheID),
-		)
-		return nil, domainerrors.DatabaseError("commit transaction", err)
-	}
-
 	logger.Info("Successfully saved book recommendation cache",
 		zap.Int64("id", cacheID),
+		zap.String("scope_key", cache.ScopeKey),
 		zap.Int("book_count", len(cache.Books)),
 	)
 
@@ -161,6 +237,45 @@ func (r *mysqlBookRecommendationRepository) Save(ctx context.Context, cache *ent
 	return cache, nil
 }
 
+// 保持期間を過ぎた推薦を削除
+func (r *mysqlBookRecommendationRepository) DeleteGeneratedBefore(ctx context.Context, before time.Time) (int64, error) {
+	// 対象範囲ごとの最新の推薦は、キャッシュや差分の基準として残す
+	// MySQLでは削除対象のテーブルを直接サブクエリで参照できないため、派生テーブルを経由する
+	query := `
+		DELETE FROM book_recommendations
+		WHERE created_at < ?
+		AND id NOT IN (
+			SELECT id FROM (
+				SELECT MAX(id) AS id FROM book_recommendations GROUP BY scope_key
+			) AS latest
+		)
+    `
+
+	result, err := r.db.ExecContext(ctx, query, before)
+	if err != nil {
+		logger.Error("Failed to delete old book recommendations",
+			zap.Error(err),
+			zap.Time("before", before),
+		)
+		return 0, domainerrors.DatabaseError("delete old book recommendations", err)
+	}
+
+	deleted, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("Failed to get rows affected",
+			zap.Error(err),
+		)
+		return 0, domainerrors.DatabaseError("get rows affected", err)
+	}
+
+	logger.Info("Deleted old book recommendations",
+		zap.Int64("deleted", deleted),
+		zap.Time("before", before),
+	)
+
+	return deleted, nil
+}
+
 // bookRecommendationRowをentity.BookRecommendationCacheに変換
 func rowToBookRecommendationCache(row *bookRecommendationRow) (*entity.BookRecommendationCache, error) {
 	var books []entity.Book
@@ -174,9 +289,14 @@ func rowToBookRecommendationCache(row *bookRecommendationRow) (*entity.BookRecom
 
 	cache := &entity.BookRecommendationCache{
 		ID:          row.ID,
+		ScopeKey:    row.ScopeKey,
 		Books:       books,
 		GeneratedAt: row.CreatedAt.Time,
 		ExpiresAt:   row.ExpiresAt.Time,
+
+		ArticleCount:  row.ArticleCount,
+		Model:         row.Model,
+		PromptVersion: row.PromptVersion,
 	}
 
 	return cache, nil
@@ -0,0 +1,489 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/infrastructure/logger"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// collectionsテーブルと掲載する記事を結合した行とのマッピング
+type collectionWithArticleRow struct {
+	ID          int64          `db:"id"`
+	Name        string         `db:"name"`
+	Description sql.NullString `db:"description"`
+	CreatedAt   sql.NullTime   `db:"created_at"`
+	UpdatedAt   sql.NullTime   `db:"updated_at"`
+	ArticleID   sql.NullInt64  `db:"article_id"`
+}
+
+const collectionSelect = `
+		SELECT
+			c.id, c.name, c.description, c.created_at, c.updated_at,
+			ca.article_id
+		FROM collections c
+		LEFT JOIN collection_articles ca ON c.id = ca.collection_id
+`
+
+// CollectionRepositoryのMySQL実装
+type mysqlCollectionRepository struct {
+	db *sqlx.DB
+}
+
+// コンストラクタ
+func NewMySQLCollectionRepository(db *sqlx.DB) repository.CollectionRepository {
+	return &mysqlCollectionRepository{db: db}
+}
+
+// 新しいコレクションを保存
+func (r *mysqlCollectionRepository) Create(ctx context.Context, collection *entity.Collection) (*entity.Collection, error) {
+	if collection == nil {
+		logger.Error("Attempted to create nil collection")
+		return nil, domainerrors.InvalidArgumentError("collection", "collection cannot be nil")
+	}
+
+	logger.Debug("Creating collection in database",
+		zap.String("name", collection.Name),
+	)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("Failed to begin transaction",
+			zap.Error(err),
+			zap.String("operation", "Create"),
+		)
+		return nil, domainerrors.DatabaseError("begin transaction", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	query := `INSERT INTO collections (name, description, created_at, updated_at) VALUES (?, ?, ?, ?)`
+
+	result, err := tx.ExecContext(ctx, query,
+		collection.Name, nullString(collection.Description), collection.CreatedAt, collection.UpdatedAt,
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
+			logger.Debug("Collection already exists",
+				zap.String("name", collection.Name),
+			)
+			return nil, domainerrors.AlreadyExistsError("collection", collection.Name)
+		}
+		logger.Error("Failed to insert collection",
+			zap.Error(err),
+			zap.String("name", collection.Name),
+		)
+		return nil, domainerrors.DatabaseError("insert collection", err)
+	}
+
+	collectionID, err := result.LastInsertId()
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Error("Failed to get last insert ID",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("get last insert id", err)
+	}
+
+	if err := r.insertCollectionArticles(ctx, tx, collectionID, collection.ArticleIDs); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("Failed to commit transaction",
+			zap.Error(err),
+			zap.Int64("collection_id", collectionID),
+		)
+		return nil, domainerrors.DatabaseError("commit transaction", err)
+	}
+
+	logger.Info("Successfully created collection in database",
+		zap.Int64("id", collectionID),
+		zap.String("name", collection.Name),
+	)
+
+	return r.FindByID(ctx, collectionID)
+}
+
+// 指定されたIDのコレクションを取得
+func (r *mysqlCollectionRepository) FindByID(ctx context.Context, id int64) (*entity.Collection, error) {
+	if id <= 0 {
+		logger.Warn("Invalid collection ID",
+			zap.Int64("id", id),
+		)
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	query := collectionSelect + `
+		WHERE c.id = ?
+		ORDER BY ca.position ASC
+	`
+
+	var rows []collectionWithArticleRow
+	if err := r.db.SelectContext(ctx, &rows, query, id); err != nil {
+		logger.Error("Failed to find collection",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return nil, domainerrors.DatabaseError("find collection", err)
+	}
+
+	collections := collectionsFromRows(rows)
+	if len(collections) == 0 {
+		logger.Debug("Collection not found",
+			zap.Int64("id", id),
+		)
+		return nil, domainerrors.NotFoundError("collection", id)
+	}
+
+	return collections[0], nil
+}
+
+// すべてのコレクションを名前順に取得
+func (r *mysqlCollectionRepository) FindAll(ctx context.Context) ([]*entity.Collection, error) {
+	logger.Debug("Finding all collections")
+
+	query := collectionSelect + `
+		ORDER BY c.name ASC, c.id ASC, ca.position ASC
+	`
+
+	var rows []collectionWithArticleRow
+	if err := r.db.SelectContext(ctx, &rows, query); err != nil {
+		logger.Error("Failed to find all collections",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("find all collections", err)
+	}
+
+	collections := collectionsFromRows(rows)
+
+	logger.Debug("Successfully found all collections",
+		zap.Int("count", len(collections)),
+	)
+
+	return collections, nil
+}
+
+// コレクションの名前と説明を更新（掲載する記事は変更しない）
+func (r *mysqlCollectionRepository) Update(ctx context.Context, collection *entity.Collection) (*entity.Collection, error) {
+	if collection == nil {
+		logger.Error("Attempted to update nil collection")
+		return nil, domainerrors.InvalidArgumentError("collection", "collection cannot be nil")
+	}
+	if collection.ID <= 0 {
+		logger.Warn("Invalid collection ID for update",
+			zap.Int64("id", collection.ID),
+		)
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	query := `UPDATE collections SET name = ?, description = ?, updated_at = ? WHERE id = ?`
+
+	result, err := r.db.ExecContext(ctx, query, collection.Name, nullString(collection.Description), collection.UpdatedAt, collection.ID)
+	if err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
+			return nil, domainerrors.AlreadyExistsError("collection", collection.Name)
+		}
+		logger.Error("Failed to update collection",
+			zap.Error(err),
+			zap.Int64("id", collection.ID),
+		)
+		return nil, domainerrors.DatabaseError("update collection", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("Failed to get rows affected",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("get rows affected", err)
+	}
+	if rowsAffected == 0 {
+		logger.Debug("Collection not found for update",
+			zap.Int64("id", collection.ID),
+		)
+		return nil, domainerrors.NotFoundError("collection", collection.ID)
+	}
+
+	logger.Info("Successfully updated collection in database",
+		zap.Int64("id", collection.ID),
+	)
+
+	return r.FindByID(ctx, collection.ID)
+}
+
+// 指定されたIDのコレクションを行ロックして読み込み、modifyで変更した掲載する記事を保存する
+func (r *mysqlCollectionRepository) UpdateArticles(ctx context.Context, id int64, modify func(collection *entity.Collection) error) (*entity.Collection, error) {
+	if id <= 0 {
+		logger.Warn("Invalid collection ID for update",
+			zap.Int64("id", id),
+		)
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("Failed to begin transaction",
+			zap.Error(err),
+			zap.String("operation", "UpdateArticles"),
+		)
+		return nil, domainerrors.DatabaseError("begin transaction", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	collection, err := r.lockCollection(ctx, tx, id)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := modify(collection); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+	if err := r.saveCollectionArticles(ctx, tx, collection); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("Failed to commit transaction",
+			zap.Error(err),
+			zap.Int64("collection_id", id),
+		)
+		return nil, domainerrors.DatabaseError("commit transaction", err)
+	}
+
+	logger.Info("Successfully updated collection articles in database",
+		zap.Int64("id", id),
+		zap.Int("article_count", len(collection.ArticleIDs)),
+	)
+
+	return r.FindByID(ctx, id)
+}
+
+// 2つのコレクションを行ロックして読み込み、modifyで記事を移した結果を1つのトランザクションで保存
+func (r *mysqlCollectionRepository) Move(ctx context.Context, sourceID, targetID int64, modify func(source, target *entity.Collection) error) error {
+	if sourceID <= 0 || targetID <= 0 || sourceID == targetID {
+		logger.Warn("Invalid collection IDs for move",
+			zap.Int64("source_id", sourceID),
+			zap.Int64("target_id", targetID),
+		)
+		return domainerrors.InvalidArgumentError("id", "ids must be positive and different")
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("Failed to begin transaction",
+			zap.Error(err),
+			zap.String("operation", "Move"),
+		)
+		return domainerrors.DatabaseError("begin transaction", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	// 同時に逆向きの移動が行われてもデッドロックしないよう、IDの小さい順にロックする
+	locked := make(map[int64]*entity.Collection, 2)
+	for _, id := range []int64{min(sourceID, targetID), max(sourceID, targetID)} {
+		collection, err := r.lockCollection(ctx, tx, id)
+		if err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+		locked[id] = collection
+	}
+	source, target := locked[sourceID], locked[targetID]
+
+	if err := modify(source, target); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	// 移動元から先に外し、移動先の記事を入れる
+	for _, collection := range []*entity.Collection{source, target} {
+		if err := r.saveCollectionArticles(ctx, tx, collection); err != nil {
+			_ = tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("Failed to commit transaction",
+			zap.Error(err),
+			zap.Int64("source_id", sourceID),
+			zap.Int64("target_id", targetID),
+		)
+		return domainerrors.DatabaseError("commit transaction", err)
+	}
+
+	logger.Info("Successfully moved article between collections in database",
+		zap.Int64("source_id", sourceID),
+		zap.Int64("target_id", targetID),
+	)
+
+	return nil
+}
+
+// 指定されたIDのコレクションを削除（掲載する記事の関連付けは外部キーにより削除される）
+func (r *mysqlCollectionRepository) Delete(ctx context.Context, id int64) error {
+	if id <= 0 {
+		logger.Warn("Invalid collection ID for deletion",
+			zap.Int64("id", id),
+		)
+		return domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM collections WHERE id = ?`, id)
+	if err != nil {
+		logger.Error("Failed to delete collection",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return domainerrors.DatabaseError("delete collection", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("Failed to get rows affected",
+			zap.Error(err),
+		)
+		return domainerrors.DatabaseError("get rows affected", err)
+	}
+	if rowsAffected == 0 {
+		logger.Debug("Collection not found for deletion",
+			zap.Int64("id", id),
+		)
+		return domainerrors.NotFoundError("collection", id)
+	}
+
+	logger.Info("Successfully deleted collection from database",
+		zap.Int64("id", id),
+	)
+
+	return nil
+}
+
+// トランザクション内でコレクションと掲載する記事を行ロックして取得
+func (r *mysqlCollectionRepository) lockCollection(ctx context.Context, tx *sqlx.Tx, id int64) (*entity.Collection, error) {
+	query := collectionSelect + `
+		WHERE c.id = ?
+		ORDER BY ca.position ASC
+		FOR UPDATE
+	`
+
+	var rows []collectionWithArticleRow
+	if err := tx.SelectContext(ctx, &rows, query, id); err != nil {
+		logger.Error("Failed to lock collection",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return nil, domainerrors.DatabaseError("lock collection", err)
+	}
+
+	collections := collectionsFromRows(rows)
+	if len(collections) == 0 {
+		logger.Debug("Collection not found for update",
+			zap.Int64("id", id),
+		)
+		return nil, domainerrors.NotFoundError("collection", id)
+	}
+
+	return collections[0], nil
+}
+
+// トランザクション内でコレクションの掲載する記事を掲載順ごと置き換える
+func (r *mysqlCollectionRepository) saveCollectionArticles(ctx context.Context, tx *sqlx.Tx, collection *entity.Collection) error {
+	if _, err := tx.ExecContext(ctx, `UPDATE collections SET updated_at = ? WHERE id = ?`, collection.UpdatedAt, collection.ID); err != nil {
+		logger.Error("Failed to update collection",
+			zap.Error(err),
+			zap.Int64("id", collection.ID),
+		)
+		return domainerrors.DatabaseError("update collection", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM collection_articles WHERE collection_id = ?`, collection.ID); err != nil {
+		logger.Error("Failed to delete collection articles",
+			zap.Error(err),
+			zap.Int64("id", collection.ID),
+		)
+		return domainerrors.DatabaseError("delete collection articles", err)
+	}
+	return r.insertCollectionArticles(ctx, tx, collection.ID, collection.ArticleIDs)
+}
+
+// コレクションに掲載する記事を掲載順（1始まり）で保存
+func (r *mysqlCollectionRepository) insertCollectionArticles(ctx context.Context, tx *sqlx.Tx, collectionID int64, articleIDs []int64) error {
+	for i, articleID := range articleIDs {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO collection_articles (collection_id, article_id, position) VALUES (?, ?, ?)`,
+			collectionID, articleID, i+1,
+		)
+		if err != nil {
+			// 外部キー制約違反（存在しない記事）
+			if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1452 {
+				return domainerrors.NotFoundError("article", articleID)
+			}
+			logger.Error("Failed to insert collection article",
+				zap.Error(err),
+				zap.Int64("collection_id", collectionID),
+				zap.Int64("article_id", articleID),
+			)
+			return domainerrors.DatabaseError("insert collection article", err)
+		}
+	}
+	return nil
+}
+
+// コレクションと掲載する記事を結合した行をコレクションごとにまとめる（行の順序を維持する）
+func collectionsFromRows(rows []collectionWithArticleRow) []*entity.Collection {
+	collectionMap := make(map[int64]*entity.Collection)
+	var collectionOrder []int64
+
+	for _, row := range rows {
+		collection, exists := collectionMap[row.ID]
+		if !exists {
+			collection = &entity.Collection{
+				ID:          row.ID,
+				Name:        row.Name,
+				Description: row.Description.String,
+				ArticleIDs:  []int64{},
+				CreatedAt:   row.CreatedAt.Time,
+				UpdatedAt:   row.UpdatedAt.Time,
+			}
+			collectionMap[row.ID] = collection
+			collectionOrder = append(collectionOrder, row.ID)
+		}
+
+		if row.ArticleID.Valid {
+			collection.ArticleIDs = append(collection.ArticleIDs, row.ArticleID.Int64)
+		}
+	}
+
+	collections := make([]*entity.Collection, 0, len(collectionOrder))
+	for _, id := range collectionOrder {
+		collections = append(collections, collectionMap[id])
+	}
+	return collections
+}
@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/infrastructure/logger"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// digestsテーブルとのマッピング
+type digestRow struct {
+	ID           int64     `db:"id"`
+	PeriodStart  time.Time `db:"period_start"`
+	PeriodEnd    time.Time `db:"period_end"`
+	ArticleCount int       `db:"article_count"`
+	GroupsJSON   string    `db:"groups_json"`
+	Markdown     string    `db:"markdown"`
+	HTML         string    `db:"html"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+// groups_jsonカラムに保存するグループ
+type digestGroupPayload struct {
+	Tag      string                 `json:"tag"`
+	Overview string                 `json:"overview"`
+	Articles []digestArticlePayload `json:"articles"`
+}
+
+type digestArticlePayload struct {
+	ArticleID int64  `json:"article_id"`
+	Title     string `json:"title"`
+	URL       string `json:"url"`
+}
+
+const digestColumns = `id, period_start, period_end, article_count, groups_json, markdown, html, created_at`
+
+// DigestRepositoryのMySQL実装
+type mysqlDigestRepository struct {
+	db *sqlx.DB
+}
+
+// コンストラクタ
+func NewMySQLDigestRepository(db *sqlx.DB) repository.DigestRepository {
+	return &mysqlDigestRepository{db: db}
+}
+
+// ダイジェストを保存
+func (r *mysqlDigestRepository) Create(ctx context.Context, digest *entity.Digest) (*entity.Digest, error) {
+	if digest == nil {
+		logger.Error("Attempted to create nil digest")
+		return nil, domainerrors.InvalidArgumentError("digest", "digest cannot be nil")
+	}
+
+	groupsJSON, err := json.Marshal(toDigestGroupPayloads(digest.Groups))
+	if err != nil {
+		return nil, domainerrors.InternalError("failed to encode digest groups", err)
+	}
+
+	query := `
+		INSERT INTO digests (period_start, period_end, article_count, groups_json, markdown, html, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := r.db.ExecContext(ctx, query,
+		digest.PeriodStart,
+		digest.PeriodEnd,
+		digest.ArticleCount,
+		string(groupsJSON),
+		digest.Markdown,
+		digest.HTML,
+		digest.CreatedAt,
+	)
+	if err != nil {
+		var mysqlErr *mysql.MySQLError
+		if errors.As(err, &mysqlErr) && mysqlErr.Number == 1062 {
+			logger.Debug("Digest for the period already exists",
+				zap.Time("period_start", digest.PeriodStart),
+				zap.Time("period_end", digest.PeriodEnd),
+			)
+			return nil, domainerrors.AlreadyExistsError("digest", digest.PeriodStart.Format(time.RFC3339))
+		}
+		logger.Error("Failed to insert digest",
+			zap.Error(err),
+			zap.Time("period_start", digest.PeriodStart),
+		)
+		return nil, domainerrors.DatabaseError("insert digest", err)
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		logger.Error("Failed to get last insert ID for digest",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("get last insert id", err)
+	}
+
+	created := *digest
+	created.ID = id
+	return &created, nil
+}
+
+// 指定されたIDのダイジェストを取得
+func (r *mysqlDigestRepository) FindByID(ctx context.Context, id int64) (*entity.Digest, error) {
+	if id <= 0 {
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	var row digestRow
+	err := r.db.GetContext(ctx, &row, `SELECT `+digestColumns+` FROM digests WHERE id = ?`, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domainerrors.NotFoundError("digest", id)
+		}
+		logger.Error("Failed to find digest",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return nil, domainerrors.DatabaseError("find digest", err)
+	}
+
+	return digestRowToEntity(&row)
+}
+
+// すべてのダイジェストを新しい期間順に取得
+func (r *mysqlDigestRepository) FindAll(ctx context.Context) ([]*entity.Digest, error) {
+	var rows []digestRow
+	err := r.db.SelectContext(ctx, &rows, `SELECT `+digestColumns+` FROM digests ORDER BY period_end DESC, id DESC`)
+	if err != nil {
+		logger.Error("Failed to find all digests",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("find all digests", err)
+	}
+
+	digests := make([]*entity.Digest, 0, len(rows))
+	for i := range rows {
+		digest, err := digestRowToEntity(&rows[i])
+		if err != nil {
+			return nil, err
+		}
+		digests = append(digests, digest)
+	}
+	return digests, nil
+}
+
+// 最も新しい期間のダイジェストを取得
+func (r *mysqlDigestRepository) FindLatest(ctx context.Context) (*entity.Digest, error) {
+	var row digestRow
+	err := r.db.GetContext(ctx, &row, `SELECT `+digestColumns+` FROM digests ORDER BY period_end DESC, id DESC LIMIT 1`)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		logger.Error("Failed to find latest digest",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("find latest digest", err)
+	}
+
+	return digestRowToEntity(&row)
+}
+
+func toDigestGroupPayloads(groups []entity.DigestGroup) []digestGroupPayload {
+	payloads := make([]digestGroupPayload, 0, len(groups))
+	for _, group := range groups {
+		articles := make([]digestArticlePayload, 0, len(group.Articles))
+		for _, article := range group.Articles {
+			articles = append(articles, digestArticlePayload{
+				ArticleID: article.ArticleID,
+				Title:     article.Title,
+				URL:       article.URL,
+			})
+		}
+		payloads = append(payloads, digestGroupPayload{
+			Tag:      group.Tag,
+			Overview: group.Overview,
+			Articles: articles,
+		})
+	}
+	return payloads
+}
+
+// digestRowをentity.Digestに変換
+func digestRowToEntity(row *digestRow) (*entity.Digest, error) {
+	var payloads []digestGroupPayload
+	if err := json.Unmarshal([]byte(row.GroupsJSON), &payloads); err != nil {
+		logger.Error("Failed to decode digest groups",
+			zap.Error(err),
+			zap.Int64("id", row.ID),
+		)
+		return nil, domainerrors.InternalError("failed to decode digest groups", err)
+	}
+
+	groups := make([]entity.DigestGroup, 0, len(payloads))
+	for _, payload := range payloads {
+		articles := make([]entity.DigestArticle, 0, len(payload.Articles))
+		for _, article := range payload.Articles {
+			articles = append(articles, entity.DigestArticle{
+				ArticleID: article.ArticleID,
+				Title:     article.Title,
+				URL:       article.URL,
+			})
+		}
+		groups = append(groups, entity.DigestGroup{
+			Tag:      payload.Tag,
+			Overview: payload.Overview,
+			Articles: articles,
+		})
+	}
+
+	return &entity.Digest{
+		ID:           row.ID,
+		PeriodStart:  row.PeriodStart,
+		PeriodEnd:    row.PeriodEnd,
+		ArticleCount: row.ArticleCount,
+		Groups:       groups,
+		Markdown:     row.Markdown,
+		HTML:         row.HTML,
+		CreatedAt:    row.CreatedAt,
+	}, nil
+}
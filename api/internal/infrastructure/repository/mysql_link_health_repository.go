@@ -0,0 +1,188 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/infrastructure/logger"
+
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// article_link_healthテーブルとのマッピング
+type linkHealthRow struct {
+	ArticleID         int64          `db:"article_id"`
+	CheckedURL        string         `db:"checked_url"`
+	StatusCode        int            `db:"status_code"`
+	FinalURL          string         `db:"final_url"`
+	LinkStatus        string         `db:"link_status"`
+	PermanentRedirect bool           `db:"permanent_redirect"`
+	ErrorMessage      sql.NullString `db:"error_message"`
+	CheckedAt         sql.NullTime   `db:"checked_at"`
+}
+
+// LinkHealthRepositoryのMySQL実装
+type mysqlLinkHealthRepository struct {
+	db *sqlx.DB
+}
+
+// コンストラクタ
+func NewMySQLLinkHealthRepository(db *sqlx.DB) repository.LinkHealthRepository {
+	return &mysqlLinkHealthRepository{db: db}
+}
+
+// リンクヘルスを保存
+func (r *mysqlLinkHealthRepository) Save(ctx context.Context, health *entity.LinkHealth) (*entity.LinkHealth, error) {
+	if health == nil {
+		logger.Error("Attempted to save nil link health")
+		return nil, domainerrors.InvalidArgumentError("link_health", "link health cannot be nil")
+	}
+
+	logger.Debug("Saving link health",
+		zap.Int64("article_id", health.ArticleID),
+		zap.String("status", string(health.Status)),
+		zap.Int("status_code", health.StatusCode),
+	)
+
+	var errorMessage sql.NullString
+	if health.ErrorMessage != "" {
+		errorMessage = sql.NullString{String: health.ErrorMessage, Valid: true}
+	}
+
+	query := `
+		INSERT INTO article_link_health
+			(article_id, checked_url, status_code, final_url, link_status, permanent_redirect, error_message, checked_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			checked_url = VALUES(checked_url),
+			status_code = VALUES(status_code),
+			final_url = VALUES(final_url),
+			link_status = VALUES(link_status),
+			permanent_redirect = VALUES(permanent_redirect),
+			error_message = VALUES(error_message),
+			checked_at = VALUES(checked_at)
+	`
+
+	_, err := r.db.ExecContext(ctx, query,
+		health.ArticleID,
+		health.CheckedURL,
+		health.StatusCode,
+		health.FinalURL,
+		string(health.Status),
+		health.PermanentRedirect,
+		errorMessage,
+		health.CheckedAt,
+	)
+	if err != nil {
+		logger.Error("Failed to save link health",
+			zap.Error(err),
+			zap.Int64("article_id", health.ArticleID),
+		)
+		return nil, domainerrors.DatabaseError("save link health", err)
+	}
+
+	return health, nil
+}
+
+// 指定された記事IDのリンクヘルスを取得
+func (r *mysqlLinkHealthRepository) FindByArticleID(ctx context.Context, articleID int64) (*entity.LinkHealth, error) {
+	if articleID <= 0 {
+		logger.Warn("Invalid article ID",
+			zap.Int64("article_id", articleID),
+		)
+		return nil, domainerrors.InvalidArgumentError("article_id", "article_id must be positive")
+	}
+
+	query := `
+		SELECT article_id, checked_url, status_code, final_url, link_status, permanent_redirect, error_message, checked_at
+		FROM article_link_health
+		WHERE article_id = ?
+	`
+
+	var row linkHealthRow
+	err := r.db.GetContext(ctx, &row, query, articleID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			logger.Debug("Link health not found",
+				zap.Int64("article_id", articleID),
+			)
+			return nil, domainerrors.NotFoundError("link_health", articleID)
+		}
+		logger.Error("Failed to find link health",
+			zap.Error(err),
+			zap.Int64("article_id", articleID),
+		)
+		return nil, domainerrors.DatabaseError("find link health", err)
+	}
+
+	return linkHealthRowToEntity(&row), nil
+}
+
+// すべてのリンクヘルスを取得
+func (r *mysqlLinkHealthRepository) FindAll(ctx context.Context) ([]*entity.LinkHealth, error) {
+	query := `
+		SELECT article_id, checked_url, status_code, final_url, link_status, permanent_redirect, error_message, checked_at
+		FROM article_link_health
+		ORDER BY checked_at DESC
+	`
+
+	return r.selectLinkHealth(ctx, "find all link health", query)
+}
+
+// 指定された状態のリンクヘルスを取得
+func (r *mysqlLinkHealthRepository) FindByStatus(ctx context.Context, status entity.LinkStatus) ([]*entity.LinkHealth, error) {
+	query := `
+		SELECT article_id, checked_url, status_code, final_url, link_status, permanent_redirect, error_message, checked_at
+		FROM article_link_health
+		WHERE link_status = ?
+		ORDER BY checked_at DESC
+	`
+
+	return r.selectLinkHealth(ctx, "find link health by status", query, string(status))
+}
+
+func (r *mysqlLinkHealthRepository) selectLinkHealth(ctx context.Context, operation string, query string, args ...interface{}) ([]*entity.LinkHealth, error) {
+	var rows []linkHealthRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		logger.Error("Failed to select link health",
+			zap.Error(err),
+			zap.String("operation", operation),
+		)
+		return nil, domainerrors.DatabaseError(operation, err)
+	}
+
+	results := make([]*entity.LinkHealth, 0, len(rows))
+	for i := range rows {
+		results = append(results, linkHealthRowToEntity(&rows[i]))
+	}
+
+	logger.Debug("Successfully selected link health",
+		zap.String("operation", operation),
+		zap.Int("count", len(results)),
+	)
+
+	return results, nil
+}
+
+// linkHealthRowをentity.LinkHealthに変換
+func linkHealthRowToEntity(row *linkHealthRow) *entity.LinkHealth {
+	errorMessage := ""
+	if row.ErrorMessage.Valid {
+		errorMessage = row.ErrorMessage.String
+	}
+
+	return &entity.LinkHealth{
+		ArticleID:         row.ArticleID,
+		CheckedURL:        row.CheckedURL,
+		StatusCode:        row.StatusCode,
+		FinalURL:          row.FinalURL,
+		Status:            entity.LinkStatus(row.LinkStatus),
+		PermanentRedirect: row.PermanentRedirect,
+		ErrorMessage:      errorMessage,
+		CheckedAt:         row.CheckedAt.Time,
+	}
+}
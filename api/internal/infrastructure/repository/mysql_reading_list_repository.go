@@ -0,0 +1,385 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/infrastructure/logger"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jmoiron/sqlx"
+	"go.uber.org/zap"
+)
+
+// reading_list_itemsテーブルと関連する記事を結合した行とのマッピング
+type readingListItemWithArticleRow struct {
+	ID            int64          `db:"id"`
+	BookKey       string         `db:"book_key"`
+	Title         string         `db:"title"`
+	Author        string         `db:"author"`
+	ISBN13        sql.NullString `db:"isbn13"`
+	CoverImageURL string         `db:"cover_image_url"`
+	Status        string         `db:"status"`
+	Notes         sql.NullString `db:"notes"`
+	Rating        int            `db:"rating"`
+	CreatedAt     sql.NullTime   `db:"created_at"`
+	UpdatedAt     sql.NullTime   `db:"updated_at"`
+	ArticleID     sql.NullInt64  `db:"article_id"`
+}
+
+const readingListItemSelect = `
+		SELECT
+			i.id, i.book_key, i.title, i.author, i.isbn13, i.cover_image_url,
+			i.status, i.notes, i.rating, i.created_at, i.updated_at,
+			ria.article_id
+		FROM reading_list_items i
+		LEFT JOIN reading_list_item_articles ria ON i.id = ria.item_id
+`
+
+// ReadingListRepositoryのMySQL実装
+type mysqlReadingListRepository struct {
+	db *sqlx.DB
+}
+
+// コンストラクタ
+func NewMySQLReadingListRepository(db *sqlx.DB) repository.ReadingListRepository {
+	return &mysqlReadingListRepository{db: db}
+}
+
+// 新しい書籍を保存
+func (r *mysqlReadingListRepository) Create(ctx context.Context, item *entity.ReadingListItem) (*entity.ReadingListItem, error) {
+	if item == nil {
+		logger.Error("Attempted to create nil reading list item")
+		return nil, domainerrors.InvalidArgumentError("item", "item cannot be nil")
+	}
+
+	logger.Debug("Creating reading list item in database",
+		zap.String("key", item.Key),
+		zap.String("title", item.Title),
+	)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("Failed to begin transaction",
+			zap.Error(err),
+			zap.String("operation", "Create"),
+		)
+		return nil, domainerrors.DatabaseError("begin transaction", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	query := `
+		INSERT INTO reading_list_items (
+			book_key, title, author, isbn13, cover_image_url, status, notes, rating, created_at, updated_at
+		) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`
+
+	result, err := tx.ExecContext(ctx, query,
+		item.Key, item.Title, item.Author, nullString(item.ISBN13), item.CoverImageURL,
+		string(item.Status), nullString(item.Notes), item.Rating, item.CreatedAt, item.UpdatedAt,
+	)
+	if err != nil {
+		_ = tx.Rollback()
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1062 {
+			logger.Debug("Reading list item already exists",
+				zap.String("key", item.Key),
+			)
+			return nil, domainerrors.AlreadyExistsError("reading_list_item", item.Key)
+		}
+		logger.Error("Failed to insert reading list item",
+			zap.Error(err),
+			zap.String("key", item.Key),
+		)
+		return nil, domainerrors.DatabaseError("insert reading list item", err)
+	}
+
+	itemID, err := result.LastInsertId()
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Error("Failed to get last insert ID",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("get last insert id", err)
+	}
+
+	if err := r.insertItemArticles(ctx, tx, itemID, item.ArticleIDs); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("Failed to commit transaction",
+			zap.Error(err),
+			zap.Int64("item_id", itemID),
+		)
+		return nil, domainerrors.DatabaseError("commit transaction", err)
+	}
+
+	logger.Info("Successfully created reading list item in database",
+		zap.Int64("id", itemID),
+		zap.String("key", item.Key),
+	)
+
+	return r.FindByID(ctx, itemID)
+}
+
+// 指定されたIDの書籍を取得
+func (r *mysqlReadingListRepository) FindByID(ctx context.Context, id int64) (*entity.ReadingListItem, error) {
+	if id <= 0 {
+		logger.Warn("Invalid reading list item ID",
+			zap.Int64("id", id),
+		)
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	query := readingListItemSelect + `
+		WHERE i.id = ?
+		ORDER BY ria.article_id ASC
+	`
+
+	var rows []readingListItemWithArticleRow
+	if err := r.db.SelectContext(ctx, &rows, query, id); err != nil {
+		logger.Error("Failed to find reading list item",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return nil, domainerrors.DatabaseError("find reading list item", err)
+	}
+
+	items := readingListItemsFromRows(rows)
+	if len(items) == 0 {
+		logger.Debug("Reading list item not found",
+			zap.Int64("id", id),
+		)
+		return nil, domainerrors.NotFoundError("reading_list_item", id)
+	}
+
+	return items[0], nil
+}
+
+// 書籍を追加の新しい順に取得
+func (r *mysqlReadingListRepository) FindAll(ctx context.Context, status entity.ReadingStatus) ([]*entity.ReadingListItem, error) {
+	logger.Debug("Finding reading list items",
+		zap.String("status", string(status)),
+	)
+
+	query := readingListItemSelect
+	args := []any{}
+	if status != "" {
+		query += `
+		WHERE i.status = ?`
+		args = append(args, string(status))
+	}
+	query += `
+		ORDER BY i.created_at DESC, i.id DESC, ria.article_id ASC
+	`
+
+	var rows []readingListItemWithArticleRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		logger.Error("Failed to find reading list items",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("find reading list items", err)
+	}
+
+	items := readingListItemsFromRows(rows)
+
+	logger.Debug("Successfully found reading list items",
+		zap.Int("count", len(items)),
+	)
+
+	return items, nil
+}
+
+// 書籍を更新（関連する記事は置き換える）
+func (r *mysqlReadingListRepository) Update(ctx context.Context, item *entity.ReadingListItem) (*entity.ReadingListItem, error) {
+	if item == nil {
+		logger.Error("Attempted to update nil reading list item")
+		return nil, domainerrors.InvalidArgumentError("item", "item cannot be nil")
+	}
+	if item.ID <= 0 {
+		logger.Warn("Invalid reading list item ID for update",
+			zap.Int64("id", item.ID),
+		)
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("Failed to begin transaction",
+			zap.Error(err),
+			zap.String("operation", "Update"),
+		)
+		return nil, domainerrors.DatabaseError("begin transaction", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	query := `UPDATE reading_list_items SET status = ?, notes = ?, rating = ?, updated_at = ? WHERE id = ?`
+
+	result, err := tx.ExecContext(ctx, query, string(item.Status), nullString(item.Notes), item.Rating, item.UpdatedAt, item.ID)
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Error("Failed to update reading list item",
+			zap.Error(err),
+			zap.Int64("id", item.ID),
+		)
+		return nil, domainerrors.DatabaseError("update reading list item", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Error("Failed to get rows affected",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("get rows affected", err)
+	}
+	if rowsAffected == 0 {
+		_ = tx.Rollback()
+		logger.Debug("Reading list item not found for update",
+			zap.Int64("id", item.ID),
+		)
+		return nil, domainerrors.NotFoundError("reading_list_item", item.ID)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM reading_list_item_articles WHERE item_id = ?`, item.ID); err != nil {
+		_ = tx.Rollback()
+		logger.Error("Failed to delete reading list item articles",
+			zap.Error(err),
+			zap.Int64("id", item.ID),
+		)
+		return nil, domainerrors.DatabaseError("delete reading list item articles", err)
+	}
+	if err := r.insertItemArticles(ctx, tx, item.ID, item.ArticleIDs); err != nil {
+		_ = tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("Failed to commit transaction",
+			zap.Error(err),
+			zap.Int64("item_id", item.ID),
+		)
+		return nil, domainerrors.DatabaseError("commit transaction", err)
+	}
+
+	logger.Info("Successfully updated reading list item in database",
+		zap.Int64("id", item.ID),
+		zap.String("status", string(item.Status)),
+	)
+
+	return r.FindByID(ctx, item.ID)
+}
+
+// 指定されたIDの書籍を削除（関連する記事は外部キーにより削除される）
+func (r *mysqlReadingListRepository) Delete(ctx context.Context, id int64) error {
+	if id <= 0 {
+		logger.Warn("Invalid reading list item ID for deletion",
+			zap.Int64("id", id),
+		)
+		return domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	result, err := r.db.ExecContext(ctx, `DELETE FROM reading_list_items WHERE id = ?`, id)
+	if err != nil {
+		logger.Error("Failed to delete reading list item",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return domainerrors.DatabaseError("delete reading list item", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("Failed to get rows affected",
+			zap.Error(err),
+		)
+		return domainerrors.DatabaseError("get rows affected", err)
+	}
+	if rowsAffected == 0 {
+		logger.Debug("Reading list item not found for deletion",
+			zap.Int64("id", id),
+		)
+		return domainerrors.NotFoundError("reading_list_item", id)
+	}
+
+	logger.Info("Successfully deleted reading list item from database",
+		zap.Int64("id", id),
+	)
+
+	return nil
+}
+
+// 書籍と関連する記事の関連付けを保存
+func (r *mysqlReadingListRepository) insertItemArticles(ctx context.Context, tx *sqlx.Tx, itemID int64, articleIDs []int64) error {
+	for _, articleID := range articleIDs {
+		_, err := tx.ExecContext(ctx,
+			`INSERT INTO reading_list_item_articles (item_id, article_id) VALUES (?, ?)`,
+			itemID, articleID,
+		)
+		if err != nil {
+			// 外部キー制約違反（存在しない記事）
+			if mysqlErr, ok := err.(*mysql.MySQLError); ok && mysqlErr.Number == 1452 {
+				return domainerrors.NotFoundError("article", articleID)
+			}
+			logger.Error("Failed to insert reading list item article",
+				zap.Error(err),
+				zap.Int64("item_id", itemID),
+				zap.Int64("article_id", articleID),
+			)
+			return domainerrors.DatabaseError("insert reading list item article", err)
+		}
+	}
+	return nil
+}
+
+// 書籍と関連する記事を結合した行を書籍ごとにまとめる（行の順序を維持する）
+func readingListItemsFromRows(rows []readingListItemWithArticleRow) []*entity.ReadingListItem {
+	itemMap := make(map[int64]*entity.ReadingListItem)
+	var itemOrder []int64
+
+	for _, row := range rows {
+		item, exists := itemMap[row.ID]
+		if !exists {
+			item = &entity.ReadingListItem{
+				ID:            row.ID,
+				Key:           row.BookKey,
+				Title:         row.Title,
+				Author:        row.Author,
+				ISBN13:        row.ISBN13.String,
+				CoverImageURL: row.CoverImageURL,
+				Status:        entity.ReadingStatus(row.Status),
+				Notes:         row.Notes.String,
+				Rating:        row.Rating,
+				ArticleIDs:    []int64{},
+				CreatedAt:     row.CreatedAt.Time,
+				UpdatedAt:     row.UpdatedAt.Time,
+			}
+			itemMap[row.ID] = item
+			itemOrder = append(itemOrder, row.ID)
+		}
+
+		if row.ArticleID.Valid {
+			item.ArticleIDs = append(item.ArticleIDs, row.ArticleID.Int64)
+		}
+	}
+
+	items := make([]*entity.ReadingListItem, 0, len(itemOrder))
+	for _, id := range itemOrder {
+		items = append(items, itemMap[id])
+	}
+	return items
+}
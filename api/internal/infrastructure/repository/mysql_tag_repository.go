@@ -17,20 +17,44 @@ import (
 
 // tagsテーブルとのマッピング
 type tagRow struct {
-	ID        int64        `db:"id"`
-	Name      string       `db:"name"`
+	ID          int64          `db:"id"`
+	Name        string         `db:"name"`
+	ParentID    sql.NullInt64  `db:"parent_id"`
+	Color       sql.NullString `db:"color"`
+	Description sql.NullString `db:"description"`
+	CreatedAt   sql.NullTime   `db:"created_at"`
+	UpdatedAt   sql.NullTime   `db:"updated_at"`
+}
+
+// tag_aliasesテーブルとのマッピング
+type tagAliasRow struct {
+	Alias     string       `db:"alias"`
+	TagID     int64        `db:"tag_id"`
 	CreatedAt sql.NullTime `db:"created_at"`
-	UpdatedAt sql.NullTime `db:"updated_at"`
+}
+
+// タグごとの使用状況の集計結果
+type tagUsageRow struct {
+	TagID        int64        `db:"tag_id"`
+	ArticleCount int          `db:"article_count"`
+	LastUsedAt   sql.NullTime `db:"last_used_at"`
+}
+
+// 月ごとの記事数の集計結果
+type tagMonthlyCountRow struct {
+	Month        string `db:"month"`
+	ArticleCount int    `db:"article_count"`
 }
 
 // TagRepositoryのMySQL実装
 type mysqlTagRepository struct {
-	db *sqlx.DB
+	db     *sqlx.DB
+	policy entity.TagNamePolicy
 }
 
-// コンストラクタ
-func NewMySQLTagRepository(db *sqlx.DB) repository.TagRepository {
-	return &mysqlTagRepository{db: db}
+// コンストラクタ（タグ名の一意性と検索はpolicyによる正規化キーで判定する）
+func NewMySQLTagRepository(db *sqlx.DB, policy entity.TagNamePolicy) repository.TagRepository {
+	return &mysqlTagRepository{db: db, policy: policy}
 }
 
 // 新しいタグを保存
@@ -44,9 +68,14 @@ func (r *mysqlTagRepository) Create(ctx context.Context, tag *entity.Tag) (*enti
 		zap.String("name", tag.Name),
 	)
 
-	query := `INSERT INTO tags (name, created_at, updated_at) VALUES (?, ?, ?)`
+	key := r.policy.Key(tag.Name)
+	if err := r.ensureNotAlias(ctx, key); err != nil {
+		return nil, err
+	}
+
+	query := `INSERT INTO tags (name, normalized_key, parent_id, color, description, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?)`
 
-	result, err := r.db.ExecContext(ctx, query, tag.Name, tag.CreatedAt, tag.UpdatedAt)
+	result, err := r.db.ExecContext(ctx, query, tag.Name, key, nullTagParentID(tag.ParentID), nullString(tag.Color), nullString(tag.Description), tag.CreatedAt, tag.UpdatedAt)
 	if err != nil {
 		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
 			if mysqlErr.Number == 1062 {
@@ -92,7 +121,7 @@ func (r *mysqlTagRepository) FindByID(ctx context.Context, id int64) (*entity.Ta
 		zap.Int64("id", id),
 	)
 
-	query := `SELECT id, name, created_at, updated_at FROM tags WHERE id = ?`
+	query := `SELECT id, name, parent_id, color, description, created_at, updated_at FROM tags WHERE id = ?`
 
 	var row tagRow
 	err := r.db.GetContext(ctx, &row, query, id)
@@ -131,10 +160,22 @@ func (r *mysqlTagRepository) FindByName(ctx context.Context, name string) (*enti
 		zap.String("name", name),
 	)
 
-	query := `SELECT id, name, created_at, updated_at FROM tags WHERE name = ?`
-
+	// 正規化キーで検索し、別名の場合は代表のタグを返す（タグ名と別名は重複しない）
+	// 正規化キーが衝突して未設定のタグは名前の完全一致で検索する
+	query := `
+		SELECT id, name, parent_id, color, description, created_at, updated_at FROM tags
+		WHERE normalized_key = ? OR (normalized_key IS NULL AND name = ?)
+		UNION ALL
+		SELECT t.id, t.name, t.parent_id, t.color, t.description, t.created_at, t.updated_at
+		FROM tags t
+		INNER JOIN tag_aliases a ON a.tag_id = t.id
+		WHERE a.normalized_key = ?
+		LIMIT 1
+	`
+
+	key := r.policy.Key(name)
 	var row tagRow
-	err := r.db.GetContext(ctx, &row, query, name)
+	err := r.db.GetContext(ctx, &row, query, key, name, key)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			logger.Debug("Tag not found",
@@ -161,7 +202,7 @@ func (r *mysqlTagRepository) FindByName(ctx context.Context, name string) (*enti
 func (r *mysqlTagRepository) FindAll(ctx context.Context) ([]*entity.Tag, error) {
 	logger.Debug("Finding all tags")
 
-	query := `SELECT id, name, created_at, updated_at FROM tags ORDER BY name ASC`
+	query := `SELECT id, name, parent_id, color, description, created_at, updated_at FROM tags ORDER BY name ASC`
 
 	var rows []tagRow
 	err := r.db.SelectContext(ctx, &rows, query)
@@ -188,6 +229,47 @@ func (r *mysqlTagRepository) FindAll(ctx context.Context) ([]*entity.Tag, error)
 	return tags, nil
 }
 
+// 名前が前方一致するタグを名前順に最大limit件取得（idx_tags_nameを使う）
+func (r *mysqlTagRepository) FindByNamePrefix(ctx context.Context, prefix string, limit int) ([]*entity.Tag, error) {
+	logger.Debug("Finding tags by name prefix",
+		zap.String("prefix", prefix),
+		zap.Int("limit", limit),
+	)
+
+	query := `
+		SELECT id, name, parent_id, color, description, created_at, updated_at
+		FROM tags
+		WHERE name LIKE ?
+		ORDER BY name ASC
+		LIMIT ?
+	`
+
+	var rows []tagRow
+	if err := r.db.SelectContext(ctx, &rows, query, likePrefixPattern(prefix), limit); err != nil {
+		logger.Error("Failed to find tags by name prefix",
+			zap.Error(err),
+			zap.String("prefix", prefix),
+		)
+		return nil, domainerrors.DatabaseError("find tags by name prefix", err)
+	}
+
+	tags := make([]*entity.Tag, 0, len(rows))
+	for _, row := range rows {
+		tag, err := tagRowToEntity(&row)
+		if err != nil {
+			return nil, err
+		}
+		tags = append(tags, tag)
+	}
+
+	return tags, nil
+}
+
+// LIKEの特殊文字をエスケープした前方一致のパターン
+func likePrefixPattern(prefix string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(prefix) + "%"
+}
+
 // タグを更新
 func (r *mysqlTagRepository) Update(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
 	if tag == nil {
@@ -206,9 +288,14 @@ func (r *mysqlTagRepository) Update(ctx context.Context, tag *entity.Tag) (*enti
 		zap.String("name", tag.Name),
 	)
 
-	query := `UPDATE tags SET name = ?, updated_at = ? WHERE id = ?`
+	key := r.policy.Key(tag.Name)
+	if err := r.ensureNotAlias(ctx, key); err != nil {
+		return nil, err
+	}
+
+	query := `UPDATE tags SET name = ?, normalized_key = ?, parent_id = ?, color = ?, description = ?, updated_at = ? WHERE id = ?`
 
-	result, err := r.db.ExecContext(ctx, query, tag.Name, tag.UpdatedAt, tag.ID)
+	result, err := r.db.ExecContext(ctx, query, tag.Name, key, nullTagParentID(tag.ParentID), nullString(tag.Color), nullString(tag.Description), tag.UpdatedAt, tag.ID)
 	if err != nil {
 		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
 			if mysqlErr.Number == 1062 {
@@ -286,6 +373,7 @@ func (r *mysqlTagRepository) Delete(ctx context.Context, id int64) error {
 		return domainerrors.DatabaseError("delete article tags", err)
 	}
 
+	// 子タグは外部キー制約により親なしとなる
 	deleteTagQuery := `DELETE FROM tags WHERE id = ?`
 	result, err := tx.ExecContext(ctx, deleteTagQuery, id)
 	if err != nil {
@@ -328,14 +416,422 @@ func (r *mysqlTagRepository) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
+// 統合元のタグを統合先のタグに統合
+func (r *mysqlTagRepository) Merge(ctx context.Context, targetID int64, sourceIDs []int64) (*entity.Tag, error) {
+	if targetID <= 0 {
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+	if len(sourceIDs) == 0 {
+		return nil, domainerrors.InvalidArgumentError("source_ids", "source ids are required")
+	}
+
+	logger.Debug("Merging tags in database",
+		zap.Int64("target_id", targetID),
+		zap.Int64s("source_ids", sourceIDs),
+	)
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("Failed to begin transaction",
+			zap.Error(err),
+			zap.String("operation", "Merge"),
+		)
+		return nil, domainerrors.DatabaseError("begin transaction", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	statements := []struct {
+		operation string
+		query     string
+		args      []interface{}
+	}{
+		// 記事の関連付けを移す（複合主キーで重複する関連付けは無視する）
+		{"move article tags", `INSERT IGNORE INTO article_tags (article_id, tag_id) SELECT article_id, ? FROM article_tags WHERE tag_id IN (?)`, []interface{}{targetID, sourceIDs}},
+		{"delete source article tags", `DELETE FROM article_tags WHERE tag_id IN (?)`, []interface{}{sourceIDs}},
+		// 子タグを移す（統合先が統合元の子の場合、統合先の親は外部キー制約によりなしとなる）
+		{"move child tags", `UPDATE tags SET parent_id = ? WHERE parent_id IN (?) AND id <> ?`, []interface{}{targetID, sourceIDs, targetID}},
+		// 別名を移し、統合元の名前を別名として残す（正規化キーが衝突していたタグの名前は統合先と同じキーのため残さない）
+		{"move tag aliases", `UPDATE tag_aliases SET tag_id = ? WHERE tag_id IN (?)`, []interface{}{targetID, sourceIDs}},
+		{"insert source names as aliases", `INSERT IGNORE INTO tag_aliases (alias, normalized_key, tag_id, created_at) SELECT name, normalized_key, ?, NOW() FROM tags WHERE id IN (?) AND normalized_key IS NOT NULL`, []interface{}{targetID, sourceIDs}},
+	}
+
+	for _, stmt := range statements {
+		query, args, err := sqlx.In(stmt.query, stmt.args...)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, domainerrors.DatabaseError("prepare "+stmt.operation, err)
+		}
+		if _, err := tx.ExecContext(ctx, tx.Rebind(query), args...); err != nil {
+			_ = tx.Rollback()
+			logger.Error("Failed to merge tags",
+				zap.Error(err),
+				zap.String("operation", stmt.operation),
+				zap.Int64("target_id", targetID),
+			)
+			return nil, domainerrors.DatabaseError(stmt.operation, err)
+		}
+	}
+
+	query, args, err := sqlx.In(`DELETE FROM tags WHERE id IN (?)`, sourceIDs)
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, domainerrors.DatabaseError("prepare delete source tags", err)
+	}
+	result, err := tx.ExecContext(ctx, tx.Rebind(query), args...)
+	if err != nil {
+		_ = tx.Rollback()
+		logger.Error("Failed to delete source tags",
+			zap.Error(err),
+			zap.Int64s("source_ids", sourceIDs),
+		)
+		return nil, domainerrors.DatabaseError("delete source tags", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		_ = tx.Rollback()
+		return nil, domainerrors.DatabaseError("get rows affected", err)
+	}
+	if rowsAffected != int64(len(sourceIDs)) {
+		_ = tx.Rollback()
+		logger.Debug("Source tag not found for merge",
+			zap.Int64s("source_ids", sourceIDs),
+		)
+		return nil, domainerrors.NotFoundError("tag", sourceIDs)
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("Failed to commit transaction",
+			zap.Error(err),
+			zap.Int64("target_id", targetID),
+		)
+		return nil, domainerrors.DatabaseError("commit transaction", err)
+	}
+
+	logger.Info("Successfully merged tags in database",
+		zap.Int64("target_id", targetID),
+		zap.Int64s("source_ids", sourceIDs),
+	)
+
+	return r.FindByID(ctx, targetID)
+}
+
+// 別名を保存
+func (r *mysqlTagRepository) CreateAlias(ctx context.Context, alias *entity.TagAlias) (*entity.TagAlias, error) {
+	if alias == nil {
+		logger.Error("Attempted to create nil tag alias")
+		return nil, domainerrors.InvalidArgumentError("alias", "alias cannot be nil")
+	}
+
+	// タグ名と重複する別名は作成しない
+	key := r.policy.Key(alias.Alias)
+	var count int
+	if err := r.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM tags WHERE normalized_key = ?`, key); err != nil {
+		logger.Error("Failed to check tag name",
+			zap.Error(err),
+			zap.String("alias", alias.Alias),
+		)
+		return nil, domainerrors.DatabaseError("check tag name", err)
+	}
+	if count > 0 {
+		return nil, domainerrors.AlreadyExistsError("tag", alias.Alias)
+	}
+
+	query := `INSERT INTO tag_aliases (alias, normalized_key, tag_id, created_at) VALUES (?, ?, ?, ?)`
+	if _, err := r.db.ExecContext(ctx, query, alias.Alias, key, alias.TagID, alias.CreatedAt); err != nil {
+		if mysqlErr, ok := err.(*mysql.MySQLError); ok {
+			switch mysqlErr.Number {
+			case 1062:
+				return nil, domainerrors.AlreadyExistsError("tag_alias", alias.Alias)
+			case 1452:
+				return nil, domainerrors.NotFoundError("tag", alias.TagID)
+			}
+		}
+		logger.Error("Failed to insert tag alias",
+			zap.Error(err),
+			zap.String("alias", alias.Alias),
+		)
+		return nil, domainerrors.DatabaseError("insert tag alias", err)
+	}
+
+	logger.Info("Successfully created tag alias in database",
+		zap.String("alias", alias.Alias),
+		zap.Int64("tag_id", alias.TagID),
+	)
+
+	saved := *alias
+	return &saved, nil
+}
+
+// 指定されたタグの別名を取得
+func (r *mysqlTagRepository) FindAliases(ctx context.Context, tagID int64) ([]*entity.TagAlias, error) {
+	query := `SELECT alias, tag_id, created_at FROM tag_aliases WHERE tag_id = ? ORDER BY alias ASC`
+
+	var rows []tagAliasRow
+	if err := r.db.SelectContext(ctx, &rows, query, tagID); err != nil {
+		logger.Error("Failed to find tag aliases",
+			zap.Error(err),
+			zap.Int64("tag_id", tagID),
+		)
+		return nil, domainerrors.DatabaseError("find tag aliases", err)
+	}
+
+	aliases := make([]*entity.TagAlias, 0, len(rows))
+	for _, row := range rows {
+		aliases = append(aliases, &entity.TagAlias{
+			Alias:     row.Alias,
+			TagID:     row.TagID,
+			CreatedAt: row.CreatedAt.Time,
+		})
+	}
+	return aliases, nil
+}
+
+// 指定されたタグの別名を削除
+func (r *mysqlTagRepository) DeleteAlias(ctx context.Context, tagID int64, alias string) error {
+	query := `DELETE FROM tag_aliases WHERE tag_id = ? AND (normalized_key = ? OR alias = ?)`
+	result, err := r.db.ExecContext(ctx, query, tagID, r.policy.Key(alias), alias)
+	if err != nil {
+		logger.Error("Failed to delete tag alias",
+			zap.Error(err),
+			zap.String("alias", alias),
+		)
+		return domainerrors.DatabaseError("delete tag alias", err)
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return domainerrors.DatabaseError("get rows affected", err)
+	}
+	if rowsAffected == 0 {
+		return domainerrors.NotFoundError("tag_alias", alias)
+	}
+
+	logger.Info("Successfully deleted tag alias from database",
+		zap.String("alias", alias),
+		zap.Int64("tag_id", tagID),
+	)
+
+	return nil
+}
+
+// 記事に付けられているタグごとの記事数と最終使用日時を取得
+func (r *mysqlTagRepository) FindUsages(ctx context.Context) ([]entity.TagUsage, error) {
+	query := `
+		SELECT tag_id, COUNT(*) AS article_count, MAX(created_at) AS last_used_at
+		FROM article_tags
+		GROUP BY tag_id
+	`
+
+	var rows []tagUsageRow
+	if err := r.db.SelectContext(ctx, &rows, query); err != nil {
+		logger.Error("Failed to find tag usages",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("find tag usages", err)
+	}
+
+	usages := make([]entity.TagUsage, 0, len(rows))
+	for _, row := range rows {
+		usages = append(usages, entity.TagUsage{
+			TagID:        row.TagID,
+			ArticleCount: row.ArticleCount,
+			LastUsedAt:   row.LastUsedAt.Time,
+		})
+	}
+	return usages, nil
+}
+
+// 指定されたタグの使用状況を取得
+func (r *mysqlTagRepository) FindUsagesByTagIDs(ctx context.Context, tagIDs []int64) ([]entity.TagUsage, error) {
+	if len(tagIDs) == 0 {
+		return []entity.TagUsage{}, nil
+	}
+
+	query, args, err := sqlx.In(`
+		SELECT tag_id, COUNT(*) AS article_count, MAX(created_at) AS last_used_at
+		FROM article_tags
+		WHERE tag_id IN (?)
+		GROUP BY tag_id
+	`, tagIDs)
+	if err != nil {
+		return nil, domainerrors.DatabaseError("prepare tag usages query", err)
+	}
+	query = r.db.Rebind(query)
+
+	var rows []tagUsageRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		logger.Error("Failed to find tag usages by tag IDs",
+			zap.Error(err),
+			zap.Int("count", len(tagIDs)),
+		)
+		return nil, domainerrors.DatabaseError("find tag usages", err)
+	}
+
+	usages := make([]entity.TagUsage, 0, len(rows))
+	for _, row := range rows {
+		usages = append(usages, entity.TagUsage{
+			TagID:        row.TagID,
+			ArticleCount: row.ArticleCount,
+			LastUsedAt:   row.LastUsedAt.Time,
+		})
+	}
+	return usages, nil
+}
+
+// 指定されたタグの記事数を記事の作成月ごとに取得
+func (r *mysqlTagRepository) FindMonthlyArticleCounts(ctx context.Context, tagID int64) ([]entity.TagMonthlyCount, error) {
+	query := `
+		SELECT DATE_FORMAT(a.created_at, '%Y-%m') AS month, COUNT(*) AS article_count
+		FROM article_tags at
+		INNER JOIN articles a ON a.id = at.article_id
+		WHERE at.tag_id = ?
+		GROUP BY month
+		ORDER BY month ASC
+	`
+
+	var rows []tagMonthlyCountRow
+	if err := r.db.SelectContext(ctx, &rows, query, tagID); err != nil {
+		logger.Error("Failed to find monthly article counts",
+			zap.Error(err),
+			zap.Int64("tag_id", tagID),
+		)
+		return nil, domainerrors.DatabaseError("find monthly article counts", err)
+	}
+
+	counts := make([]entity.TagMonthlyCount, 0, len(rows))
+	for _, row := range rows {
+		counts = append(counts, entity.TagMonthlyCount{Month: row.Month, Count: row.ArticleCount})
+	}
+	return counts, nil
+}
+
+// 記事にも子タグにも使われていないタグを削除
+func (r *mysqlTagRepository) DeleteUnused(ctx context.Context) ([]*entity.Tag, error) {
+	logger.Debug("Deleting unused tags from database")
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		logger.Error("Failed to begin transaction",
+			zap.Error(err),
+			zap.String("operation", "DeleteUnused"),
+		)
+		return nil, domainerrors.DatabaseError("begin transaction", err)
+	}
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	// 削除するまでの間に記事や子タグが追加されないよう行ロックを取得する
+	selectQuery := `
+		SELECT t.id, t.name, t.parent_id, t.color, t.description, t.created_at, t.updated_at
+		FROM tags t
+		WHERE NOT EXISTS (SELECT 1 FROM article_tags at WHERE at.tag_id = t.id)
+			AND NOT EXISTS (SELECT 1 FROM tags c WHERE c.parent_id = t.id)
+		ORDER BY t.name ASC
+		FOR UPDATE
+	`
+	var rows []tagRow
+	if err := tx.SelectContext(ctx, &rows, selectQuery); err != nil {
+		_ = tx.Rollback()
+		logger.Error("Failed to find unused tags",
+			zap.Error(err),
+		)
+		return nil, domainerrors.DatabaseError("find unused tags", err)
+	}
+
+	tags := make([]*entity.Tag, 0, len(rows))
+	ids := make([]int64, 0, len(rows))
+	for _, row := range rows {
+		tag, err := tagRowToEntity(&row)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, err
+		}
+		tags = append(tags, tag)
+		ids = append(ids, tag.ID)
+	}
+
+	if len(ids) > 0 {
+		// 別名は外部キー制約により削除される
+		query, args, err := sqlx.In(`DELETE FROM tags WHERE id IN (?)`, ids)
+		if err != nil {
+			_ = tx.Rollback()
+			return nil, domainerrors.DatabaseError("prepare delete unused tags", err)
+		}
+		if _, err := tx.ExecContext(ctx, tx.Rebind(query), args...); err != nil {
+			_ = tx.Rollback()
+			logger.Error("Failed to delete unused tags",
+				zap.Error(err),
+				zap.Int64s("ids", ids),
+			)
+			return nil, domainerrors.DatabaseError("delete unused tags", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("Failed to commit transaction",
+			zap.Error(err),
+			zap.String("operation", "DeleteUnused"),
+		)
+		return nil, domainerrors.DatabaseError("commit transaction", err)
+	}
+
+	logger.Info("Successfully deleted unused tags from database",
+		zap.Int("count", len(tags)),
+	)
+
+	return tags, nil
+}
+
+// 正規化キーが別名として登録されている場合はAlreadyExists
+func (r *mysqlTagRepository) ensureNotAlias(ctx context.Context, key string) error {
+	var count int
+	if err := r.db.GetContext(ctx, &count, `SELECT COUNT(*) FROM tag_aliases WHERE normalized_key = ?`, key); err != nil {
+		logger.Error("Failed to check tag alias",
+			zap.Error(err),
+			zap.String("normalized_key", key),
+		)
+		return domainerrors.DatabaseError("check tag alias", err)
+	}
+	if count > 0 {
+		logger.Debug("Tag name is registered as alias",
+			zap.String("normalized_key", key),
+		)
+		return domainerrors.AlreadyExistsError("tag", key)
+	}
+	return nil
+}
+
 // tagRowをentity.Tagに変換
 func tagRowToEntity(row *tagRow) (*entity.Tag, error) {
 	tag := &entity.Tag{
-		ID:        row.ID,
-		Name:      row.Name,
-		CreatedAt: row.CreatedAt.Time,
-		UpdatedAt: row.UpdatedAt.Time,
+		ID:          row.ID,
+		Name:        row.Name,
+		ParentID:    row.ParentID.Int64,
+		Color:       row.Color.String,
+		Description: row.Description.String,
+		CreatedAt:   row.CreatedAt.Time,
+		UpdatedAt:   row.UpdatedAt.Time,
 	}
 
 	return tag, nil
 }
+
+// 親タグのIDをNULL許容の値に変換（0の場合は親なし）
+func nullTagParentID(parentID int64) sql.NullInt64 {
+	return sql.NullInt64{Int64: parentID, Valid: parentID != 0}
+}
+
+// タグ名の一意性の判定に使う正規化方針を取得
+func (r *mysqlTagRepository) NamePolicy() entity.TagNamePolicy {
+	return r.policy
+}
@@ -107,8 +107,8 @@ func createTestTag(t *testing.T, name string) *entity.Tag {
 func insertTagDirectly(t *testing.T, db *sqlx.DB, tag *entity.Tag) int64 {
 	t.Helper()
 
-	query := `INSERT INTO tags (name, created_at, updated_at) VALUES (?, ?, ?)`
-	result, err := db.Exec(query, tag.Name, tag.CreatedAt, tag.UpdatedAt)
+	query := `INSERT INTO tags (name, normalized_key, created_at, updated_at) VALUES (?, ?, ?, ?)`
+	result, err := db.Exec(query, tag.Name, entity.DefaultTagNamePolicy().Key(tag.Name), tag.CreatedAt, tag.UpdatedAt)
 	require.NoError(t, err, "タグの挿入に失敗")
 
 	id, err := result.LastInsertId()
@@ -123,7 +123,7 @@ func TestMySQLTagRepository_Create(t *testing.T) {
 
 	t.Run("正常系：タグを作成できる", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 
@@ -140,7 +140,7 @@ func TestMySQLTagRepository_Create(t *testing.T) {
 
 	t.Run("正常系：複数のタグを作成できる", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag1 := createTestTag(t, "Go")
 		tag2 := createTestTag(t, "Python")
@@ -157,7 +157,7 @@ func TestMySQLTagRepository_Create(t *testing.T) {
 
 	t.Run("異常系：nilのタグを作成しようとするとエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		created, err := repo.Create(ctx, nil)
@@ -168,7 +168,7 @@ func TestMySQLTagRepository_Create(t *testing.T) {
 
 	t.Run("異常系：キャンセルされたコンテキストではエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 
@@ -183,7 +183,7 @@ func TestMySQLTagRepository_Create(t *testing.T) {
 
 	t.Run("異常系：重複した名前のタグを作成しようとするとエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag1 := createTestTag(t, "Go")
 		tag2 := createTestTag(t, "Go")
@@ -196,6 +196,33 @@ func TestMySQLTagRepository_Create(t *testing.T) {
 		require.Error(t, err)
 		assert.Nil(t, created)
 	})
+	t.Run("異常系：正規化キーが同じタグは重複として扱い、名前の表記ゆれで検索できる", func(t *testing.T) {
+		cleanupTableForTag(t, db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
+
+		ctx := context.Background()
+		created, err := repo.Create(ctx, createTestTag(t, "AWS"))
+		require.NoError(t, err)
+
+		_, err = repo.Create(ctx, createTestTag(t, "ａｗｓ "))
+		require.Error(t, err)
+
+		found, err := repo.FindByName(ctx, "ＡＷＳ")
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, found.ID)
+	})
+
+	t.Run("正常系：大文字小文字を区別する方針では別のタグとして作成できる", func(t *testing.T) {
+		cleanupTableForTag(t, db)
+		repo := NewMySQLTagRepository(db, entity.TagNamePolicy{FoldCase: false})
+
+		ctx := context.Background()
+		_, err := repo.Create(ctx, createTestTag(t, "AWS"))
+		require.NoError(t, err)
+
+		_, err = repo.Create(ctx, createTestTag(t, "aws"))
+		require.NoError(t, err)
+	})
 }
 
 func TestMySQLTageRepository_FindByID(t *testing.T) {
@@ -204,7 +231,7 @@ func TestMySQLTageRepository_FindByID(t *testing.T) {
 
 	t.Run("正常系：IDでタグを取得できる", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 		id := insertTagDirectly(t, db, tag)
@@ -220,7 +247,7 @@ func TestMySQLTageRepository_FindByID(t *testing.T) {
 
 	t.Run("異常系：存在しないIDで取得するとエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		found, err := repo.FindByID(ctx, 99999)
@@ -232,7 +259,7 @@ func TestMySQLTageRepository_FindByID(t *testing.T) {
 
 	t.Run("異常系：負のIDで取得するとエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		found, err := repo.FindByID(ctx, -1)
@@ -243,7 +270,7 @@ func TestMySQLTageRepository_FindByID(t *testing.T) {
 
 	t.Run("異常系：キャンセルされたコンテキストではエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 		id := insertTagDirectly(t, db, tag)
@@ -264,7 +291,7 @@ func TestMySQLTagRepository_FindByName(t *testing.T) {
 
 	t.Run("正常系：名前でタグを取得できる", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 		id := insertTagDirectly(t, db, tag)
@@ -280,7 +307,7 @@ func TestMySQLTagRepository_FindByName(t *testing.T) {
 
 	t.Run("異常系：存在しない名前で取得するとエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		found, err := repo.FindByName(ctx, "NonExistent")
@@ -292,7 +319,7 @@ func TestMySQLTagRepository_FindByName(t *testing.T) {
 
 	t.Run("異常系：空文字列で取得するとエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		found, err := repo.FindByName(ctx, "")
@@ -303,7 +330,7 @@ func TestMySQLTagRepository_FindByName(t *testing.T) {
 
 	t.Run("異常系：キャンセルされたコンテキストではエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 		insertTagDirectly(t, db, tag)
@@ -324,7 +351,7 @@ func TestMySQLTagRepository_FindAll(t *testing.T) {
 
 	t.Run("正常系：複数のタグを取得できる（アルファベット順）", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag1 := createTestTag(t, "Python")
 		tag2 := createTestTag(t, "Go")
@@ -349,7 +376,7 @@ func TestMySQLTagRepository_FindAll(t *testing.T) {
 
 	t.Run("正常系：タグが0件の場合は空配列を返す", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		tags, err := repo.FindAll(ctx)
@@ -361,7 +388,7 @@ func TestMySQLTagRepository_FindAll(t *testing.T) {
 
 	t.Run("正常系：1件のタグを取得できる", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 		insertTagDirectly(t, db, tag)
@@ -377,7 +404,7 @@ func TestMySQLTagRepository_FindAll(t *testing.T) {
 
 	t.Run("異常系：キャンセルされたコンテキストではエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx, cancel := context.WithCancel(context.Background())
 		cancel()
@@ -395,7 +422,7 @@ func TestMySQLTagRepository_Update(t *testing.T) {
 
 	t.Run("正常系：タグを更新できる", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 		id := insertTagDirectly(t, db, tag)
@@ -416,7 +443,7 @@ func TestMySQLTagRepository_Update(t *testing.T) {
 
 	t.Run("正常系：UpdatedAtが更新される", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 		id := insertTagDirectly(t, db, tag)
@@ -438,7 +465,7 @@ func TestMySQLTagRepository_Update(t *testing.T) {
 
 	t.Run("異常系：存在しないIDのタグを更新しようとするとエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 		tag.ID = 99999
@@ -453,7 +480,7 @@ func TestMySQLTagRepository_Update(t *testing.T) {
 
 	t.Run("異常系：IDが0のタグを更新しようとするとエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 		tag.ID = 0
@@ -467,7 +494,7 @@ func TestMySQLTagRepository_Update(t *testing.T) {
 
 	t.Run("異常系：nilのタグを更新しようとするとエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		updated, err := repo.Update(ctx, nil)
@@ -478,7 +505,7 @@ func TestMySQLTagRepository_Update(t *testing.T) {
 
 	t.Run("異常系：キャンセルされたコンテキストではエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 		id := insertTagDirectly(t, db, tag)
@@ -495,7 +522,7 @@ func TestMySQLTagRepository_Update(t *testing.T) {
 
 	t.Run("異常系：重複した名前に更新しようとするとエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag1 := createTestTag(t, "Go")
 		tag2 := createTestTag(t, "Python")
@@ -521,7 +548,7 @@ func TestMySQLTagRepository_Delete(t *testing.T) {
 
 	t.Run("正常系：タグを削除できる", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 		id := insertTagDirectly(t, db, tag)
@@ -538,7 +565,7 @@ func TestMySQLTagRepository_Delete(t *testing.T) {
 
 	t.Run("正常系：タグを削除するとarticle_tagsも削除される", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		articleIDs := ensureArticlesExist(t, db, 1)
 
@@ -562,7 +589,7 @@ func TestMySQLTagRepository_Delete(t *testing.T) {
 
 	t.Run("正常系：複数のタグのうち1つを削除できる", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag1 := createTestTag(t, "Go")
 		tag2 := createTestTag(t, "Python")
@@ -583,7 +610,7 @@ func TestMySQLTagRepository_Delete(t *testing.T) {
 
 	t.Run("異常系：存在しないIDのタグを削除しようとするとエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		err := repo.Delete(ctx, 99999)
@@ -594,7 +621,7 @@ func TestMySQLTagRepository_Delete(t *testing.T) {
 
 	t.Run("異常系：IDが0のタグを削除しようとするとエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		err := repo.Delete(ctx, 0)
@@ -604,7 +631,7 @@ func TestMySQLTagRepository_Delete(t *testing.T) {
 
 	t.Run("異常系：負のIDのタグを削除しようとするとエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		ctx := context.Background()
 		err := repo.Delete(ctx, -1)
@@ -614,7 +641,7 @@ func TestMySQLTagRepository_Delete(t *testing.T) {
 
 	t.Run("異常系：キャンセルされたコンテキストではエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 		id := insertTagDirectly(t, db, tag)
@@ -629,7 +656,7 @@ func TestMySQLTagRepository_Delete(t *testing.T) {
 
 	t.Run("異常系：同じIDを2回削除しようとすると2回目はエラー", func(t *testing.T) {
 		cleanupTableForTag(t, db)
-		repo := NewMySQLTagRepository(db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
 
 		tag := createTestTag(t, "Go")
 		id := insertTagDirectly(t, db, tag)
@@ -646,3 +673,118 @@ func TestMySQLTagRepository_Delete(t *testing.T) {
 		assert.Contains(t, err.Error(), "not found")
 	})
 }
+
+func TestMySQLTagRepository_Merge(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	t.Run("正常系：記事の関連付けを重複なく移し、統合元の名前を別名として残す", func(t *testing.T) {
+		cleanupTableForTag(t, db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
+
+		articleIDs := ensureArticlesExist(t, db, 2)
+		targetID := insertTagDirectly(t, db, createTestTag(t, "Go"))
+		sourceID := insertTagDirectly(t, db, createTestTag(t, "golang"))
+
+		// 記事Aは両方のタグ、記事Bは統合元のタグのみ
+		_, err := db.Exec("INSERT INTO article_tags (article_id, tag_id) VALUES (?, ?), (?, ?), (?, ?)",
+			articleIDs[0], targetID, articleIDs[0], sourceID, articleIDs[1], sourceID)
+		require.NoError(t, err)
+
+		ctx := context.Background()
+		merged, err := repo.Merge(ctx, targetID, []int64{sourceID})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Go", merged.Name)
+
+		var count int
+		err = db.Get(&count, "SELECT COUNT(*) FROM article_tags WHERE tag_id = ?", targetID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		_, err = repo.FindByID(ctx, sourceID)
+		require.Error(t, err)
+
+		resolved, err := repo.FindByName(ctx, "golang")
+		require.NoError(t, err)
+		assert.Equal(t, targetID, resolved.ID)
+	})
+}
+
+func TestMySQLTagRepository_Usages(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	t.Run("正常系：記事数を集計し、記事にも子タグにも使われていないタグのみ削除する", func(t *testing.T) {
+		cleanupTableForTag(t, db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
+
+		articleIDs := ensureArticlesExist(t, db, 2)
+		usedID := insertTagDirectly(t, db, createTestTag(t, "Go"))
+		parentID := insertTagDirectly(t, db, createTestTag(t, "Infrastructure"))
+		unusedID := insertTagDirectly(t, db, createTestTag(t, "Rust"))
+		_, err := db.Exec("UPDATE tags SET parent_id = ? WHERE id = ?", parentID, usedID)
+		require.NoError(t, err)
+		_, err = db.Exec("INSERT INTO article_tags (article_id, tag_id) VALUES (?, ?), (?, ?)",
+			articleIDs[0], usedID, articleIDs[1], usedID)
+		require.NoError(t, err)
+
+		ctx := context.Background()
+		usages, err := repo.FindUsages(ctx)
+		require.NoError(t, err)
+		require.Len(t, usages, 1)
+		assert.Equal(t, usedID, usages[0].TagID)
+		assert.Equal(t, 2, usages[0].ArticleCount)
+		assert.False(t, usages[0].LastUsedAt.IsZero())
+
+		usages, err = repo.FindUsagesByTagIDs(ctx, []int64{usedID, unusedID})
+		require.NoError(t, err)
+		require.Len(t, usages, 1)
+		assert.Equal(t, usedID, usages[0].TagID)
+		assert.Equal(t, 2, usages[0].ArticleCount)
+
+		usages, err = repo.FindUsagesByTagIDs(ctx, []int64{})
+		require.NoError(t, err)
+		assert.Empty(t, usages)
+
+		counts, err := repo.FindMonthlyArticleCounts(ctx, usedID)
+		require.NoError(t, err)
+		require.Len(t, counts, 1)
+		assert.Equal(t, 2, counts[0].Count)
+
+		deleted, err := repo.DeleteUnused(ctx)
+		require.NoError(t, err)
+		require.Len(t, deleted, 1)
+		assert.Equal(t, unusedID, deleted[0].ID)
+
+		_, err = repo.FindByID(ctx, parentID)
+		assert.NoError(t, err)
+	})
+}
+
+func TestMySQLTagRepository_FindByNamePrefix(t *testing.T) {
+	db := setupTestDB(t)
+	defer db.Close()
+
+	t.Run("正常系：前方一致するタグを名前順に取得できる", func(t *testing.T) {
+		cleanupTableForTag(t, db)
+		repo := NewMySQLTagRepository(db, entity.DefaultTagNamePolicy())
+
+		insertTagDirectly(t, db, createTestTag(t, "Google Cloud"))
+		insertTagDirectly(t, db, createTestTag(t, "Go"))
+		insertTagDirectly(t, db, createTestTag(t, "Rust"))
+		insertTagDirectly(t, db, createTestTag(t, "go_tools"))
+
+		tags, err := repo.FindByNamePrefix(context.Background(), "go", 10)
+
+		require.NoError(t, err)
+		require.Len(t, tags, 3)
+		assert.Equal(t, "Go", tags[0].Name)
+
+		// LIKEの特殊文字は文字として扱う
+		tags, err = repo.FindByNamePrefix(context.Background(), "go_", 10)
+		require.NoError(t, err)
+		require.Len(t, tags, 1)
+		assert.Equal(t, "go_tools", tags[0].Name)
+	})
+}
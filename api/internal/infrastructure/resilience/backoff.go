@@ -0,0 +1,80 @@
+package resilience
+
+import (
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ジッター付き指数バックオフ
+type Backoff struct {
+	Initial time.Duration
+	Max     time.Duration
+	// 待機時間のうちランダムに短縮する割合（0〜1）
+	Jitter float64
+}
+
+// attempt回目（1始まり）のリトライ前の待機時間
+func (b Backoff) Delay(attempt int) time.Duration {
+	if attempt < 1 || b.Initial <= 0 {
+		return 0
+	}
+
+	delay := float64(b.Initial) * math.Pow(2, float64(attempt-1))
+	if b.Max > 0 {
+		delay = min(delay, float64(b.Max))
+	}
+
+	jitter := min(max(b.Jitter, 0), 1)
+	delay -= delay * jitter * rand.Float64()
+	return time.Duration(delay)
+}
+
+// Retry-Afterヘッダー（秒数またはHTTP日付）を待機時間に変換する
+func ParseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// サーバーから再試行までの待機時間を指定されたエラー
+type RetryAfterError struct {
+	Err   error
+	After time.Duration
+}
+
+func (e *RetryAfterError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RetryAfterError) Unwrap() error {
+	return e.Err
+}
+
+// レスポンスヘッダーにRetry-Afterがあればエラーに待機時間を付与する
+func WithRetryAfter(err error, header http.Header) error {
+	if err == nil || header == nil {
+		return err
+	}
+	after, ok := ParseRetryAfter(header.Get("Retry-After"), time.Now())
+	if !ok {
+		return err
+	}
+	return &RetryAfterError{Err: err, After: after}
+}
@@ -0,0 +1,78 @@
+package resilience
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff_Delay(t *testing.T) {
+	t.Run("正常系：ジッターなしの場合は指数的に増加し上限で止まる", func(t *testing.T) {
+		backoff := Backoff{Initial: 100 * time.Millisecond, Max: time.Second}
+
+		assert.Equal(t, time.Duration(0), backoff.Delay(0))
+		assert.Equal(t, 100*time.Millisecond, backoff.Delay(1))
+		assert.Equal(t, 200*time.Millisecond, backoff.Delay(2))
+		assert.Equal(t, 400*time.Millisecond, backoff.Delay(3))
+		assert.Equal(t, time.Second, backoff.Delay(10))
+	})
+
+	t.Run("正常系：ジッターは指定した割合の範囲に収まる", func(t *testing.T) {
+		backoff := Backoff{Initial: 100 * time.Millisecond, Max: time.Second, Jitter: 0.5}
+
+		for i := 0; i < 100; i++ {
+			delay := backoff.Delay(2)
+			assert.GreaterOrEqual(t, delay, 100*time.Millisecond)
+			assert.LessOrEqual(t, delay, 200*time.Millisecond)
+		}
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{name: "正常系：秒数", value: "120", expected: 2 * time.Minute, ok: true},
+		{name: "正常系：HTTP日付", value: "Wed, 01 Jan 2025 00:00:30 GMT", expected: 30 * time.Second, ok: true},
+		{name: "正常系：過去のHTTP日付は0", value: "Tue, 31 Dec 2024 23:59:00 GMT", expected: 0, ok: true},
+		{name: "異常系：空文字", value: "", ok: false},
+		{name: "異常系：負の秒数", value: "-1", ok: false},
+		{name: "異常系：不正な形式", value: "soon", ok: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			after, ok := ParseRetryAfter(tt.value, now)
+
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, after)
+		})
+	}
+}
+
+func TestWithRetryAfter(t *testing.T) {
+	baseErr := errors.New("rate limited")
+
+	t.Run("正常系：Retry-Afterがあれば待機時間を付与する", func(t *testing.T) {
+		header := http.Header{}
+		header.Set("Retry-After", "3")
+
+		err := WithRetryAfter(baseErr, header)
+
+		var retryAfterErr *RetryAfterError
+		assert.ErrorAs(t, err, &retryAfterErr)
+		assert.Equal(t, 3*time.Second, retryAfterErr.After)
+		assert.ErrorIs(t, err, baseErr)
+	})
+
+	t.Run("正常系：Retry-Afterがなければそのまま返す", func(t *testing.T) {
+		assert.Equal(t, baseErr, WithRetryAfter(baseErr, http.Header{}))
+	})
+}
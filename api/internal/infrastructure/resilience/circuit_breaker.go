@@ -0,0 +1,129 @@
+package resilience
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// サーキットが開いているため呼び出しを拒否したことを示すエラー
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// サーキットブレーカーの状態
+type BreakerState string
+
+const (
+	BreakerStateClosed   BreakerState = "closed"
+	BreakerStateOpen     BreakerState = "open"
+	BreakerStateHalfOpen BreakerState = "half_open"
+)
+
+// サーキットブレーカーの状態のスナップショット
+type BreakerSnapshot struct {
+	Name                string
+	State               BreakerState
+	ConsecutiveFailures int
+	OpenedAt            time.Time // 開いていない場合はゼロ値
+}
+
+// 連続した失敗で呼び出しを遮断し、一定時間後に試行呼び出しで回復を確認するサーキットブレーカー
+type CircuitBreaker struct {
+	name             string
+	failureThreshold int
+	openTimeout      time.Duration
+
+	mu                  sync.Mutex
+	state               BreakerState
+	consecutiveFailures int
+	openedAt            time.Time
+	probing             bool
+	now                 func() time.Time
+}
+
+// failureThresholdが0以下の場合は遮断しない
+func NewCircuitBreaker(name string, failureThreshold int, openTimeout time.Duration) *CircuitBreaker {
+	return &CircuitBreaker{
+		name:             name,
+		failureThreshold: failureThreshold,
+		openTimeout:      openTimeout,
+		state:            BreakerStateClosed,
+		now:              time.Now,
+	}
+}
+
+// 呼び出しを許可するか判定する
+// half-open状態では同時に1件の試行呼び出しのみ許可する
+func (b *CircuitBreaker) Allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case BreakerStateOpen:
+		if b.now().Sub(b.openedAt) < b.openTimeout {
+			return ErrCircuitOpen
+		}
+		b.state = BreakerStateHalfOpen
+		b.probing = true
+		return nil
+	case BreakerStateHalfOpen:
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// 呼び出しの成功を記録する
+func (b *CircuitBreaker) RecordSuccess() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.state = BreakerStateClosed
+	b.consecutiveFailures = 0
+	b.openedAt = time.Time{}
+	b.probing = false
+}
+
+// 呼び出しの失敗を記録する
+func (b *CircuitBreaker) RecordFailure() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.consecutiveFailures++
+	b.probing = false
+
+	if b.state == BreakerStateHalfOpen ||
+		(b.failureThreshold > 0 && b.consecutiveFailures >= b.failureThreshold) {
+		b.state = BreakerStateOpen
+		b.openedAt = b.now()
+	}
+}
+
+// 失敗・成功のどちらとしても扱わずに試行呼び出しを終了する
+func (b *CircuitBreaker) Release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
+
+// 現在の状態を取得する
+func (b *CircuitBreaker) Snapshot() BreakerSnapshot {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	state := b.state
+	if state == BreakerStateOpen && b.now().Sub(b.openedAt) >= b.openTimeout {
+		state = BreakerStateHalfOpen
+	}
+
+	return BreakerSnapshot{
+		Name:                b.name,
+		State:               state,
+		ConsecutiveFailures: b.consecutiveFailures,
+		OpenedAt:            b.openedAt,
+	}
+}
@@ -0,0 +1,88 @@
+package resilience
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	newBreaker := func() (*CircuitBreaker, *time.Time) {
+		breaker := NewCircuitBreaker("test", 3, time.Minute)
+		now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		breaker.now = func() time.Time { return now }
+		return breaker, &now
+	}
+
+	t.Run("正常系：連続失敗がしきい値に達すると開く", func(t *testing.T) {
+		breaker, _ := newBreaker()
+
+		for i := 0; i < 2; i++ {
+			assert.NoError(t, breaker.Allow())
+			breaker.RecordFailure()
+		}
+		assert.Equal(t, BreakerStateClosed, breaker.Snapshot().State)
+
+		assert.NoError(t, breaker.Allow())
+		breaker.RecordFailure()
+
+		assert.Equal(t, BreakerStateOpen, breaker.Snapshot().State)
+		assert.Equal(t, 3, breaker.Snapshot().ConsecutiveFailures)
+		assert.ErrorIs(t, breaker.Allow(), ErrCircuitOpen)
+	})
+
+	t.Run("正常系：成功すると連続失敗数がリセットされる", func(t *testing.T) {
+		breaker, _ := newBreaker()
+
+		breaker.RecordFailure()
+		breaker.RecordFailure()
+		breaker.RecordSuccess()
+		breaker.RecordFailure()
+
+		assert.Equal(t, BreakerStateClosed, breaker.Snapshot().State)
+		assert.Equal(t, 1, breaker.Snapshot().ConsecutiveFailures)
+	})
+
+	t.Run("正常系：一定時間後に試行呼び出しを1件だけ許可し、成功すると閉じる", func(t *testing.T) {
+		breaker, now := newBreaker()
+		for i := 0; i < 3; i++ {
+			breaker.RecordFailure()
+		}
+
+		*now = now.Add(time.Minute)
+		assert.Equal(t, BreakerStateHalfOpen, breaker.Snapshot().State)
+		assert.NoError(t, breaker.Allow())
+		assert.ErrorIs(t, breaker.Allow(), ErrCircuitOpen)
+
+		breaker.RecordSuccess()
+
+		assert.Equal(t, BreakerStateClosed, breaker.Snapshot().State)
+		assert.NoError(t, breaker.Allow())
+	})
+
+	t.Run("異常系：試行呼び出しが失敗すると再び開く", func(t *testing.T) {
+		breaker, now := newBreaker()
+		for i := 0; i < 3; i++ {
+			breaker.RecordFailure()
+		}
+
+		*now = now.Add(time.Minute)
+		assert.NoError(t, breaker.Allow())
+		breaker.RecordFailure()
+
+		snapshot := breaker.Snapshot()
+		assert.Equal(t, BreakerStateOpen, snapshot.State)
+		assert.Equal(t, *now, snapshot.OpenedAt)
+		assert.ErrorIs(t, breaker.Allow(), ErrCircuitOpen)
+	})
+
+	t.Run("正常系：しきい値が0の場合は開かない", func(t *testing.T) {
+		breaker := NewCircuitBreaker("test", 0, time.Minute)
+		for i := 0; i < 10; i++ {
+			breaker.RecordFailure()
+		}
+
+		assert.NoError(t, breaker.Allow())
+	})
+}
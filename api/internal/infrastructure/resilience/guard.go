@@ -0,0 +1,141 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// 外部API呼び出しの保護設定
+type Config struct {
+	// リトライ回数（最初の試行を含まない）
+	MaxRetries int
+	// リトライ間隔（指数バックオフの初期値と上限）
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// これより長いRetry-Afterを指定された場合はリトライしない
+	MaxRetryAfter time.Duration
+	// 1秒あたりのリクエスト数とバースト（0以下の場合は制限しない）
+	RequestsPerSecond float64
+	Burst             int
+	// サーキットを開く連続失敗数と、開いてから試行呼び出しを行うまでの時間
+	FailureThreshold int
+	OpenTimeout      time.Duration
+}
+
+// リトライ上限に達したことを示すエラー
+type RetryExhaustedError struct {
+	Attempts int
+	Err      error
+}
+
+func (e *RetryExhaustedError) Error() string {
+	return fmt.Sprintf("failed after %d attempts: %v", e.Attempts, e.Err)
+}
+
+func (e *RetryExhaustedError) Unwrap() error {
+	return e.Err
+}
+
+// レート制限・リトライ・サーキットブレーカーをまとめて適用する
+type Guard struct {
+	config  Config
+	backoff Backoff
+	limiter *TokenBucket
+	breaker *CircuitBreaker
+}
+
+// コンストラクタ
+func NewGuard(name string, config Config) *Guard {
+	return &Guard{
+		config: config,
+		backoff: Backoff{
+			Initial: config.InitialBackoff,
+			Max:     config.MaxBackoff,
+			Jitter:  0.5,
+		},
+		limiter: NewTokenBucket(config.RequestsPerSecond, config.Burst),
+		breaker: NewCircuitBreaker(name, config.FailureThreshold, config.OpenTimeout),
+	}
+}
+
+// サーキットブレーカーを取得
+func (g *Guard) Breaker() *CircuitBreaker {
+	return g.breaker
+}
+
+// callを実行し、retryableがtrueを返すエラーの場合はバックオフしてリトライする
+// 返すエラーは以下のいずれか
+//   - リトライ不可能なエラー（callが返したもの）
+//   - リトライ上限に達した場合のRetryExhaustedError
+//   - サーキットが開いている場合のErrCircuitOpenをラップしたエラー
+//   - 待機中にキャンセルされた場合のctx.Err()
+func (g *Guard) Do(ctx context.Context, call func(ctx context.Context) error, retryable func(err error) bool) error {
+	var lastErr error
+
+	for attempt := 0; attempt <= g.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			wait := g.backoff.Delay(attempt)
+			var retryAfterErr *RetryAfterError
+			if errors.As(lastErr, &retryAfterErr) {
+				if g.config.MaxRetryAfter > 0 && retryAfterErr.After > g.config.MaxRetryAfter {
+					return &RetryExhaustedError{Attempts: attempt, Err: unwrapRetryAfter(lastErr)}
+				}
+				wait = max(wait, retryAfterErr.After)
+			}
+			if err := sleep(ctx, wait); err != nil {
+				return err
+			}
+		}
+
+		if err := g.breaker.Allow(); err != nil {
+			return fmt.Errorf("%s: %w", g.breaker.name, err)
+		}
+		if err := g.limiter.Wait(ctx); err != nil {
+			g.breaker.Release()
+			return err
+		}
+
+		err := call(ctx)
+		if err == nil {
+			g.breaker.RecordSuccess()
+			return nil
+		}
+
+		if !retryable(err) {
+			// リクエスト自体の問題は上流の障害として扱わない
+			g.breaker.Release()
+			return unwrapRetryAfter(err)
+		}
+		if ctx.Err() != nil {
+			g.breaker.Release()
+			return unwrapRetryAfter(err)
+		}
+		g.breaker.RecordFailure()
+		lastErr = err
+	}
+
+	return &RetryExhaustedError{Attempts: g.config.MaxRetries + 1, Err: unwrapRetryAfter(lastErr)}
+}
+
+func unwrapRetryAfter(err error) error {
+	if retryAfterErr, ok := err.(*RetryAfterError); ok {
+		return retryAfterErr.Err
+	}
+	return err
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
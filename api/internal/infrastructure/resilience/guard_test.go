@@ -0,0 +1,125 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGuard_Do(t *testing.T) {
+	errTemporary := errors.New("temporary")
+	errPermanent := errors.New("permanent")
+	retryable := func(err error) bool { return errors.Is(err, errTemporary) }
+	config := Config{
+		MaxRetries:       2,
+		InitialBackoff:   time.Millisecond,
+		MaxBackoff:       5 * time.Millisecond,
+		FailureThreshold: 3,
+		OpenTimeout:      time.Minute,
+	}
+
+	t.Run("正常系：リトライ可能なエラーの後に成功する", func(t *testing.T) {
+		guard := NewGuard("test", config)
+		calls := 0
+
+		err := guard.Do(context.Background(), func(ctx context.Context) error {
+			calls++
+			if calls < 3 {
+				return errTemporary
+			}
+			return nil
+		}, retryable)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, BreakerStateClosed, guard.Breaker().Snapshot().State)
+	})
+
+	t.Run("異常系：リトライ不可能なエラーはそのまま返す", func(t *testing.T) {
+		guard := NewGuard("test", config)
+		calls := 0
+
+		err := guard.Do(context.Background(), func(ctx context.Context) error {
+			calls++
+			return errPermanent
+		}, retryable)
+
+		assert.Equal(t, errPermanent, err)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, 0, guard.Breaker().Snapshot().ConsecutiveFailures)
+	})
+
+	t.Run("異常系：リトライ上限に達するとサーキットが開き、以降の呼び出しを拒否する", func(t *testing.T) {
+		guard := NewGuard("test", config)
+		calls := 0
+		call := func(ctx context.Context) error {
+			calls++
+			return errTemporary
+		}
+
+		err := guard.Do(context.Background(), call, retryable)
+
+		var exhaustedErr *RetryExhaustedError
+		assert.ErrorAs(t, err, &exhaustedErr)
+		assert.Equal(t, 3, exhaustedErr.Attempts)
+		assert.ErrorIs(t, err, errTemporary)
+		assert.Equal(t, BreakerStateOpen, guard.Breaker().Snapshot().State)
+
+		err = guard.Do(context.Background(), call, retryable)
+
+		assert.ErrorIs(t, err, ErrCircuitOpen)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("正常系：Retry-Afterの待機時間を尊重する", func(t *testing.T) {
+		guard := NewGuard("test", config)
+		calls := 0
+		start := time.Now()
+
+		err := guard.Do(context.Background(), func(ctx context.Context) error {
+			calls++
+			if calls == 1 {
+				return &RetryAfterError{Err: errTemporary, After: 50 * time.Millisecond}
+			}
+			return nil
+		}, retryable)
+
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+	})
+
+	t.Run("異常系：Retry-Afterが上限を超える場合はリトライしない", func(t *testing.T) {
+		limited := config
+		limited.MaxRetryAfter = time.Second
+		guard := NewGuard("test", limited)
+		calls := 0
+
+		err := guard.Do(context.Background(), func(ctx context.Context) error {
+			calls++
+			return &RetryAfterError{Err: errTemporary, After: time.Hour}
+		}, retryable)
+
+		var exhaustedErr *RetryExhaustedError
+		assert.ErrorAs(t, err, &exhaustedErr)
+		assert.Equal(t, errTemporary, exhaustedErr.Err)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("異常系：待機中にキャンセルされる", func(t *testing.T) {
+		slow := config
+		slow.InitialBackoff = time.Hour
+		slow.MaxBackoff = time.Hour
+		guard := NewGuard("test", slow)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		err := guard.Do(ctx, func(ctx context.Context) error {
+			return errTemporary
+		}, retryable)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
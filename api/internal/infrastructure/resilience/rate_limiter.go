@@ -0,0 +1,72 @@
+package resilience
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// トークンバケット方式のレートリミッター
+type TokenBucket struct {
+	rate   float64 // 1秒あたりに補充されるトークン数
+	burst  float64
+	tokens float64
+	last   time.Time
+	mu     sync.Mutex
+	now    func() time.Time
+}
+
+// ratePerSecondが0以下の場合はnilを返す（nilのリミッターは制限しない）
+func NewTokenBucket(ratePerSecond float64, burst int) *TokenBucket {
+	if ratePerSecond <= 0 {
+		return nil
+	}
+	burst = max(burst, 1)
+	return &TokenBucket{
+		rate:   ratePerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+}
+
+// トークンを1つ取得できるまで待機する
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if b == nil {
+		return nil
+	}
+
+	for {
+		wait := b.reserve()
+		if wait == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// トークンを取得できた場合は0、できない場合は次のトークンまでの待機時間を返す
+func (b *TokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if !b.last.IsZero() {
+		elapsed := now.Sub(b.last).Seconds()
+		b.tokens = min(b.burst, b.tokens+elapsed*b.rate)
+	}
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return 0
+	}
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
@@ -0,0 +1,52 @@
+package resilience
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenBucket(t *testing.T) {
+	t.Run("正常系：バースト分は待機せずに取得できる", func(t *testing.T) {
+		bucket := NewTokenBucket(1, 3)
+		now := time.Now()
+		bucket.now = func() time.Time { return now }
+
+		for i := 0; i < 3; i++ {
+			assert.Equal(t, time.Duration(0), bucket.reserve())
+		}
+		assert.Equal(t, time.Second, bucket.reserve())
+	})
+
+	t.Run("正常系：経過時間に応じてトークンが補充される", func(t *testing.T) {
+		bucket := NewTokenBucket(2, 1)
+		now := time.Now()
+		bucket.now = func() time.Time { return now }
+
+		assert.Equal(t, time.Duration(0), bucket.reserve())
+		assert.Equal(t, 500*time.Millisecond, bucket.reserve())
+
+		now = now.Add(500 * time.Millisecond)
+		assert.Equal(t, time.Duration(0), bucket.reserve())
+	})
+
+	t.Run("正常系：レートが0以下の場合は制限しない", func(t *testing.T) {
+		bucket := NewTokenBucket(0, 1)
+
+		assert.Nil(t, bucket)
+		assert.NoError(t, bucket.Wait(context.Background()))
+	})
+
+	t.Run("異常系：待機中にキャンセルされる", func(t *testing.T) {
+		bucket := NewTokenBucket(0.1, 1)
+		require.NoError(t, bucket.Wait(context.Background()))
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.ErrorIs(t, bucket.Wait(ctx), context.DeadlineExceeded)
+	})
+}
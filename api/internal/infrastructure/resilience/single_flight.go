@@ -0,0 +1,45 @@
+package resilience
+
+import "sync"
+
+// 同じキーの処理が実行中の場合は、新たに実行せずにその結果を共有する
+type SingleFlight[T any] struct {
+	mu    sync.Mutex
+	calls map[string]*singleFlightCall[T]
+}
+
+type singleFlightCall[T any] struct {
+	done  chan struct{}
+	value T
+	err   error
+	dups  int // 結果を待っている他の呼び出しの数
+}
+
+func NewSingleFlight[T any]() *SingleFlight[T] {
+	return &SingleFlight[T]{calls: make(map[string]*singleFlightCall[T])}
+}
+
+// fnを実行して結果を返す。同じキーのfnが実行中の場合は、その完了を待って同じ結果を返す
+// sharedは他の呼び出しが実行した結果を受け取った場合にtrueとなる
+func (g *SingleFlight[T]) Do(key string, fn func() (T, error)) (value T, shared bool, err error) {
+	g.mu.Lock()
+	if call, ok := g.calls[key]; ok {
+		call.dups++
+		g.mu.Unlock()
+		<-call.done
+		return call.value, true, call.err
+	}
+	call := &singleFlightCall[T]{done: make(chan struct{})}
+	g.calls[key] = call
+	g.mu.Unlock()
+
+	defer func() {
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(call.done)
+	}()
+
+	call.value, call.err = fn()
+	return call.value, false, call.err
+}
@@ -0,0 +1,74 @@
+package resilience
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSingleFlight(t *testing.T) {
+	t.Run("正常系：同じキーの同時呼び出しは1回だけ実行する", func(t *testing.T) {
+		group := NewSingleFlight[int]()
+		var calls atomic.Int32
+		release := make(chan struct{})
+		started := make(chan struct{})
+
+		var wg sync.WaitGroup
+		results := make([]int, 5)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[0], _, _ = group.Do("key", func() (int, error) {
+				close(started)
+				<-release
+				return int(calls.Add(1)), nil
+			})
+		}()
+		<-started
+
+		for i := 1; i < len(results); i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				value, shared, err := group.Do("key", func() (int, error) {
+					return int(calls.Add(1)), nil
+				})
+				assert.NoError(t, err)
+				assert.True(t, shared)
+				results[i] = value
+			}(i)
+		}
+		// 後続の呼び出しが待機に入るまで待ってから完了させる
+		for {
+			group.mu.Lock()
+			waiting := group.calls["key"].dups == len(results)-1
+			group.mu.Unlock()
+			if waiting {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), calls.Load())
+		assert.Equal(t, []int{1, 1, 1, 1, 1}, results)
+	})
+
+	t.Run("正常系：完了後の呼び出しは再度実行する", func(t *testing.T) {
+		group := NewSingleFlight[int]()
+		errFailed := errors.New("failed")
+
+		_, _, err := group.Do("key", func() (int, error) { return 0, errFailed })
+		assert.ErrorIs(t, err, errFailed)
+
+		value, shared, err := group.Do("key", func() (int, error) { return 2, nil })
+		assert.NoError(t, err)
+		assert.False(t, shared)
+		assert.Equal(t, 2, value)
+	})
+}
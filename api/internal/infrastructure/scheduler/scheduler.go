@@ -0,0 +1,110 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"article-manager/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// 定期実行するジョブ
+type Job struct {
+	Name       string
+	Interval   time.Duration
+	RunOnStart bool // 起動直後に一度実行するかどうか
+	Run        func(ctx context.Context) error
+}
+
+// プロセス内でジョブを定期実行するスケジューラ
+type Scheduler struct {
+	jobs []Job
+	wg   sync.WaitGroup
+}
+
+// 新しいスケジューラを作成
+func NewScheduler() *Scheduler {
+	return &Scheduler{}
+}
+
+// ジョブを登録（間隔が0以下のジョブは無効として登録しない）
+func (s *Scheduler) AddJob(job Job) {
+	if job.Interval <= 0 || job.Run == nil {
+		logger.Info("Scheduled job is disabled",
+			zap.String("job", job.Name),
+		)
+		return
+	}
+	s.jobs = append(s.jobs, job)
+}
+
+// 登録済みジョブを開始（ctxのキャンセルで停止）
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		s.wg.Add(1)
+		go func(job Job) {
+			defer s.wg.Done()
+			s.loop(ctx, job)
+		}(job)
+
+		logger.Info("Scheduled job started",
+			zap.String("job", job.Name),
+			zap.Duration("interval", job.Interval),
+		)
+	}
+}
+
+// 実行中のジョブがすべて終了するまで待機
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	if job.RunOnStart {
+		s.runJob(ctx, job)
+	}
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			logger.Info("Scheduled job stopped",
+				zap.String("job", job.Name),
+			)
+			return
+		case <-ticker.C:
+			s.runJob(ctx, job)
+		}
+	}
+}
+
+// ジョブを実行（パニックしてもスケジューラは停止しない）
+func (s *Scheduler) runJob(ctx context.Context, job Job) {
+	defer func() {
+		if p := recover(); p != nil {
+			logger.Error("Scheduled job panicked",
+				zap.String("job", job.Name),
+				zap.Any("panic", p),
+			)
+		}
+	}()
+
+	start := time.Now()
+	if err := job.Run(ctx); err != nil {
+		logger.Error("Scheduled job failed",
+			zap.String("job", job.Name),
+			zap.Error(err),
+			zap.Duration("elapsed", time.Since(start)),
+		)
+		return
+	}
+
+	logger.Debug("Scheduled job completed",
+		zap.String("job", job.Name),
+		zap.Duration("elapsed", time.Since(start)),
+	)
+}
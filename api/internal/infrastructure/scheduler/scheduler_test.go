@@ -0,0 +1,96 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScheduler(t *testing.T) {
+	t.Run("正常系：ジョブが定期実行され、キャンセルで停止する", func(t *testing.T) {
+		var count int32
+		s := NewScheduler()
+		s.AddJob(Job{
+			Name:     "counter",
+			Interval: 10 * time.Millisecond,
+			Run: func(ctx context.Context) error {
+				atomic.AddInt32(&count, 1)
+				return nil
+			},
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		s.Start(ctx)
+		time.Sleep(55 * time.Millisecond)
+		cancel()
+		s.Wait()
+
+		stopped := atomic.LoadInt32(&count)
+		assert.GreaterOrEqual(t, stopped, int32(2))
+
+		time.Sleep(30 * time.Millisecond)
+		assert.Equal(t, stopped, atomic.LoadInt32(&count))
+	})
+
+	t.Run("正常系：RunOnStartの場合は起動直後に実行される", func(t *testing.T) {
+		executed := make(chan struct{}, 1)
+		s := NewScheduler()
+		s.AddJob(Job{
+			Name:       "on-start",
+			Interval:   time.Hour,
+			RunOnStart: true,
+			Run: func(ctx context.Context) error {
+				executed <- struct{}{}
+				return nil
+			},
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		s.Start(ctx)
+
+		select {
+		case <-executed:
+		case <-time.After(time.Second):
+			t.Fatal("job was not executed on start")
+		}
+	})
+
+	t.Run("正常系：エラーやパニックが発生してもジョブは継続する", func(t *testing.T) {
+		var count int32
+		s := NewScheduler()
+		s.AddJob(Job{
+			Name:     "failing",
+			Interval: 10 * time.Millisecond,
+			Run: func(ctx context.Context) error {
+				if atomic.AddInt32(&count, 1)%2 == 0 {
+					panic("unexpected")
+				}
+				return errors.New("failed")
+			},
+		})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		s.Start(ctx)
+		time.Sleep(55 * time.Millisecond)
+		cancel()
+		s.Wait()
+
+		assert.GreaterOrEqual(t, atomic.LoadInt32(&count), int32(3))
+	})
+
+	t.Run("正常系：間隔が0のジョブは登録されない", func(t *testing.T) {
+		s := NewScheduler()
+		s.AddJob(Job{
+			Name:     "disabled",
+			Interval: 0,
+			Run:      func(ctx context.Context) error { return nil },
+		})
+
+		assert.Empty(t, s.jobs)
+	})
+}
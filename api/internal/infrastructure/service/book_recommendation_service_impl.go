@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"slices"
 
 	"article-manager/internal/domain/entity"
 	domainservice "article-manager/internal/domain/service"
@@ -14,19 +15,23 @@ import (
 // BookRecommendationServiceの実装
 type bookRecommendationServiceImpl struct {
 	geminiClient *ai.GeminiClient
+	bookLookup   domainservice.BookLookup
 }
 
 // コンストラクタ
+// AIが推薦した書籍はbookLookupで実在を確認し、確認できた書誌情報で置き換える
 func NewBookRecommendationService(
 	geminiClient *ai.GeminiClient,
+	bookLookup domainservice.BookLookup,
 ) domainservice.BookRecommendationService {
 	return &bookRecommendationServiceImpl{
 		geminiClient: geminiClient,
+		bookLookup:   bookLookup,
 	}
 }
 
 // 書籍を推薦する
-func (s *bookRecommendationServiceImpl) RecommendBooks(ctx context.Context, articles []*entity.Article) ([]entity.Book, error) {
+func (s *bookRecommendationServiceImpl) RecommendBooks(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
 	logger.Debug("Recommending books from articles",
 		zap.Int("article_count", len(articles)),
 	)
@@ -38,7 +43,7 @@ func (s *bookRecommendationServiceImpl) RecommendBooks(ctx context.Context, arti
 	}
 
 	// GeminiでAI推薦を取得
-	recommendedBooks, err := s.geminiClient.RecommendBooks(ctx, articles)
+	recommendedBooks, err := s.geminiClient.RecommendBooks(ctx, articles, feedback)
 	if err != nil {
 		logger.Error("Failed to get book recommendations from Gemini",
 			zap.Error(err),
@@ -54,36 +59,63 @@ func (s *bookRecommendationServiceImpl) RecommendBooks(ctx context.Context, arti
 		zap.Int("book_count", len(recommendedBooks)),
 	)
 
-	// Geminiのレスポンスを直接entity.Bookに変換
+	// 推薦された書籍の実在を確認し、確認できない書籍は除外する
 	books := make([]entity.Book, 0, len(recommendedBooks))
+	articleIDs := make(map[int64]bool, len(articles))
+	for _, article := range articles {
+		articleIDs[article.ID] = true
+	}
+	seenISBN := make(map[string]bool, len(recommendedBooks))
+	var lookupErr error
 	for i, rec := range recommendedBooks {
-		logger.Debug("Processing book recommendation",
+		logger.Debug("Verifying book recommendation",
 			zap.Int("index", i),
 			zap.String("title", rec.Title),
+			zap.String("author", rec.Author),
 		)
 
-		// entity.Bookに変換
-		book := entity.Book{
-			Title: rec.Title,
-			PurchaseLinks: entity.PurchaseLinks{
-				Amazon:  rec.AmazonURL,
-				Rakuten: rec.RakutenURL,
-			},
+		// バリデーション
+		if rec.Title == "" {
+			logger.Warn("Skipping book with missing title")
+			continue
 		}
 
-		// バリデーション
-		if book.Title == "" {
-			logger.Warn("Skipping book with missing title",
-				zap.String("title", book.Title),
+		book, err := s.bookLookup.LookupBook(ctx, rec.Title, rec.Author)
+		if err != nil {
+			logger.Warn("Failed to verify book, skipping",
+				zap.Error(err),
+				zap.String("title", rec.Title),
+			)
+			lookupErr = err
+			continue
+		}
+		if book == nil {
+			logger.Info("Dropping book that could not be verified",
+				zap.String("title", rec.Title),
+				zap.String("author", rec.Author),
 			)
 			continue
 		}
+		if seenISBN[book.ISBN13] {
+			continue
+		}
+		seenISBN[book.ISBN13] = true
+
+		// 推薦理由と根拠の記事（渡していない記事IDは除外する）
+		book.Reason = rec.Reason
+		book.SourceArticleIDs = make([]int64, 0, len(rec.SourceArticleIDs))
+		for _, id := range rec.SourceArticleIDs {
+			if articleIDs[id] && !slices.Contains(book.SourceArticleIDs, id) {
+				book.SourceArticleIDs = append(book.SourceArticleIDs, id)
+			}
+		}
 
-		books = append(books, book)
+		books = append(books, *book)
 
 		logger.Debug("Successfully added book",
 			zap.Int("index", i),
 			zap.String("title", book.Title),
+			zap.String("isbn13", book.ISBN13),
 		)
 	}
 
@@ -91,7 +123,8 @@ func (s *bookRecommendationServiceImpl) RecommendBooks(ctx context.Context, arti
 		logger.Error("No valid books after processing recommendations")
 		return nil, &domainservice.BookRecommendationError{
 			Code:    domainservice.ErrCodeBooksAPIError,
-			Message: "Failed to process any book recommendations",
+			Message: "Failed to verify any book recommendations",
+			Err:     lookupErr,
 		}
 	}
 
@@ -101,3 +134,13 @@ func (s *bookRecommendationServiceImpl) RecommendBooks(ctx context.Context, arti
 
 	return books, nil
 }
+
+// 推薦に使用するモデル名
+func (s *bookRecommendationServiceImpl) RecommendationModel() string {
+	return s.geminiClient.Model()
+}
+
+// 推薦に使用するプロンプトのバージョン
+func (s *bookRecommendationServiceImpl) RecommendationPromptVersion() string {
+	return s.geminiClient.BookRecommendationPromptVersion()
+}
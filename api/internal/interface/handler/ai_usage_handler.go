@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/infrastructure/logger"
+	"article-manager/internal/usecase"
+
+	"go.uber.org/zap"
+)
+
+// AI使用量に関するHTTPハンドラ
+type AIUsageHandler struct {
+	usecase *usecase.AIUsageUsecase
+}
+
+// AIUsageHandlerのコンストラクタ
+func NewAIUsageHandler(uc *usecase.AIUsageUsecase) *AIUsageHandler {
+	return &AIUsageHandler{
+		usecase: uc,
+	}
+}
+
+// 期間・操作ごとの使用量のレスポンス構造体
+type AIUsageSummaryResponse struct {
+	Period           string `json:"period"`
+	Operation        string `json:"operation"`
+	Calls            int    `json:"calls"`
+	Errors           int    `json:"errors"`
+	PromptTokens     int64  `json:"prompt_tokens"`
+	OutputTokens     int64  `json:"output_tokens"`
+	TotalTokens      int64  `json:"total_tokens"`
+	AverageLatencyMs int64  `json:"average_latency_ms"`
+}
+
+// 予算の状況のレスポンス構造体
+type AIBudgetResponse struct {
+	MonthlyTokenLimit int64 `json:"monthly_token_limit"`
+	UsedTokens        int64 `json:"used_tokens"`
+	RemainingTokens   int64 `json:"remaining_tokens"`
+	Exceeded          bool  `json:"exceeded"`
+}
+
+// AI使用量のレスポンス構造体
+type AIUsageResponse struct {
+	Daily   []AIUsageSummaryResponse `json:"daily"`
+	Monthly []AIUsageSummaryResponse `json:"monthly"`
+	Budget  AIBudgetResponse         `json:"budget"`
+}
+
+// AI使用量の日次・月次集計を取得する
+func (h *AIUsageHandler) GetUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	days, err := parseIntQuery(r, "days")
+	if err != nil {
+		HandleError(w, err, "GetAIUsage")
+		return
+	}
+	months, err := parseIntQuery(r, "months")
+	if err != nil {
+		HandleError(w, err, "GetAIUsage")
+		return
+	}
+
+	logger.Info("Getting AI usage",
+		zap.Int("days", days),
+		zap.Int("months", months),
+	)
+
+	report, err := h.usecase.GetUsageReport(ctx, days, months)
+	if err != nil {
+		HandleError(w, err, "GetAIUsage")
+		return
+	}
+
+	response := AIUsageResponse{
+		Daily:   toAIUsageSummaryResponses(report.Daily),
+		Monthly: toAIUsageSummaryResponses(report.Monthly),
+		Budget: AIBudgetResponse{
+			MonthlyTokenLimit: report.Budget.MonthlyTokenLimit,
+			UsedTokens:        report.Budget.UsedTokens,
+			RemainingTokens:   report.Budget.RemainingTokens,
+			Exceeded:          report.Budget.Exceeded,
+		},
+	}
+
+	RespondSuccess(w, http.StatusOK, response)
+}
+
+// 整数のクエリパラメータを取得（未指定の場合は0）
+func parseIntQuery(r *http.Request, name string) (int, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, nil
+	}
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, domainerrors.InvalidArgumentError(name, name+" must be an integer")
+	}
+	return parsed, nil
+}
+
+func toAIUsageSummaryResponses(summaries []*entity.AIUsageSummary) []AIUsageSummaryResponse {
+	responses := make([]AIUsageSummaryResponse, 0, len(summaries))
+	for _, summary := range summaries {
+		responses = append(responses, AIUsageSummaryResponse{
+			Period:           summary.Period,
+			Operation:        summary.Operation,
+			Calls:            summary.Calls,
+			Errors:           summary.Errors,
+			PromptTokens:     summary.PromptTokens,
+			OutputTokens:     summary.OutputTokens,
+			TotalTokens:      summary.TotalTokens,
+			AverageLatencyMs: summary.AverageLatency.Milliseconds(),
+		})
+	}
+	return responses
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/domain/service"
+	"article-manager/internal/infrastructure/repository"
+	"article-manager/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// GET /api/ai/usageのテスト
+func TestGetAIUsage(t *testing.T) {
+	setup := func(t *testing.T, budget int64) *AIUsageHandler {
+		uc := usecase.NewAIUsageUsecase(repository.NewMemoryAIUsageRepository(), budget)
+		for _, operation := range []string{entity.AIOperationArticleGeneration, entity.AIOperationArticleGeneration, entity.AIOperationBookRecommendation} {
+			usage, err := entity.NewAIUsage(operation, "gemini-test", 60, 40, 100, 200*time.Millisecond, "")
+			require.NoError(t, err)
+			uc.RecordUsage(context.Background(), usage)
+		}
+		return NewAIUsageHandler(uc)
+	}
+
+	t.Run("正常系：日次・月次の集計と予算の状況を返す", func(t *testing.T) {
+		handler := setup(t, 250)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/ai/usage?days=7&months=1", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetUsage(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var response AIUsageResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Len(t, response.Daily, 2)
+		assert.Equal(t, entity.AIOperationArticleGeneration, response.Daily[0].Operation)
+		assert.Equal(t, 2, response.Daily[0].Calls)
+		assert.Equal(t, int64(200), response.Daily[0].TotalTokens)
+		assert.Equal(t, int64(200), response.Daily[0].AverageLatencyMs)
+		require.Len(t, response.Monthly, 2)
+		assert.Equal(t, AIBudgetResponse{MonthlyTokenLimit: 250, UsedTokens: 300, RemainingTokens: 0, Exceeded: true}, response.Budget)
+	})
+
+	t.Run("異常系：daysが整数でない", func(t *testing.T) {
+		handler := setup(t, 0)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/ai/usage?days=abc", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetUsage(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("異常系：monthsが範囲外", func(t *testing.T) {
+		handler := setup(t, 0)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/ai/usage?months=100", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetUsage(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// 予算超過エラーのマッピングのテスト
+func TestBudgetExceededErrorMapping(t *testing.T) {
+	budgetErr := &service.AIGeneratorError{Code: service.ErrCodeBudgetExceeded, Message: "Monthly AI token budget exceeded"}
+
+	t.Run("正常系：予算超過は429を返す", func(t *testing.T) {
+		status, response := mapErrorToResponse(budgetErr)
+
+		assert.Equal(t, http.StatusTooManyRequests, status)
+		assert.Equal(t, service.ErrCodeBudgetExceeded, response.Code)
+	})
+
+	t.Run("正常系：書籍推薦エラーでラップされていても429を返す", func(t *testing.T) {
+		status, response := mapErrorToResponse(&service.BookRecommendationError{
+			Code:    service.ErrCodeAIError,
+			Message: "Failed to generate book recommendations from AI",
+			Err:     budgetErr,
+		})
+
+		assert.Equal(t, http.StatusTooManyRequests, status)
+		assert.Equal(t, service.ErrCodeBudgetExceeded, response.Code)
+	})
+}
@@ -6,8 +6,8 @@ import (
 
 	"article-manager/internal/domain/entity"
 	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/service"
 	"article-manager/internal/infrastructure/logger"
-	"article-manager/internal/infrastructure/timeutil"
 	"article-manager/internal/usecase"
 
 	"go.uber.org/zap"
@@ -27,8 +27,20 @@ func NewArticleGeneratorHandler(uc *usecase.ArticleGeneratorUsecase) *ArticleGen
 
 // 記事自動生成リクエストの構造体
 type GenerateArticleRequest struct {
-	URL  string `json:"url"`
-	Memo string `json:"memo"`
+	URL           string `json:"url"`
+	Memo          string `json:"memo"`
+	SummaryLength int    `json:"summary_length"`
+	Language      string `json:"language"`
+	TagCount      int    `json:"tag_count"`
+	TagPolicy     string `json:"tag_policy"`
+	Force         bool   `json:"force"`
+}
+
+// 記事自動生成レスポンスの構造体
+type GenerateArticleResponse struct {
+	ArticleResponse
+	PendingTags []string `json:"pending_tags,omitempty"`
+	Cached      bool     `json:"cached"`
 }
 
 // URLから記事を自動生成
@@ -50,31 +62,49 @@ func (h *ArticleGeneratorHandler) GenerateArticle(w http.ResponseWriter, r *http
 		zap.String("memo", req.Memo),
 	)
 
-	article, err := h.generatorUsecase.GenerateArticleFromURL(ctx, req.URL, req.Memo)
+	result, err := h.generatorUsecase.GenerateArticleFromURL(ctx, req.toInput())
 	if err != nil {
 		HandleError(w, err, "GenerateArticle")
 		return
 	}
 
+	article := result.Article
 	logger.Info("Successfully generated article",
 		zap.Int64("id", article.ID),
 		zap.String("title", article.Title),
 		zap.String("url", article.URL),
+		zap.Strings("pending_tags", result.PendingTags),
+		zap.Bool("cached", result.CacheHit),
 	)
 
-	RespondSuccess(w, http.StatusOK, h.toArticleResponse(article))
+	RespondSuccess(w, http.StatusOK, h.toGenerateArticleResponse(result))
+}
+
+// リクエストをユースケースの入力に変換する
+func (req GenerateArticleRequest) toInput() usecase.GenerateArticleInput {
+	return usecase.GenerateArticleInput{
+		URL:  req.URL,
+		Memo: req.Memo,
+		Options: service.ArticleGenerationOptions{
+			SummaryLength: req.SummaryLength,
+			Language:      req.Language,
+			TagCount:      req.TagCount,
+		},
+		TagPolicy: usecase.TagPolicy(req.TagPolicy),
+		Force:     req.Force,
+	}
+}
+
+// 生成結果をレスポンス形式に変換する
+func (h *ArticleGeneratorHandler) toGenerateArticleResponse(result *usecase.GenerateArticleResult) GenerateArticleResponse {
+	return GenerateArticleResponse{
+		ArticleResponse: h.toArticleResponse(result.Article),
+		PendingTags:     result.PendingTags,
+		Cached:          result.CacheHit,
+	}
 }
 
 // エンティティをレスポンス形式に変換する
 func (h *ArticleGeneratorHandler) toArticleResponse(article *entity.Article) ArticleResponse {
-	return ArticleResponse{
-		ID:        article.ID,
-		Title:     article.Title,
-		URL:       article.URL,
-		Summary:   article.Summary,
-		Tags:      article.Tags,
-		Memo:      article.Memo,
-		CreatedAt: timeutil.MustFormatInJST(article.CreatedAt),
-		UpdatedAt: timeutil.MustFormatInJST(article.UpdatedAt),
-	}
+	return toArticleResponse(article)
 }
@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"article-manager/internal/domain/entity"
 	"article-manager/internal/domain/service"
 	"article-manager/internal/infrastructure/repository"
 	"article-manager/internal/usecase"
@@ -29,11 +30,15 @@ func (m *mockAIGeneratorService) GenerateArticleFromURL(ctx context.Context, req
 	return nil, nil
 }
 
+func (m *mockAIGeneratorService) ArticlePromptVersion() string {
+	return "article-test"
+}
+
 // テスト用ハンドラのセットアップ
 func setupGeneratorHandler(aiService service.AIGeneratorService) *ArticleGeneratorHandler {
 	articleRepo := repository.NewMemoryArticleRepository()
-	tagRepo := repository.NewMemoryTagRepository()
-	generatorUsecase := usecase.NewArticleGeneratorUsecase(aiService, articleRepo, tagRepo)
+	tagRepo := repository.NewMemoryTagRepository(entity.DefaultTagNamePolicy())
+	generatorUsecase := usecase.NewArticleGeneratorUsecase(aiService, articleRepo, tagRepo, nil, nil, usecase.DefaultTagSuggestionConfig())
 	return NewArticleGeneratorHandler(generatorUsecase)
 }
 
@@ -114,6 +119,100 @@ func TestGenerateArticle(t *testing.T) {
 		assert.Equal(t, "", response["memo"])
 	})
 
+	t.Run("正常系：生成オプションが反映されプロンプトバージョンが返る", func(t *testing.T) {
+		var received service.ArticleGenerationOptions
+		mockAI := &mockAIGeneratorService{
+			generateFunc: func(ctx context.Context, req service.ArticleGenerationRequest) (*service.GeneratedArticle, error) {
+				received = req.Options
+				return &service.GeneratedArticle{
+					Title:         "English Article",
+					Summary:       "A short summary.",
+					SuggestedTags: []string{},
+					SourceURL:     req.URL,
+					PromptVersion: "article-v2",
+					GeneratedAt:   time.Now(),
+				}, nil
+			},
+		}
+		handler := setupGeneratorHandler(mockAI)
+
+		requestBody := map[string]interface{}{
+			"url":            "https://example.com/article",
+			"summary_length": 100,
+			"language":       "en",
+			"tag_count":      3,
+		}
+		body, _ := json.Marshal(requestBody)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/generate", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		handler.GenerateArticle(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, service.ArticleGenerationOptions{SummaryLength: 100, Language: "en", TagCount: 3}, received)
+
+		var response map[string]interface{}
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		require.NoError(t, err)
+		assert.Equal(t, "article-v2", response["prompt_version"])
+	})
+
+	t.Run("正常系：tag_policyがaskの場合、未登録のタグがpending_tagsとして返る", func(t *testing.T) {
+		mockAI := &mockAIGeneratorService{
+			generateFunc: func(ctx context.Context, req service.ArticleGenerationRequest) (*service.GeneratedArticle, error) {
+				return &service.GeneratedArticle{
+					Title:         "タグ確認記事",
+					Summary:       "未登録のタグを含む記事です。",
+					SuggestedTags: []string{"NewTech"},
+					SourceURL:     req.URL,
+					GeneratedAt:   time.Now(),
+				}, nil
+			},
+		}
+		handler := setupGeneratorHandler(mockAI)
+
+		requestBody := map[string]interface{}{
+			"url":        "https://example.com/article",
+			"tag_policy": "ask",
+		}
+		body, _ := json.Marshal(requestBody)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/generate", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		handler.GenerateArticle(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var response GenerateArticleResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		require.NoError(t, err)
+		assert.Equal(t, "タグ確認記事", response.Title)
+		assert.Empty(t, response.Tags)
+		assert.Equal(t, []string{"NewTech"}, response.PendingTags)
+	})
+
+	t.Run("異常系：tag_policyが不正な場合", func(t *testing.T) {
+		handler := setupGeneratorHandler(&mockAIGeneratorService{})
+
+		requestBody := map[string]interface{}{
+			"url":        "https://example.com/article",
+			"tag_policy": "always",
+		}
+		body, _ := json.Marshal(requestBody)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/generate", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+
+		handler.GenerateArticle(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
 	t.Run("異常系：URLが空の場合", func(t *testing.T) {
 		mockAI := &mockAIGeneratorService{}
 		handler := setupGeneratorHandler(mockAI)
@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/service"
+	"article-manager/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// SSEのイベント名
+const (
+	generationEventProgress = "progress"
+	generationEventComplete = "complete"
+	generationEventError    = "error"
+)
+
+// 進捗イベントの構造体
+type GenerationProgressResponse struct {
+	Stage   string `json:"stage"`
+	Attempt int    `json:"attempt,omitempty"`
+	Message string `json:"message,omitempty"`
+	Text    string `json:"text,omitempty"`
+}
+
+// エラーイベントの構造体
+type GenerationErrorResponse struct {
+	ErrorResponse
+	Status int `json:"status"`
+}
+
+// URLから記事を自動生成し、進捗をServer-Sent Eventsで送信する
+// リクエストボディはGenerateArticleと同じ
+// イベント:
+//   - progress: 生成の各段階（partialの場合はtextに生成途中の要約、retryingの後は要約が最初から送り直される）
+//   - complete: 保存された記事（GenerateArticleResponse）
+//   - error: エラー（GenerationErrorResponse）
+func (h *ArticleGeneratorHandler) GenerateArticleStream(w http.ResponseWriter, r *http.Request) {
+	var req GenerateArticleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Failed to decode request body",
+			zap.Error(err),
+			zap.String("operation", "GenerateArticleStream"),
+		)
+		HandleError(w, domainerrors.InvalidArgumentError("request body", "invalid JSON format"), "GenerateArticleStream")
+		return
+	}
+
+	logger.Info("Generating article from URL with progress stream",
+		zap.String("url", req.URL),
+		zap.String("memo", req.Memo),
+	)
+
+	// 生成はサーバーの書き込みタイムアウトより長くかかることがあるため解除する
+	rc := http.NewResponseController(w)
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil {
+		logger.Debug("Failed to clear write deadline", zap.Error(err))
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+
+	send := func(event string, data interface{}) {
+		if err := writeServerSentEvent(w, event, data); err != nil {
+			logger.Debug("Failed to write server-sent event",
+				zap.Error(err),
+				zap.String("event", event),
+			)
+			return
+		}
+		if err := rc.Flush(); err != nil {
+			logger.Debug("Failed to flush server-sent event", zap.Error(err))
+		}
+	}
+
+	// 進捗はユースケースと同じゴルーチンから通知されるため、そのまま書き込む
+	ctx := service.WithGenerationProgress(r.Context(), func(progress service.GenerationProgress) {
+		send(generationEventProgress, GenerationProgressResponse{
+			Stage:   string(progress.Stage),
+			Attempt: progress.Attempt,
+			Message: progress.Message,
+			Text:    progress.Text,
+		})
+	})
+
+	result, err := h.generatorUsecase.GenerateArticleFromURL(ctx, req.toInput())
+	if err != nil {
+		statusCode, errorResponse := mapErrorToResponse(err)
+		logError(err, "GenerateArticleStream", statusCode)
+		send(generationEventError, GenerationErrorResponse{
+			ErrorResponse: errorResponse,
+			Status:        statusCode,
+		})
+		return
+	}
+
+	logger.Info("Successfully generated article",
+		zap.Int64("id", result.Article.ID),
+		zap.String("title", result.Article.Title),
+		zap.Strings("pending_tags", result.PendingTags),
+		zap.Bool("cached", result.CacheHit),
+	)
+
+	send(generationEventComplete, h.toGenerateArticleResponse(result))
+}
+
+// SSEのイベントを1件書き込む
+func writeServerSentEvent(w http.ResponseWriter, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	return err
+}
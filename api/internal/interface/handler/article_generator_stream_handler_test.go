@@ -0,0 +1,133 @@
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"article-manager/internal/domain/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type serverSentEvent struct {
+	Event string
+	Data  string
+}
+
+// レスポンスボディをSSEのイベントに分割する
+func parseServerSentEvents(t *testing.T, body string) []serverSentEvent {
+	t.Helper()
+	var events []serverSentEvent
+	var current serverSentEvent
+	scanner := bufio.NewScanner(strings.NewReader(body))
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event: "):
+			current.Event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			current.Data = strings.TrimPrefix(line, "data: ")
+		case line == "":
+			events = append(events, current)
+			current = serverSentEvent{}
+		}
+	}
+	require.NoError(t, scanner.Err())
+	return events
+}
+
+// POST /api/articles/generate/streamのテスト
+func TestGenerateArticleStream(t *testing.T) {
+	t.Run("正常系：進捗イベントの後に保存された記事を送信する", func(t *testing.T) {
+		mockAI := &mockAIGeneratorService{
+			generateFunc: func(ctx context.Context, req service.ArticleGenerationRequest) (*service.GeneratedArticle, error) {
+				service.ReportGenerationProgress(ctx, service.GenerationProgress{Stage: service.GenerationStageRetrying, Attempt: 2, Message: "rate limited"})
+				service.ReportGenerationProgress(ctx, service.GenerationProgress{Stage: service.GenerationStagePartial, Text: "AI生成"})
+				return &service.GeneratedArticle{
+					Title:         "AI生成記事タイトル",
+					Summary:       "AI生成記事の要約です。",
+					SuggestedTags: []string{"Go"},
+					SourceURL:     req.URL,
+					GeneratedAt:   time.Now(),
+				}, nil
+			},
+		}
+		handler := setupGeneratorHandler(mockAI)
+
+		body := []byte(`{"url": "https://example.com/article"}`)
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/generate/stream", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		handler.GenerateArticleStream(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+
+		events := parseServerSentEvents(t, rec.Body.String())
+		require.NotEmpty(t, events)
+
+		var stages []string
+		for _, event := range events[:len(events)-1] {
+			require.Equal(t, "progress", event.Event)
+			var progress GenerationProgressResponse
+			require.NoError(t, json.Unmarshal([]byte(event.Data), &progress))
+			stages = append(stages, progress.Stage)
+			if progress.Stage == "partial" {
+				assert.Equal(t, "AI生成", progress.Text)
+			}
+		}
+		assert.Equal(t, []string{"calling_model", "retrying", "partial", "creating_tags", "saving"}, stages)
+
+		last := events[len(events)-1]
+		require.Equal(t, "complete", last.Event)
+		var article GenerateArticleResponse
+		require.NoError(t, json.Unmarshal([]byte(last.Data), &article))
+		assert.Equal(t, "AI生成記事タイトル", article.Title)
+		assert.NotZero(t, article.ID)
+	})
+
+	t.Run("異常系：生成に失敗した場合はエラーイベントを送信する", func(t *testing.T) {
+		mockAI := &mockAIGeneratorService{
+			generateFunc: func(ctx context.Context, req service.ArticleGenerationRequest) (*service.GeneratedArticle, error) {
+				return nil, &service.AIGeneratorError{Code: service.ErrCodeAPILimit, Message: "Rate limit exceeded"}
+			},
+		}
+		handler := setupGeneratorHandler(mockAI)
+
+		body := []byte(`{"url": "https://example.com/article"}`)
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/generate/stream", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		handler.GenerateArticleStream(rec, req)
+
+		events := parseServerSentEvents(t, rec.Body.String())
+		require.NotEmpty(t, events)
+		last := events[len(events)-1]
+		require.Equal(t, "error", last.Event)
+
+		var errResp GenerationErrorResponse
+		require.NoError(t, json.Unmarshal([]byte(last.Data), &errResp))
+		assert.Equal(t, http.StatusTooManyRequests, errResp.Status)
+		assert.Equal(t, service.ErrCodeAPILimit, errResp.Code)
+	})
+
+	t.Run("異常系：不正なJSONの場合はストリームを開始せず400を返す", func(t *testing.T) {
+		handler := setupGeneratorHandler(&mockAIGeneratorService{})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/generate/stream", strings.NewReader("{invalid"))
+		rec := httptest.NewRecorder()
+
+		handler.GenerateArticleStream(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+	})
+}
@@ -46,26 +46,42 @@ type UpdateArticleRequest struct {
 
 // 記事レスポンスの構造体
 type ArticleResponse struct {
-	ID        int64    `json:"id"`
-	Title     string   `json:"title"`
-	URL       string   `json:"url"`
-	Summary   string   `json:"summary"`
-	Tags      []string `json:"tags"`
-	Memo      string   `json:"memo"`
-	CreatedAt string   `json:"created_at"`
-	UpdatedAt string   `json:"updated_at"`
+	ID                 int64    `json:"id"`
+	Title              string   `json:"title"`
+	URL                string   `json:"url"`
+	Summary            string   `json:"summary"`
+	Tags               []string `json:"tags"`
+	Memo               string   `json:"memo"`
+	SiteName           string   `json:"site_name,omitempty"`
+	Author             string   `json:"author,omitempty"`
+	PublishedAt        *string  `json:"published_at,omitempty"`
+	ImageURL           string   `json:"image_url,omitempty"`
+	FaviconURL         string   `json:"favicon_url,omitempty"`
+	Language           string   `json:"language,omitempty"`
+	ReadingTimeMinutes int      `json:"reading_time_minutes,omitempty"`
+	PromptVersion      string   `json:"prompt_version,omitempty"`
+	CreatedAt          string   `json:"created_at"`
+	UpdatedAt          string   `json:"updated_at"`
 }
 
-// 全記事の取得
+// 全記事の取得（tagクエリを指定した場合はそのタグと子孫のタグが付いた記事のみ）
 func (h *ArticleHandler) GetAllArticles(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
+	tag := r.URL.Query().Get("tag")
 
 	logger.Info("Getting all articles",
 		zap.String("method", r.Method),
 		zap.String("path", r.URL.Path),
+		zap.String("tag", tag),
 	)
 
-	articles, err := h.usecase.GetAllArticles(ctx)
+	var articles []*entity.Article
+	var err error
+	if strings.TrimSpace(tag) != "" {
+		articles, err = h.usecase.GetArticlesByTag(ctx, tag)
+	} else {
+		articles, err = h.usecase.GetAllArticles(ctx)
+	}
 	if err != nil {
 		HandleError(w, err, "GetAllArticles")
 		return
@@ -197,14 +213,16 @@ func (h *ArticleHandler) DeleteArticle(w http.ResponseWriter, r *http.Request, i
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// 記事を検索する
+// 記事を検索する（tagクエリを指定した場合はそのタグと子孫のタグが付いた記事に絞り込む）
 func (h *ArticleHandler) SearchArticles(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	keyword := r.URL.Query().Get("keyword")
+	tag := r.URL.Query().Get("tag")
 
 	logger.Info("Searching articles",
 		zap.String("keyword", keyword),
+		zap.String("tag", tag),
 	)
 
 	trimmedKeyword := strings.TrimSpace(keyword)
@@ -213,7 +231,7 @@ func (h *ArticleHandler) SearchArticles(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	articles, err := h.usecase.SearchArticles(ctx, trimmedKeyword)
+	articles, err := h.usecase.SearchArticles(ctx, trimmedKeyword, tag)
 	if err != nil {
 		HandleError(w, err, "SearchArticles")
 		return
@@ -234,14 +252,26 @@ func (h *ArticleHandler) SearchArticles(w http.ResponseWriter, r *http.Request)
 
 // エンティティをレスポンス形式に変換する
 func toArticleResponse(article *entity.Article) ArticleResponse {
-	return ArticleResponse{
-		ID:        article.ID,
-		Title:     article.Title,
-		URL:       article.URL,
-		Summary:   article.Summary,
-		Tags:      article.Tags,
-		Memo:      article.Memo,
-		CreatedAt: timeutil.MustFormatInJST(article.CreatedAt),
-		UpdatedAt: timeutil.MustFormatInJST(article.UpdatedAt),
+	response := ArticleResponse{
+		ID:                 article.ID,
+		Title:              article.Title,
+		URL:                article.URL,
+		Summary:            article.Summary,
+		Tags:               article.Tags,
+		Memo:               article.Memo,
+		SiteName:           article.Metadata.SiteName,
+		Author:             article.Metadata.Author,
+		ImageURL:           article.Metadata.ImageURL,
+		FaviconURL:         article.Metadata.FaviconURL,
+		Language:           article.Metadata.Language,
+		ReadingTimeMinutes: article.Metadata.ReadingTimeMinutes,
+		PromptVersion:      article.PromptVersion,
+		CreatedAt:          timeutil.MustFormatInJST(article.CreatedAt),
+		UpdatedAt:          timeutil.MustFormatInJST(article.UpdatedAt),
+	}
+	if article.Metadata.PublishedAt != nil {
+		publishedAt := timeutil.MustFormatInJST(*article.Metadata.PublishedAt)
+		response.PublishedAt = &publishedAt
 	}
+	return response
 }
@@ -8,6 +8,7 @@ import (
 	"net/http/httptest"
 	"testing"
 
+	"article-manager/internal/domain/entity"
 	"article-manager/internal/infrastructure/repository"
 	"article-manager/internal/usecase"
 
@@ -18,7 +19,7 @@ import (
 // テスト用のハンドラのセットアップ
 func setupHandler() *ArticleHandler {
 	repo := repository.NewMemoryArticleRepository()
-	uc := usecase.NewArticleUsecase(repo)
+	uc := usecase.NewArticleUsecase(repo, repository.NewMemoryTagRepository(entity.DefaultTagNamePolicy()))
 	return NewArticleHandler(uc)
 }
 
@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"net/http"
+
+	"article-manager/internal/infrastructure/logger"
+	"article-manager/internal/usecase"
+
+	"go.uber.org/zap"
+)
+
+// 記事メタデータに関するHTTPハンドラ
+type ArticleMetadataHandler struct {
+	usecase *usecase.ArticleMetadataUsecase
+}
+
+// ArticleMetadataHandlerのコンストラクタ
+func NewArticleMetadataHandler(uc *usecase.ArticleMetadataUsecase) *ArticleMetadataHandler {
+	return &ArticleMetadataHandler{
+		usecase: uc,
+	}
+}
+
+// 指定された記事のメタデータを再取得する
+func (h *ArticleMetadataHandler) RefreshArticleMetadata(w http.ResponseWriter, r *http.Request, id int64) {
+	ctx := r.Context()
+
+	logger.Info("Refreshing article metadata",
+		zap.Int64("article_id", id),
+	)
+
+	article, err := h.usecase.RefreshArticleMetadata(ctx, id)
+	if err != nil {
+		HandleError(w, err, "RefreshArticleMetadata")
+		return
+	}
+
+	RespondSuccess(w, http.StatusOK, toArticleResponse(article))
+}
@@ -0,0 +1,106 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/infrastructure/repository"
+	"article-manager/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// モック MetadataFetcher
+type mockMetadataFetcherForHandler struct {
+	metadata map[string]*entity.ArticleMetadata
+}
+
+func (m *mockMetadataFetcherForHandler) FetchMetadata(ctx context.Context, url string) (*entity.ArticleMetadata, error) {
+	metadata, ok := m.metadata[url]
+	if !ok {
+		return nil, errors.New("connection refused")
+	}
+	return metadata, nil
+}
+
+// テスト用のハンドラをセットアップ
+func setupArticleMetadataHandler(t *testing.T) *ArticleMetadataHandler {
+	t.Helper()
+
+	articleRepo := repository.NewMemoryArticleRepository()
+	article, err := entity.NewArticle("記事", "https://example.com/article", "要約", nil, "")
+	require.NoError(t, err)
+	_, err = articleRepo.Create(context.Background(), article)
+	require.NoError(t, err)
+	broken, err := entity.NewArticle("取得できない記事", "https://example.com/broken", "要約", nil, "")
+	require.NoError(t, err)
+	_, err = articleRepo.Create(context.Background(), broken)
+	require.NoError(t, err)
+
+	fetcher := &mockMetadataFetcherForHandler{
+		metadata: map[string]*entity.ArticleMetadata{
+			"https://example.com/article": {
+				SiteName:           "Example",
+				ImageURL:           "https://example.com/thumb.png",
+				FaviconURL:         "https://example.com/favicon.ico",
+				Language:           "ja",
+				ReadingTimeMinutes: 4,
+			},
+		},
+	}
+
+	uc := usecase.NewArticleMetadataUsecase(articleRepo, fetcher)
+	return NewArticleMetadataHandler(uc)
+}
+
+// POST /api/articles/{id}/metadata/refreshのテスト
+func TestRefreshArticleMetadataHandler(t *testing.T) {
+	t.Run("正常系：メタデータを含む記事が返る", func(t *testing.T) {
+		handler := setupArticleMetadataHandler(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/1/metadata/refresh", nil)
+		rec := httptest.NewRecorder()
+
+		handler.RefreshArticleMetadata(rec, req, 1)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var response ArticleResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		require.NoError(t, err)
+		assert.Equal(t, "Example", response.SiteName)
+		assert.Equal(t, "https://example.com/thumb.png", response.ImageURL)
+		assert.Equal(t, "https://example.com/favicon.ico", response.FaviconURL)
+		assert.Equal(t, "ja", response.Language)
+		assert.Equal(t, 4, response.ReadingTimeMinutes)
+		assert.Nil(t, response.PublishedAt)
+	})
+
+	t.Run("異常系：メタデータの取得に失敗した場合", func(t *testing.T) {
+		handler := setupArticleMetadataHandler(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/2/metadata/refresh", nil)
+		rec := httptest.NewRecorder()
+
+		handler.RefreshArticleMetadata(rec, req, 2)
+
+		assert.Equal(t, http.StatusBadGateway, rec.Code)
+	})
+
+	t.Run("異常系：存在しない記事", func(t *testing.T) {
+		handler := setupArticleMetadataHandler(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/999/metadata/refresh", nil)
+		rec := httptest.NewRecorder()
+
+		handler.RefreshArticleMetadata(rec, req, 999)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -0,0 +1,87 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/infrastructure/logger"
+	"article-manager/internal/usecase"
+
+	"go.uber.org/zap"
+)
+
+// 保存済み記事への質問応答ハンドラー
+type AskHandler struct {
+	askUsecase *usecase.AskUsecase
+}
+
+// コンストラクタ
+func NewAskHandler(uc *usecase.AskUsecase) *AskHandler {
+	return &AskHandler{
+		askUsecase: uc,
+	}
+}
+
+// 質問リクエストの構造体
+type AskRequest struct {
+	Question   string `json:"question"`
+	MaxSources int    `json:"max_sources"`
+	Language   string `json:"language"`
+}
+
+// 回答の根拠となる記事のレスポンス構造体
+type AskSourceResponse struct {
+	ArticleID int64  `json:"article_id"`
+	Title     string `json:"title"`
+	URL       string `json:"url"`
+}
+
+// 回答のレスポンス構造体
+type AskResponse struct {
+	Answer    string              `json:"answer"`
+	Citations []AskSourceResponse `json:"citations"`
+	Sources   []AskSourceResponse `json:"sources"`
+}
+
+// 保存済み記事を根拠に質問へ回答
+func (h *AskHandler) Ask(w http.ResponseWriter, r *http.Request) {
+	var req AskRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Failed to decode request body",
+			zap.Error(err),
+			zap.String("operation", "Ask"),
+		)
+		HandleError(w, domainerrors.InvalidArgumentError("request body", "invalid JSON format"), "Ask")
+		return
+	}
+
+	result, err := h.askUsecase.Ask(r.Context(), usecase.AskInput{
+		Question:   req.Question,
+		MaxSources: req.MaxSources,
+		Language:   req.Language,
+	})
+	if err != nil {
+		HandleError(w, err, "Ask")
+		return
+	}
+
+	RespondSuccess(w, http.StatusOK, AskResponse{
+		Answer:    result.Answer,
+		Citations: toAskSourceResponses(result.Citations),
+		Sources:   toAskSourceResponses(result.Sources),
+	})
+}
+
+func toAskSourceResponses(articles []*entity.Article) []AskSourceResponse {
+	responses := make([]AskSourceResponse, 0, len(articles))
+	for _, article := range articles {
+		responses = append(responses, AskSourceResponse{
+			ArticleID: article.ID,
+			Title:     article.Title,
+			URL:       article.URL,
+		})
+	}
+	return responses
+}
@@ -0,0 +1,85 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/infrastructure/ai"
+	"article-manager/internal/infrastructure/repository"
+	"article-manager/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// フェイクの回答プロバイダーを使用したハンドラのセットアップ
+func setupAskHandler(t *testing.T) (*AskHandler, []*entity.Article) {
+	t.Helper()
+	repo := repository.NewMemoryArticleRepository()
+	var saved []*entity.Article
+	for _, input := range []struct{ title, summary string }{
+		{"Kubernetesのオートスケーリング", "HPAでPod数を自動調整する方法"},
+		{"Go言語入門", "Goの基本的な文法"},
+	} {
+		article, err := entity.NewArticle(input.title, "https://example.com/"+input.title, input.summary, nil, "")
+		require.NoError(t, err)
+		created, err := repo.Create(context.Background(), article)
+		require.NoError(t, err)
+		saved = append(saved, created)
+	}
+	return NewAskHandler(usecase.NewAskUsecase(repo, ai.NewFakeQuestionAnswerer())), saved
+}
+
+// POST /api/askのテスト
+func TestAsk(t *testing.T) {
+	t.Run("正常系：保存済み記事を引用した回答を返す", func(t *testing.T) {
+		handler, saved := setupAskHandler(t)
+
+		body, _ := json.Marshal(map[string]interface{}{"question": "Kubernetesのオートスケーリングについて保存した記事は？"})
+		req := httptest.NewRequest(http.MethodPost, "/api/ask", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		handler.Ask(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response AskResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Contains(t, response.Answer, "HPAでPod数を自動調整する方法")
+		require.Len(t, response.Citations, 1)
+		assert.Equal(t, saved[0].ID, response.Citations[0].ArticleID)
+		assert.Equal(t, saved[0].Title, response.Citations[0].Title)
+		assert.Len(t, response.Sources, 1)
+	})
+
+	t.Run("正常系：関連する記事がない場合は空の回答を返す", func(t *testing.T) {
+		handler, _ := setupAskHandler(t)
+
+		body, _ := json.Marshal(map[string]interface{}{"question": "Rustのマクロ"})
+		req := httptest.NewRequest(http.MethodPost, "/api/ask", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		handler.Ask(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "", response["answer"])
+		assert.Equal(t, []interface{}{}, response["citations"])
+	})
+
+	t.Run("異常系：質問が空の場合は400", func(t *testing.T) {
+		handler, _ := setupAskHandler(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/ask", bytes.NewReader([]byte(`{"question": ""}`)))
+		rec := httptest.NewRecorder()
+
+		handler.Ask(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
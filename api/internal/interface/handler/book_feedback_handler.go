@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/infrastructure/logger"
+	"article-manager/internal/infrastructure/timeutil"
+	"article-manager/internal/usecase"
+
+	"go.uber.org/zap"
+)
+
+// 書籍へのフィードバックに関するHTTPハンドラ
+type BookFeedbackHandler struct {
+	usecase *usecase.BookFeedbackUsecase
+}
+
+// コンストラクタ
+func NewBookFeedbackHandler(uc *usecase.BookFeedbackUsecase) *BookFeedbackHandler {
+	return &BookFeedbackHandler{
+		usecase: uc,
+	}
+}
+
+// フィードバック作成リクエストの構造体
+type CreateBookFeedbackRequest struct {
+	Title  string `json:"title"`
+	Author string `json:"author"`
+	ISBN13 string `json:"isbn13"`
+	State  string `json:"state"`
+}
+
+// フィードバック更新リクエストの構造体
+type UpdateBookFeedbackRequest struct {
+	State string `json:"state"`
+}
+
+// フィードバックレスポンスの構造体
+type BookFeedbackResponse struct {
+	ID        int64  `json:"id"`
+	Title     string `json:"title"`
+	Author    string `json:"author,omitempty"`
+	ISBN13    string `json:"isbn13,omitempty"`
+	State     string `json:"state"`
+	CreatedAt string `json:"created_at"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// 全フィードバックの取得
+func (h *BookFeedbackHandler) GetAllBookFeedback(w http.ResponseWriter, r *http.Request) {
+	feedback, err := h.usecase.GetAllBookFeedback(r.Context())
+	if err != nil {
+		HandleError(w, err, "GetAllBookFeedback")
+		return
+	}
+
+	response := make([]BookFeedbackResponse, 0, len(feedback))
+	for _, f := range feedback {
+		response = append(response, toBookFeedbackResponse(f))
+	}
+
+	RespondSuccess(w, http.StatusOK, response)
+}
+
+// 指定されたIDのフィードバックを取得
+func (h *BookFeedbackHandler) GetBookFeedbackByID(w http.ResponseWriter, r *http.Request, id int64) {
+	feedback, err := h.usecase.GetBookFeedbackByID(r.Context(), id)
+	if err != nil {
+		HandleError(w, err, "GetBookFeedbackByID")
+		return
+	}
+
+	RespondSuccess(w, http.StatusOK, toBookFeedbackResponse(feedback))
+}
+
+// 新しいフィードバックを作成する
+func (h *BookFeedbackHandler) CreateBookFeedback(w http.ResponseWriter, r *http.Request) {
+	var req CreateBookFeedbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Failed to decode request body",
+			zap.Error(err),
+			zap.String("operation", "CreateBookFeedback"),
+		)
+		HandleError(w, domainerrors.InvalidArgumentError("request body", "invalid JSON format"), "CreateBookFeedback")
+		return
+	}
+
+	feedback, err := h.usecase.CreateBookFeedback(r.Context(), usecase.CreateBookFeedbackInput{
+		Title:  req.Title,
+		Author: req.Author,
+		ISBN13: req.ISBN13,
+		State:  req.State,
+	})
+	if err != nil {
+		HandleError(w, err, "CreateBookFeedback")
+		return
+	}
+
+	RespondSuccess(w, http.StatusCreated, toBookFeedbackResponse(feedback))
+}
+
+// フィードバックの状態を更新する
+func (h *BookFeedbackHandler) UpdateBookFeedback(w http.ResponseWriter, r *http.Request, id int64) {
+	var req UpdateBookFeedbackRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Failed to decode request body",
+			zap.Error(err),
+			zap.String("operation", "UpdateBookFeedback"),
+			zap.Int64("id", id),
+		)
+		HandleError(w, domainerrors.InvalidArgumentError("request body", "invalid JSON format"), "UpdateBookFeedback")
+		return
+	}
+
+	feedback, err := h.usecase.UpdateBookFeedback(r.Context(), id, req.State)
+	if err != nil {
+		HandleError(w, err, "UpdateBookFeedback")
+		return
+	}
+
+	RespondSuccess(w, http.StatusOK, toBookFeedbackResponse(feedback))
+}
+
+// フィードバックを削除する
+func (h *BookFeedbackHandler) DeleteBookFeedback(w http.ResponseWriter, r *http.Request, id int64) {
+	if err := h.usecase.DeleteBookFeedback(r.Context(), id); err != nil {
+		HandleError(w, err, "DeleteBookFeedback")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// エンティティをレスポンス形式に変換する
+func toBookFeedbackResponse(feedback *entity.BookFeedback) BookFeedbackResponse {
+	return BookFeedbackResponse{
+		ID:        feedback.ID,
+		Title:     feedback.Title,
+		Author:    feedback.Author,
+		ISBN13:    feedback.ISBN13,
+		State:     string(feedback.State),
+		CreatedAt: timeutil.MustFormatInJST(feedback.CreatedAt),
+		UpdatedAt: timeutil.MustFormatInJST(feedback.UpdatedAt),
+	}
+}
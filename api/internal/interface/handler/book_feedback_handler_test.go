@@ -0,0 +1,109 @@
+package handler
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"article-manager/internal/infrastructure/repository"
+	"article-manager/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// テスト用のハンドラのセットアップ
+func setupBookFeedbackHandler() *BookFeedbackHandler {
+	repo := repository.NewMemoryBookFeedbackRepository()
+	uc := usecase.NewBookFeedbackUsecase(repo)
+	return NewBookFeedbackHandler(uc)
+}
+
+func postBookFeedback(t *testing.T, handler *BookFeedbackHandler, requestBody map[string]interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+	body, _ := json.Marshal(requestBody)
+	req := httptest.NewRequest(http.MethodPost, "/api/book-feedback", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.CreateBookFeedback(rec, req)
+	return rec
+}
+
+// POST /api/book-feedbackのテスト
+func TestCreateBookFeedback(t *testing.T) {
+	t.Run("正常系：フィードバックを作成できる", func(t *testing.T) {
+		handler := setupBookFeedbackHandler()
+
+		rec := postBookFeedback(t, handler, map[string]interface{}{
+			"title":  "リーダブルコード",
+			"author": "Dustin Boswell",
+			"isbn13": "9784873115658",
+			"state":  "owned",
+		})
+
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var response map[string]interface{}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "リーダブルコード", response["title"])
+		assert.Equal(t, "9784873115658", response["isbn13"])
+		assert.Equal(t, "owned", response["state"])
+		assert.NotNil(t, response["created_at"])
+	})
+
+	t.Run("異常系：不正な状態", func(t *testing.T) {
+		handler := setupBookFeedbackHandler()
+
+		rec := postBookFeedback(t, handler, map[string]interface{}{
+			"title": "リーダブルコード",
+			"state": "wishlist",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("異常系：同じ書籍へのフィードバックは重複", func(t *testing.T) {
+		handler := setupBookFeedbackHandler()
+
+		first := postBookFeedback(t, handler, map[string]interface{}{"title": "リーダブルコード", "state": "liked"})
+		require.Equal(t, http.StatusCreated, first.Code)
+
+		rec := postBookFeedback(t, handler, map[string]interface{}{"title": "リーダブル・コード", "state": "dismissed"})
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
+// PUT / DELETE /api/book-feedback/{id}のテスト
+func TestUpdateAndDeleteBookFeedback(t *testing.T) {
+	t.Run("正常系：状態を更新して削除できる", func(t *testing.T) {
+		handler := setupBookFeedbackHandler()
+
+		created := postBookFeedback(t, handler, map[string]interface{}{"title": "リーダブルコード", "state": "owned"})
+		require.Equal(t, http.StatusCreated, created.Code)
+		var createdResponse BookFeedbackResponse
+		require.NoError(t, json.Unmarshal(created.Body.Bytes(), &createdResponse))
+
+		body, _ := json.Marshal(map[string]interface{}{"state": "read"})
+		req := httptest.NewRequest(http.MethodPut, "/api/book-feedback/1", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.UpdateBookFeedback(rec, req, createdResponse.ID)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var updated BookFeedbackResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &updated))
+		assert.Equal(t, "read", updated.State)
+
+		req = httptest.NewRequest(http.MethodDelete, "/api/book-feedback/1", nil)
+		rec = httptest.NewRecorder()
+		handler.DeleteBookFeedback(rec, req, createdResponse.ID)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/book-feedback/1", nil)
+		rec = httptest.NewRecorder()
+		handler.GetBookFeedbackByID(rec, req, createdResponse.ID)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"net/http"
 
 	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
 	"article-manager/internal/infrastructure/logger"
 	"article-manager/internal/infrastructure/timeutil"
 	"article-manager/internal/usecase"
@@ -31,30 +32,137 @@ type PurchaseLinksResponse struct {
 
 // 書籍のレスポンス構造体
 type BookResponse struct {
+	Key           string                `json:"key"` // 読書リストへの追加時に書籍を指定するキー
 	Title         string                `json:"title"`
+	Author        string                `json:"author,omitempty"`
+	ISBN13        string                `json:"isbn13,omitempty"`
+	CoverImageURL string                `json:"coverImageUrl,omitempty"`
 	PurchaseLinks PurchaseLinksResponse `json:"purchaseLinks"`
+	// 推薦理由と、推薦のきっかけとなった記事のID
+	Reason           string  `json:"reason,omitempty"`
+	SourceArticleIDs []int64 `json:"sourceArticleIds"`
 }
 
 // 書籍推薦のレスポンス構造体
 type BookRecommendationResponse struct {
+	Scope       string         `json:"scope"`
 	Books       []BookResponse `json:"books"`
 	Cached      bool           `json:"cached"`
 	GeneratedAt *string        `json:"generatedAt"`
 	ExpiresAt   *string        `json:"expiresAt"`
 }
 
-// 書籍推薦を取得する
+// 書籍推薦の履歴のレスポンス構造体
+type BookRecommendationHistoryResponse struct {
+	ID            int64          `json:"id"`
+	Scope         string         `json:"scope"`
+	Books         []BookResponse `json:"books"`
+	Added         []BookResponse `json:"added"`
+	Removed       []BookResponse `json:"removed"`
+	ArticleCount  int            `json:"articleCount"`
+	Model         string         `json:"model"`
+	PromptVersion string         `json:"promptVersion"`
+	GeneratedAt   string         `json:"generatedAt"`
+	ExpiresAt     string         `json:"expiresAt"`
+}
+
+// 書籍推薦を取得する（tagクエリを指定した場合はそのタグの記事から推薦する）
 func (h *BookRecommendationHandler) GetBookRecommendations(w http.ResponseWriter, r *http.Request) {
+	scope := entity.BookRecommendationScope{Tag: r.URL.Query().Get("tag")}
+	h.respondBookRecommendations(w, r, scope, "GetBookRecommendations")
+}
+
+// 指定された記事に基づく書籍推薦を取得する
+func (h *BookRecommendationHandler) GetArticleBookRecommendations(w http.ResponseWriter, r *http.Request, id int64) {
+	scope := entity.BookRecommendationScope{ArticleID: id}
+	h.respondBookRecommendations(w, r, scope, "GetArticleBookRecommendations")
+}
+
+// キャッシュを使用せずに書籍推薦を生成し直す
+// tagまたはarticleIdクエリで対象範囲を指定する
+func (h *BookRecommendationHandler) RefreshBookRecommendations(w http.ResponseWriter, r *http.Request) {
+	scope, err := bookRecommendationScopeFromQuery(r)
+	if err != nil {
+		HandleError(w, err, "RefreshBookRecommendations")
+		return
+	}
+
+	logger.Info("Refreshing book recommendations",
+		zap.String("scope_key", scope.Key()),
+	)
+
+	cache, err := h.usecase.RefreshBookRecommendations(r.Context(), scope)
+	if err != nil {
+		HandleError(w, err, "RefreshBookRecommendations")
+		return
+	}
+
+	RespondSuccess(w, http.StatusOK, toBookRecommendationResponse(cache))
+}
+
+// 書籍推薦の履歴と、1つ前の推薦から追加・削除された書籍を取得する
+// tagまたはarticleIdクエリで対象範囲を、limitクエリで件数を指定する
+func (h *BookRecommendationHandler) GetBookRecommendationHistory(w http.ResponseWriter, r *http.Request) {
+	scope, err := bookRecommendationScopeFromQuery(r)
+	if err != nil {
+		HandleError(w, err, "GetBookRecommendationHistory")
+		return
+	}
+	limit, err := parseIntQuery(r, "limit")
+	if err != nil {
+		HandleError(w, err, "GetBookRecommendationHistory")
+		return
+	}
+	if limit < 0 {
+		HandleError(w, domainerrors.InvalidArgumentError("limit", "limit must be positive"), "GetBookRecommendationHistory")
+		return
+	}
+	history, err := h.usecase.GetBookRecommendationHistory(r.Context(), scope, limit)
+	if err != nil {
+		HandleError(w, err, "GetBookRecommendationHistory")
+		return
+	}
+
+	response := make([]BookRecommendationHistoryResponse, 0, len(history))
+	for _, entry := range history {
+		response = append(response, BookRecommendationHistoryResponse{
+			ID:            entry.Recommendation.ID,
+			Scope:         entry.Recommendation.ScopeKey,
+			Books:         toBookResponses(entry.Recommendation.Books),
+			Added:         toBookResponses(entry.Added),
+			Removed:       toBookResponses(entry.Removed),
+			ArticleCount:  entry.Recommendation.ArticleCount,
+			Model:         entry.Recommendation.Model,
+			PromptVersion: entry.Recommendation.PromptVersion,
+			GeneratedAt:   timeutil.MustFormatInJST(entry.Recommendation.GeneratedAt),
+			ExpiresAt:     timeutil.MustFormatInJST(entry.Recommendation.ExpiresAt),
+		})
+	}
+
+	RespondSuccess(w, http.StatusOK, response)
+}
+
+// tagとarticleIdクエリから対象範囲を取得する
+func bookRecommendationScopeFromQuery(r *http.Request) (entity.BookRecommendationScope, error) {
+	articleID, err := parseIntQuery(r, "articleId")
+	if err != nil {
+		return entity.BookRecommendationScope{}, err
+	}
+	return entity.BookRecommendationScope{Tag: r.URL.Query().Get("tag"), ArticleID: int64(articleID)}, nil
+}
+
+func (h *BookRecommendationHandler) respondBookRecommendations(w http.ResponseWriter, r *http.Request, scope entity.BookRecommendationScope, operation string) {
 	ctx := r.Context()
 
 	logger.Info("Getting book recommendations",
 		zap.String("method", r.Method),
 		zap.String("path", r.URL.Path),
+		zap.String("scope_key", scope.Key()),
 	)
 
-	cache, err := h.usecase.GetBookRecommendations(ctx)
+	cache, err := h.usecase.GetBookRecommendations(ctx, scope)
 	if err != nil {
-		HandleError(w, err, "GetBookRecommendations")
+		HandleError(w, err, operation)
 		return
 	}
 
@@ -73,6 +181,7 @@ func toBookRecommendationResponse(cache *entity.BookRecommendationCache) BookRec
 	// 書籍が0件の場合
 	if len(cache.Books) == 0 {
 		return BookRecommendationResponse{
+			Scope:       cache.ScopeKey,
 			Books:       []BookResponse{},
 			Cached:      false,
 			GeneratedAt: nil,
@@ -81,10 +190,7 @@ func toBookRecommendationResponse(cache *entity.BookRecommendationCache) BookRec
 	}
 
 	// 書籍リストの変換
-	books := make([]BookResponse, 0, len(cache.Books))
-	for _, book := range cache.Books {
-		books = append(books, toBookResponse(book))
-	}
+	books := toBookResponses(cache.Books)
 
 	// 日時をISO 8601形式に変換
 	generatedAt := timeutil.MustFormatInJST(cache.GeneratedAt)
@@ -94,6 +200,7 @@ func toBookRecommendationResponse(cache *entity.BookRecommendationCache) BookRec
 	cached := cache.ID > 0
 
 	return BookRecommendationResponse{
+		Scope:       cache.ScopeKey,
 		Books:       books,
 		Cached:      cached,
 		GeneratedAt: &generatedAt,
@@ -101,13 +208,34 @@ func toBookRecommendationResponse(cache *entity.BookRecommendationCache) BookRec
 	}
 }
 
+// 書籍のリストをレスポンス形式に変換する
+func toBookResponses(books []entity.Book) []BookResponse {
+	responses := make([]BookResponse, 0, len(books))
+	for _, book := range books {
+		responses = append(responses, toBookResponse(book))
+	}
+	return responses
+}
+
 // 書籍エンティティをレスポンス形式に変換する
 func toBookResponse(book entity.Book) BookResponse {
+	// 根拠の記事を保存していない古いキャッシュでも空配列を返す
+	sourceArticleIDs := book.SourceArticleIDs
+	if sourceArticleIDs == nil {
+		sourceArticleIDs = []int64{}
+	}
+
 	return BookResponse{
-		Title: book.Title,
+		Key:           book.Key(),
+		Title:         book.Title,
+		Author:        book.Author,
+		ISBN13:        book.ISBN13,
+		CoverImageURL: book.CoverImageURL,
+		Reason:        book.Reason,
 		PurchaseLinks: PurchaseLinksResponse{
 			Amazon:  book.PurchaseLinks.Amazon,
 			Rakuten: book.PurchaseLinks.Rakuten,
 		},
+		SourceArticleIDs: sourceArticleIDs,
 	}
 }
@@ -10,8 +10,10 @@ import (
 	"time"
 
 	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
 	"article-manager/internal/domain/repository"
 	"article-manager/internal/domain/service"
+	infrarepository "article-manager/internal/infrastructure/repository"
 	"article-manager/internal/usecase"
 
 	"github.com/stretchr/testify/assert"
@@ -20,7 +22,8 @@ import (
 
 // モック ArticleRepository
 type mockArticleRepositoryForHandler struct {
-	findAllFunc func(ctx context.Context) ([]*entity.Article, error)
+	findAllFunc  func(ctx context.Context) ([]*entity.Article, error)
+	findByIDFunc func(ctx context.Context, id int64) (*entity.Article, error)
 }
 
 func (m *mockArticleRepositoryForHandler) Create(ctx context.Context, article *entity.Article) (*entity.Article, error) {
@@ -28,6 +31,9 @@ func (m *mockArticleRepositoryForHandler) Create(ctx context.Context, article *e
 }
 
 func (m *mockArticleRepositoryForHandler) FindByID(ctx context.Context, id int64) (*entity.Article, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, id)
+	}
 	return nil, nil
 }
 
@@ -42,6 +48,18 @@ func (m *mockArticleRepositoryForHandler) Update(ctx context.Context, article *e
 	return nil, nil
 }
 
+func (m *mockArticleRepositoryForHandler) UpdateURL(ctx context.Context, article *entity.Article, previousURL string) error {
+	return nil
+}
+
+func (m *mockArticleRepositoryForHandler) UpdateMetadata(ctx context.Context, id int64, url string, metadata entity.ArticleMetadata) error {
+	return nil
+}
+
+func (m *mockArticleRepositoryForHandler) RecordMetadataFetchFailure(ctx context.Context, id int64, url string, failedAt time.Time) error {
+	return nil
+}
+
 func (m *mockArticleRepositoryForHandler) Delete(ctx context.Context, id int64) error {
 	return nil
 }
@@ -50,15 +68,25 @@ func (m *mockArticleRepositoryForHandler) Search(ctx context.Context, keyword st
 	return nil, nil
 }
 
+func (m *mockArticleRepositoryForHandler) FindByCreatedAtRange(ctx context.Context, from, to time.Time) ([]*entity.Article, error) {
+	return nil, nil
+}
+
+func (m *mockArticleRepositoryForHandler) FindTagGraph(ctx context.Context, opts entity.TagGraphOptions) (*entity.TagGraph, error) {
+	return entity.NewTagGraph(nil, nil), nil
+}
+
 // モック BookRecommendationRepository
 type mockBookRecommendationRepositoryForHandler struct {
-	findLatestValidFunc func(ctx context.Context) (*entity.BookRecommendationCache, error)
+	findLatestValidFunc func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error)
 	saveFunc            func(ctx context.Context, cache *entity.BookRecommendationCache) (*entity.BookRecommendationCache, error)
+	findHistoryFunc     func(ctx context.Context, scopeKey string, limit int) ([]*entity.BookRecommendationCache, error)
+	findByIDFunc        func(ctx context.Context, id int64) (*entity.BookRecommendationCache, error)
 }
 
-func (m *mockBookRecommendationRepositoryForHandler) FindLatestValid(ctx context.Context) (*entity.BookRecommendationCache, error) {
+func (m *mockBookRecommendationRepositoryForHandler) FindLatestValid(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 	if m.findLatestValidFunc != nil {
-		return m.findLatestValidFunc(ctx)
+		return m.findLatestValidFunc(ctx, scopeKey)
 	}
 	return nil, errors.New("not found")
 }
@@ -71,25 +99,55 @@ func (m *mockBookRecommendationRepositoryForHandler) Save(ctx context.Context, c
 	return cache, nil
 }
 
+func (m *mockBookRecommendationRepositoryForHandler) FindByID(ctx context.Context, id int64) (*entity.BookRecommendationCache, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, id)
+	}
+	return nil, domainerrors.NotFoundError("book_recommendation", id)
+}
+
+func (m *mockBookRecommendationRepositoryForHandler) FindHistory(ctx context.Context, scopeKey string, limit int) ([]*entity.BookRecommendationCache, error) {
+	if m.findHistoryFunc != nil {
+		return m.findHistoryFunc(ctx, scopeKey, limit)
+	}
+	return []*entity.BookRecommendationCache{}, nil
+}
+
+func (m *mockBookRecommendationRepositoryForHandler) FindValidExpiringBefore(ctx context.Context, before time.Time) ([]*entity.BookRecommendationCache, error) {
+	return []*entity.BookRecommendationCache{}, nil
+}
+
+func (m *mockBookRecommendationRepositoryForHandler) DeleteGeneratedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return 0, nil
+}
+
 // モック BookRecommendationService
 type mockBookRecommendationServiceForHandler struct {
-	recommendBooksFunc func(ctx context.Context, articles []*entity.Article) ([]entity.Book, error)
+	recommendBooksFunc func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error)
 }
 
-func (m *mockBookRecommendationServiceForHandler) RecommendBooks(ctx context.Context, articles []*entity.Article) ([]entity.Book, error) {
+func (m *mockBookRecommendationServiceForHandler) RecommendBooks(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
 	if m.recommendBooksFunc != nil {
-		return m.recommendBooksFunc(ctx, articles)
+		return m.recommendBooksFunc(ctx, articles, feedback)
 	}
 	return []entity.Book{}, nil
 }
 
+func (m *mockBookRecommendationServiceForHandler) RecommendationModel() string {
+	return "test-model"
+}
+
+func (m *mockBookRecommendationServiceForHandler) RecommendationPromptVersion() string {
+	return "test-prompt-v1"
+}
+
 // テスト用のハンドラをセットアップ
 func setupBookRecommendationHandler(
 	articleRepo repository.ArticleRepository,
 	bookRecommendationRepo repository.BookRecommendationRepository,
 	bookRecommendationService service.BookRecommendationService,
 ) *BookRecommendationHandler {
-	uc := usecase.NewBookRecommendationUsecase(articleRepo, bookRecommendationRepo, bookRecommendationService)
+	uc := usecase.NewBookRecommendationUsecase(articleRepo, infrarepository.NewMemoryTagRepository(entity.DefaultTagNamePolicy()), bookRecommendationRepo, bookRecommendationService, infrarepository.NewMemoryBookFeedbackRepository(), usecase.DefaultBookRecommendationConfig())
 	return NewBookRecommendationHandler(uc)
 }
 
@@ -102,7 +160,12 @@ func TestGetBookRecommendationsHandler(t *testing.T) {
 			ID: 1,
 			Books: []entity.Book{
 				{
-					Title: "リーダブルコード",
+					Title:            "リーダブルコード",
+					Author:           "Dustin Boswell, Trevor Foucher",
+					ISBN13:           "9784873115658",
+					CoverImageURL:    "https://books.google.com/books/content?id=1",
+					Reason:           "Goの記事から読みやすいコードへの関心がうかがえるため",
+					SourceArticleIDs: []int64{3, 5},
 					PurchaseLinks: entity.PurchaseLinks{
 						Amazon:  "https://www.amazon.co.jp/dp/4873115655",
 						Rakuten: "https://books.rakuten.co.jp/rb/11753651/",
@@ -120,13 +183,20 @@ func TestGetBookRecommendationsHandler(t *testing.T) {
 		}
 
 		mockBookRecommendationRepo := &mockBookRecommendationRepositoryForHandler{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return expectedCache, nil
 			},
 		}
 
+		// 推薦のきっかけとなった記事はキャッシュ後に変更されていない
+		mockArticleRepo := &mockArticleRepositoryForHandler{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return []*entity.Article{{ID: 3}, {ID: 5}}, nil
+			},
+		}
+
 		handler := setupBookRecommendationHandler(
-			&mockArticleRepositoryForHandler{},
+			mockArticleRepo,
 			mockBookRecommendationRepo,
 			&mockBookRecommendationServiceForHandler{},
 		)
@@ -150,12 +220,18 @@ func TestGetBookRecommendationsHandler(t *testing.T) {
 
 		// 1冊目の確認
 		assert.Equal(t, "リーダブルコード", response.Books[0].Title)
+		assert.Equal(t, "Dustin Boswell, Trevor Foucher", response.Books[0].Author)
+		assert.Equal(t, "9784873115658", response.Books[0].ISBN13)
+		assert.Equal(t, "https://books.google.com/books/content?id=1", response.Books[0].CoverImageURL)
+		assert.Equal(t, "Goの記事から読みやすいコードへの関心がうかがえるため", response.Books[0].Reason)
+		assert.Equal(t, []int64{3, 5}, response.Books[0].SourceArticleIDs)
 		assert.Equal(t, "https://www.amazon.co.jp/dp/4873115655", response.Books[0].PurchaseLinks.Amazon)
 		assert.Equal(t, "https://books.rakuten.co.jp/rb/11753651/", response.Books[0].PurchaseLinks.Rakuten)
 
 		// 2冊目の確認
 		assert.Equal(t, "Clean Code", response.Books[1].Title)
 		assert.Equal(t, "https://www.amazon.co.jp/dp/0132350884", response.Books[1].PurchaseLinks.Amazon)
+		assert.Equal(t, []int64{}, response.Books[1].SourceArticleIDs)
 
 		// キャッシュフラグとタイムスタンプの確認
 		assert.True(t, response.Cached)
@@ -171,7 +247,7 @@ func TestGetBookRecommendationsHandler(t *testing.T) {
 		}
 
 		mockBookRecommendationRepo := &mockBookRecommendationRepositoryForHandler{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return nil, errors.New("not found")
 			},
 		}
@@ -215,13 +291,13 @@ func TestGetBookRecommendationsHandler(t *testing.T) {
 		}
 
 		mockBookRecommendationRepo := &mockBookRecommendationRepositoryForHandler{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return nil, errors.New("not found")
 			},
 		}
 
 		mockService := &mockBookRecommendationServiceForHandler{
-			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article) ([]entity.Book, error) {
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
 				return []entity.Book{
 					{
 						Title: "プログラミング言語Go",
@@ -283,7 +359,7 @@ func TestGetBookRecommendationsHandler(t *testing.T) {
 		}
 
 		mockBookRecommendationRepo := &mockBookRecommendationRepositoryForHandler{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return expectedCache, nil
 			},
 		}
@@ -328,7 +404,7 @@ func TestGetBookRecommendationsHandler(t *testing.T) {
 		}
 
 		mockBookRecommendationRepo := &mockBookRecommendationRepositoryForHandler{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return expectedCache, nil
 			},
 		}
@@ -363,7 +439,7 @@ func TestGetBookRecommendationsHandler(t *testing.T) {
 		}
 
 		mockBookRecommendationRepo := &mockBookRecommendationRepositoryForHandler{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return nil, errors.New("not found")
 			},
 		}
@@ -404,13 +480,13 @@ func TestGetBookRecommendationsHandler(t *testing.T) {
 		}
 
 		mockBookRecommendationRepo := &mockBookRecommendationRepositoryForHandler{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return nil, errors.New("not found")
 			},
 		}
 
 		mockService := &mockBookRecommendationServiceForHandler{
-			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article) ([]entity.Book, error) {
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
 				return nil, &service.BookRecommendationError{
 					Code:    service.ErrCodeAIError,
 					Message: "AI API error",
@@ -451,7 +527,7 @@ func TestGetBookRecommendationsHandler(t *testing.T) {
 		}
 
 		mockBookRecommendationRepo := &mockBookRecommendationRepositoryForHandler{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return nil, errors.New("not found")
 			},
 			saveFunc: func(ctx context.Context, cache *entity.BookRecommendationCache) (*entity.BookRecommendationCache, error) {
@@ -460,7 +536,7 @@ func TestGetBookRecommendationsHandler(t *testing.T) {
 		}
 
 		mockService := &mockBookRecommendationServiceForHandler{
-			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article) ([]entity.Book, error) {
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
 				return []entity.Book{
 					{
 						Title: "書籍タイトル",
@@ -488,3 +564,159 @@ func TestGetBookRecommendationsHandler(t *testing.T) {
 		assert.Contains(t, response, "error")
 	})
 }
+
+// 対象範囲を指定した書籍推薦のテスト
+func TestGetBookRecommendationsHandler_Scope(t *testing.T) {
+	articles := []*entity.Article{
+		{ID: 1, Title: "Kubernetes入門", Tags: []string{"Kubernetes"}},
+		{ID: 2, Title: "Go言語入門", Tags: []string{"Go"}},
+	}
+	newService := func(received *[]*entity.Article) *mockBookRecommendationServiceForHandler {
+		return &mockBookRecommendationServiceForHandler{
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
+				*received = articles
+				return []entity.Book{{Title: "推薦書籍", SourceArticleIDs: []int64{articles[0].ID}}}, nil
+			},
+		}
+	}
+
+	t.Run("正常系：tagクエリのタグの記事から推薦する", func(t *testing.T) {
+		var received []*entity.Article
+		mockArticleRepo := &mockArticleRepositoryForHandler{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return articles, nil
+			},
+		}
+		handler := setupBookRecommendationHandler(mockArticleRepo, &mockBookRecommendationRepositoryForHandler{}, newService(&received))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/book-recommendations?tag=KUBERNETES", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetBookRecommendations(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response BookRecommendationResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "tag:kubernetes", response.Scope)
+		require.Len(t, received, 1)
+		assert.Equal(t, int64(1), received[0].ID)
+	})
+
+	t.Run("正常系：記事に基づいて推薦する", func(t *testing.T) {
+		var received []*entity.Article
+		mockArticleRepo := &mockArticleRepositoryForHandler{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.Article, error) {
+				return articles[1], nil
+			},
+		}
+		handler := setupBookRecommendationHandler(mockArticleRepo, &mockBookRecommendationRepositoryForHandler{}, newService(&received))
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/2/book-recommendations", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetArticleBookRecommendations(rec, req, 2)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response BookRecommendationResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "article:2", response.Scope)
+		require.Len(t, response.Books, 1)
+		assert.Equal(t, []int64{2}, response.Books[0].SourceArticleIDs)
+	})
+
+	t.Run("異常系：記事が存在しない", func(t *testing.T) {
+		mockArticleRepo := &mockArticleRepositoryForHandler{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.Article, error) {
+				return nil, domainerrors.NotFoundError("article", id)
+			},
+		}
+		handler := setupBookRecommendationHandler(mockArticleRepo, &mockBookRecommendationRepositoryForHandler{}, &mockBookRecommendationServiceForHandler{})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/articles/999/book-recommendations", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetArticleBookRecommendations(rec, req, 999)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+// GET /api/book-recommendations/historyのテスト
+func TestGetBookRecommendationHistoryHandler(t *testing.T) {
+	t.Run("正常系：推薦の履歴と追加・削除された書籍を返す", func(t *testing.T) {
+		now := time.Now()
+		mockRepo := &mockBookRecommendationRepositoryForHandler{
+			findHistoryFunc: func(ctx context.Context, scopeKey string, limit int) ([]*entity.BookRecommendationCache, error) {
+				assert.Equal(t, "article:5", scopeKey)
+				return []*entity.BookRecommendationCache{
+					{ID: 2, ScopeKey: scopeKey, Books: []entity.Book{{Title: "Go言語による並行処理"}}, ArticleCount: 1, Model: "test-model", PromptVersion: "v4", GeneratedAt: now, ExpiresAt: now.Add(time.Hour)},
+					{ID: 1, ScopeKey: scopeKey, Books: []entity.Book{{Title: "プログラミング言語Go"}}, GeneratedAt: now.Add(-time.Hour), ExpiresAt: now},
+				}, nil
+			},
+		}
+		handler := setupBookRecommendationHandler(&mockArticleRepositoryForHandler{}, mockRepo, &mockBookRecommendationServiceForHandler{})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/book-recommendations/history?articleId=5&limit=1", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetBookRecommendationHistory(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response []BookRecommendationHistoryResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Len(t, response, 1)
+		assert.Equal(t, int64(2), response[0].ID)
+		assert.Equal(t, "v4", response[0].PromptVersion)
+		assert.Equal(t, 1, response[0].ArticleCount)
+		require.Len(t, response[0].Added, 1)
+		assert.Equal(t, "Go言語による並行処理", response[0].Added[0].Title)
+		require.Len(t, response[0].Removed, 1)
+		assert.Equal(t, "プログラミング言語Go", response[0].Removed[0].Title)
+	})
+
+	t.Run("異常系：limitが整数でない", func(t *testing.T) {
+		handler := setupBookRecommendationHandler(&mockArticleRepositoryForHandler{}, &mockBookRecommendationRepositoryForHandler{}, &mockBookRecommendationServiceForHandler{})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/book-recommendations/history?limit=abc", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetBookRecommendationHistory(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// POST /api/book-recommendations/refreshのテスト
+func TestRefreshBookRecommendationsHandler(t *testing.T) {
+	t.Run("正常系：有効なキャッシュがあっても生成し直す", func(t *testing.T) {
+		mockRepo := &mockBookRecommendationRepositoryForHandler{
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
+				t.Fatal("refresh must not read the cache")
+				return nil, nil
+			},
+		}
+		mockArticleRepo := &mockArticleRepositoryForHandler{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.Article, error) {
+				return &entity.Article{ID: id, Title: "Go言語入門"}, nil
+			},
+		}
+		mockService := &mockBookRecommendationServiceForHandler{
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
+				return []entity.Book{{Title: "プログラミング言語Go"}}, nil
+			},
+		}
+		handler := setupBookRecommendationHandler(mockArticleRepo, mockRepo, mockService)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/book-recommendations/refresh?articleId=3", nil)
+		rec := httptest.NewRecorder()
+
+		handler.RefreshBookRecommendations(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response BookRecommendationResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "article:3", response.Scope)
+		require.Len(t, response.Books, 1)
+		assert.Equal(t, "プログラミング言語Go", response.Books[0].Title)
+	})
+}
@@ -0,0 +1,298 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/infrastructure/logger"
+	"article-manager/internal/infrastructure/timeutil"
+	"article-manager/internal/usecase"
+
+	"go.uber.org/zap"
+)
+
+// コレクションに関するHTTPハンドラ
+type CollectionHandler struct {
+	usecase *usecase.CollectionUsecase
+}
+
+// コンストラクタ
+func NewCollectionHandler(uc *usecase.CollectionUsecase) *CollectionHandler {
+	return &CollectionHandler{
+		usecase: uc,
+	}
+}
+
+// コレクション作成リクエストの構造体
+type CreateCollectionRequest struct {
+	Name        string  `json:"name"`
+	Description string  `json:"description"`
+	ArticleIDs  []int64 `json:"article_ids"`
+}
+
+// コレクション更新リクエストの構造体
+type UpdateCollectionRequest struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// コレクションへの記事追加リクエストの構造体
+type AddCollectionArticleRequest struct {
+	ArticleID int64 `json:"article_id"`
+	Position  int   `json:"position"` // 1始まり（0または省略時は末尾）
+}
+
+// コレクションの記事の並べ替えリクエストの構造体
+type ReorderCollectionArticlesRequest struct {
+	ArticleIDs []int64 `json:"article_ids"`
+}
+
+// コレクションの記事の移動リクエストの構造体
+type MoveCollectionArticleRequest struct {
+	CollectionID int64 `json:"collection_id"` // 移動先のコレクション（0または省略時は同じコレクション内）
+	Position     int   `json:"position"`      // 1始まり（0または省略時は末尾）
+}
+
+// コレクションのレスポンス構造体
+type CollectionResponse struct {
+	ID           int64   `json:"id"`
+	Name         string  `json:"name"`
+	Description  string  `json:"description"`
+	ArticleIDs   []int64 `json:"article_ids"`
+	ArticleCount int     `json:"article_count"`
+	CreatedAt    string  `json:"created_at"`
+	UpdatedAt    string  `json:"updated_at"`
+}
+
+// 掲載する記事付きのコレクションのレスポンス構造体
+type CollectionDetailResponse struct {
+	CollectionResponse
+	Articles []ArticleResponse `json:"articles"`
+}
+
+// すべてのコレクションを名前順に取得
+func (h *CollectionHandler) GetCollections(w http.ResponseWriter, r *http.Request) {
+	collections, err := h.usecase.GetCollections(r.Context())
+	if err != nil {
+		HandleError(w, err, "GetCollections")
+		return
+	}
+
+	response := make([]CollectionResponse, 0, len(collections))
+	for _, collection := range collections {
+		response = append(response, toCollectionResponse(collection))
+	}
+
+	logger.Info("Successfully retrieved collections",
+		zap.Int("count", len(collections)),
+	)
+
+	RespondSuccess(w, http.StatusOK, response)
+}
+
+// 新しいコレクションを作成
+func (h *CollectionHandler) CreateCollection(w http.ResponseWriter, r *http.Request) {
+	var req CreateCollectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Failed to decode request body",
+			zap.Error(err),
+			zap.String("operation", "CreateCollection"),
+		)
+		HandleError(w, domainerrors.InvalidArgumentError("request body", "invalid JSON format"), "CreateCollection")
+		return
+	}
+
+	collection, err := h.usecase.CreateCollection(r.Context(), usecase.CreateCollectionInput{
+		Name:        req.Name,
+		Description: req.Description,
+		ArticleIDs:  req.ArticleIDs,
+	})
+	if err != nil {
+		HandleError(w, err, "CreateCollection")
+		return
+	}
+
+	RespondSuccess(w, http.StatusCreated, toCollectionResponse(collection))
+}
+
+// 指定されたIDのコレクションを掲載順の記事付きで取得
+func (h *CollectionHandler) GetCollectionByID(w http.ResponseWriter, r *http.Request, id int64) {
+	detail, err := h.usecase.GetCollection(r.Context(), id)
+	if err != nil {
+		HandleError(w, err, "GetCollectionByID")
+		return
+	}
+
+	articles := make([]ArticleResponse, 0, len(detail.Articles))
+	for _, article := range detail.Articles {
+		articles = append(articles, toArticleResponse(article))
+	}
+
+	RespondSuccess(w, http.StatusOK, CollectionDetailResponse{
+		CollectionResponse: toCollectionResponse(detail.Collection),
+		Articles:           articles,
+	})
+}
+
+// コレクションの名前と説明を更新
+func (h *CollectionHandler) UpdateCollection(w http.ResponseWriter, r *http.Request, id int64) {
+	var req UpdateCollectionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Failed to decode request body",
+			zap.Error(err),
+			zap.String("operation", "UpdateCollection"),
+		)
+		HandleError(w, domainerrors.InvalidArgumentError("request body", "invalid JSON format"), "UpdateCollection")
+		return
+	}
+
+	collection, err := h.usecase.UpdateCollection(r.Context(), id, usecase.UpdateCollectionInput{
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		HandleError(w, err, "UpdateCollection")
+		return
+	}
+
+	RespondSuccess(w, http.StatusOK, toCollectionResponse(collection))
+}
+
+// 指定されたIDのコレクションを削除（記事は削除しない）
+func (h *CollectionHandler) DeleteCollection(w http.ResponseWriter, r *http.Request, id int64) {
+	if err := h.usecase.DeleteCollection(r.Context(), id); err != nil {
+		HandleError(w, err, "DeleteCollection")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// コレクションをMarkdownの読書リストとして出力
+func (h *CollectionHandler) ExportCollection(w http.ResponseWriter, r *http.Request, id int64) {
+	markdown, err := h.usecase.ExportMarkdown(r.Context(), id)
+	if err != nil {
+		HandleError(w, err, "ExportCollection")
+		return
+	}
+
+	RespondText(w, "text/markdown; charset=utf-8", markdown)
+}
+
+// 記事をコレクションに追加
+func (h *CollectionHandler) AddCollectionArticle(w http.ResponseWriter, r *http.Request, id int64) {
+	var req AddCollectionArticleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Failed to decode request body",
+			zap.Error(err),
+			zap.String("operation", "AddCollectionArticle"),
+		)
+		HandleError(w, domainerrors.InvalidArgumentError("request body", "invalid JSON format"), "AddCollectionArticle")
+		return
+	}
+
+	collection, err := h.usecase.AddArticle(r.Context(), id, req.ArticleID, req.Position)
+	if err != nil {
+		HandleError(w, err, "AddCollectionArticle")
+		return
+	}
+
+	RespondSuccess(w, http.StatusOK, toCollectionResponse(collection))
+}
+
+// コレクションの記事を並べ替える
+func (h *CollectionHandler) ReorderCollectionArticles(w http.ResponseWriter, r *http.Request, id int64) {
+	var req ReorderCollectionArticlesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Failed to decode request body",
+			zap.Error(err),
+			zap.String("operation", "ReorderCollectionArticles"),
+		)
+		HandleError(w, domainerrors.InvalidArgumentError("request body", "invalid JSON format"), "ReorderCollectionArticles")
+		return
+	}
+
+	collection, err := h.usecase.ReorderArticles(r.Context(), id, req.ArticleIDs)
+	if err != nil {
+		HandleError(w, err, "ReorderCollectionArticles")
+		return
+	}
+
+	RespondSuccess(w, http.StatusOK, toCollectionResponse(collection))
+}
+
+// 記事を同じコレクション内の別の位置、または別のコレクションに移動し、移動先のコレクションを返す
+func (h *CollectionHandler) MoveCollectionArticle(w http.ResponseWriter, r *http.Request, id int64) {
+	articleID, err := collectionArticleIDFromPath(r)
+	if err != nil {
+		HandleError(w, err, "MoveCollectionArticle")
+		return
+	}
+	var req MoveCollectionArticleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Failed to decode request body",
+			zap.Error(err),
+			zap.String("operation", "MoveCollectionArticle"),
+		)
+		HandleError(w, domainerrors.InvalidArgumentError("request body", "invalid JSON format"), "MoveCollectionArticle")
+		return
+	}
+
+	collection, err := h.usecase.MoveArticle(r.Context(), id, articleID, usecase.MoveCollectionArticleInput{
+		CollectionID: req.CollectionID,
+		Position:     req.Position,
+	})
+	if err != nil {
+		HandleError(w, err, "MoveCollectionArticle")
+		return
+	}
+
+	RespondSuccess(w, http.StatusOK, toCollectionResponse(collection))
+}
+
+// 記事をコレクションから外す（記事は削除しない）
+func (h *CollectionHandler) RemoveCollectionArticle(w http.ResponseWriter, r *http.Request, id int64) {
+	articleID, err := collectionArticleIDFromPath(r)
+	if err != nil {
+		HandleError(w, err, "RemoveCollectionArticle")
+		return
+	}
+
+	collection, err := h.usecase.RemoveArticle(r.Context(), id, articleID)
+	if err != nil {
+		HandleError(w, err, "RemoveCollectionArticle")
+		return
+	}
+
+	RespondSuccess(w, http.StatusOK, toCollectionResponse(collection))
+}
+
+// パスから記事のIDを取得する
+func collectionArticleIDFromPath(r *http.Request) (int64, error) {
+	articleID, err := strconv.ParseInt(r.PathValue("articleId"), 10, 64)
+	if err != nil {
+		return 0, domainerrors.InvalidArgumentError("article_id", "article id must be an integer")
+	}
+	return articleID, nil
+}
+
+// エンティティをレスポンス形式に変換する
+func toCollectionResponse(collection *entity.Collection) CollectionResponse {
+	articleIDs := collection.ArticleIDs
+	if articleIDs == nil {
+		articleIDs = []int64{}
+	}
+
+	return CollectionResponse{
+		ID:           collection.ID,
+		Name:         collection.Name,
+		Description:  collection.Description,
+		ArticleIDs:   articleIDs,
+		ArticleCount: len(articleIDs),
+		CreatedAt:    timeutil.MustFormatInJST(collection.CreatedAt),
+		UpdatedAt:    timeutil.MustFormatInJST(collection.UpdatedAt),
+	}
+}
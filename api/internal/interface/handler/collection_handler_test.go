@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/infrastructure/repository"
+	"article-manager/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// テスト用のハンドラのセットアップ（ID 1〜3の記事を登録済み）
+func setupCollectionHandler(t *testing.T) *CollectionHandler {
+	t.Helper()
+	articleRepo := repository.NewMemoryArticleRepository()
+	for _, title := range []string{"Go入門", "Docker入門", "AWS入門"} {
+		article, err := entity.NewArticle(title, "https://example.com/"+title, title+"の基本", nil, "")
+		require.NoError(t, err)
+		_, err = articleRepo.Create(context.Background(), article)
+		require.NoError(t, err)
+	}
+
+	uc := usecase.NewCollectionUsecase(repository.NewMemoryCollectionRepository(), articleRepo)
+	return NewCollectionHandler(uc)
+}
+
+func postCollection(t *testing.T, handler *CollectionHandler, requestBody map[string]interface{}) CollectionResponse {
+	t.Helper()
+	body, _ := json.Marshal(requestBody)
+	req := httptest.NewRequest(http.MethodPost, "/api/collections", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.CreateCollection(rec, req)
+	require.Equal(t, http.StatusCreated, rec.Code, rec.Body.String())
+
+	var response CollectionResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	return response
+}
+
+// POST /api/collectionsのテスト
+func TestCreateCollection(t *testing.T) {
+	t.Run("正常系：記事を含むコレクションを作成できる", func(t *testing.T) {
+		handler := setupCollectionHandler(t)
+
+		response := postCollection(t, handler, map[string]interface{}{
+			"name":        "新人向け",
+			"description": "最初に読む記事",
+			"article_ids": []int64{2, 1},
+		})
+
+		assert.Equal(t, "新人向け", response.Name)
+		assert.Equal(t, []int64{2, 1}, response.ArticleIDs)
+		assert.Equal(t, 2, response.ArticleCount)
+	})
+
+	t.Run("異常系：同じ名前のコレクションは作成できない", func(t *testing.T) {
+		handler := setupCollectionHandler(t)
+		postCollection(t, handler, map[string]interface{}{"name": "新人向け"})
+
+		body, _ := json.Marshal(map[string]interface{}{"name": "新人向け"})
+		req := httptest.NewRequest(http.MethodPost, "/api/collections", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.CreateCollection(rec, req)
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+
+	t.Run("異常系：不正なJSONの場合は400を返す", func(t *testing.T) {
+		handler := setupCollectionHandler(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/collections", strings.NewReader("{"))
+		rec := httptest.NewRecorder()
+		handler.CreateCollection(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// GET /api/collections/{id}のテスト
+func TestGetCollectionByID(t *testing.T) {
+	t.Run("正常系：掲載順の記事付きで取得できる", func(t *testing.T) {
+		handler := setupCollectionHandler(t)
+		created := postCollection(t, handler, map[string]interface{}{"name": "新人向け", "article_ids": []int64{3, 1}})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/collections/1", nil)
+		rec := httptest.NewRecorder()
+		handler.GetCollectionByID(rec, req, created.ID)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response CollectionDetailResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Len(t, response.Articles, 2)
+		assert.Equal(t, "AWS入門", response.Articles[0].Title)
+		assert.Equal(t, "Go入門", response.Articles[1].Title)
+	})
+
+	t.Run("異常系：存在しないコレクションの場合は404を返す", func(t *testing.T) {
+		handler := setupCollectionHandler(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/collections/99", nil)
+		rec := httptest.NewRecorder()
+		handler.GetCollectionByID(rec, req, 99)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+// PUT /api/collections/{id}/articles/orderのテスト
+func TestReorderCollectionArticles(t *testing.T) {
+	t.Run("正常系：記事を並べ替えられる", func(t *testing.T) {
+		handler := setupCollectionHandler(t)
+		created := postCollection(t, handler, map[string]interface{}{"name": "新人向け", "article_ids": []int64{1, 2, 3}})
+
+		body, _ := json.Marshal(map[string]interface{}{"article_ids": []int64{3, 1, 2}})
+		req := httptest.NewRequest(http.MethodPut, "/api/collections/1/articles/order", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.ReorderCollectionArticles(rec, req, created.ID)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response CollectionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, []int64{3, 1, 2}, response.ArticleIDs)
+	})
+
+	t.Run("異常系：記事が一致しない場合は400を返す", func(t *testing.T) {
+		handler := setupCollectionHandler(t)
+		created := postCollection(t, handler, map[string]interface{}{"name": "新人向け", "article_ids": []int64{1, 2}})
+
+		body, _ := json.Marshal(map[string]interface{}{"article_ids": []int64{1}})
+		req := httptest.NewRequest(http.MethodPut, "/api/collections/1/articles/order", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.ReorderCollectionArticles(rec, req, created.ID)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// POST /api/collections/{id}/articles/{articleId}/moveのテスト
+func TestMoveCollectionArticle(t *testing.T) {
+	t.Run("正常系：別のコレクションに移動できる", func(t *testing.T) {
+		handler := setupCollectionHandler(t)
+		source := postCollection(t, handler, map[string]interface{}{"name": "Go", "article_ids": []int64{1, 2}})
+		target := postCollection(t, handler, map[string]interface{}{"name": "Docker", "article_ids": []int64{3}})
+
+		body, _ := json.Marshal(map[string]interface{}{"collection_id": target.ID, "position": 1})
+		req := httptest.NewRequest(http.MethodPost, "/api/collections/1/articles/2/move", bytes.NewReader(body))
+		req.SetPathValue("articleId", "2")
+		rec := httptest.NewRecorder()
+		handler.MoveCollectionArticle(rec, req, source.ID)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response CollectionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, target.ID, response.ID)
+		assert.Equal(t, []int64{2, 3}, response.ArticleIDs)
+	})
+
+	t.Run("異常系：記事のIDが整数でない場合は400を返す", func(t *testing.T) {
+		handler := setupCollectionHandler(t)
+		source := postCollection(t, handler, map[string]interface{}{"name": "Go", "article_ids": []int64{1}})
+
+		req := httptest.NewRequest(http.MethodPost, "/api/collections/1/articles/abc/move", strings.NewReader("{}"))
+		req.SetPathValue("articleId", "abc")
+		rec := httptest.NewRecorder()
+		handler.MoveCollectionArticle(rec, req, source.ID)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// GET /api/collections/{id}/exportのテスト
+func TestExportCollection(t *testing.T) {
+	t.Run("正常系：Markdownの読書リストとして出力できる", func(t *testing.T) {
+		handler := setupCollectionHandler(t)
+		created := postCollection(t, handler, map[string]interface{}{"name": "新人向け", "article_ids": []int64{2, 1}})
+
+		req := httptest.NewRequest(http.MethodGet, "/api/collections/1/export", nil)
+		rec := httptest.NewRecorder()
+		handler.ExportCollection(rec, req, created.ID)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Header().Get("Content-Type"), "text/markdown")
+		assert.Equal(t, "# 新人向け\n\n"+
+			"1. [Docker入門](<https://example.com/Docker入門>)\n   Docker入門の基本\n"+
+			"2. [Go入門](<https://example.com/Go入門>)\n   Go入門の基本\n", rec.Body.String())
+	})
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"net/http"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/infrastructure/logger"
+	"article-manager/internal/infrastructure/timeutil"
+	"article-manager/internal/usecase"
+
+	"go.uber.org/zap"
+)
+
+// ダイジェストに関するHTTPハンドラ
+type DigestHandler struct {
+	digestUsecase *usecase.DigestUsecase
+}
+
+// コンストラクタ
+func NewDigestHandler(uc *usecase.DigestUsecase) *DigestHandler {
+	return &DigestHandler{
+		digestUsecase: uc,
+	}
+}
+
+// ダイジェスト一覧のレスポンス構造体
+type DigestSummaryResponse struct {
+	ID           int64  `json:"id"`
+	PeriodStart  string `json:"period_start"`
+	PeriodEnd    string `json:"period_end"`
+	ArticleCount int    `json:"article_count"`
+	GroupCount   int    `json:"group_count"`
+	CreatedAt    string `json:"created_at"`
+}
+
+// ダイジェストに掲載された記事のレスポンス構造体
+type DigestArticleResponse struct {
+	ArticleID int64  `json:"article_id"`
+	Title     string `json:"title"`
+	URL       string `json:"url"`
+}
+
+// タグごとのまとめのレスポンス構造体
+type DigestGroupResponse struct {
+	Tag      string                  `json:"tag"`
+	Overview string                  `json:"overview"`
+	Articles []DigestArticleResponse `json:"articles"`
+}
+
+// ダイジェスト詳細のレスポンス構造体
+type DigestResponse struct {
+	DigestSummaryResponse
+	Groups   []DigestGroupResponse `json:"groups"`
+	Markdown string                `json:"markdown"`
+	HTML     string                `json:"html"`
+}
+
+// 全ダイジェストの取得（新しい期間順）
+func (h *DigestHandler) GetDigests(w http.ResponseWriter, r *http.Request) {
+	digests, err := h.digestUsecase.GetDigests(r.Context())
+	if err != nil {
+		HandleError(w, err, "GetDigests")
+		return
+	}
+
+	response := make([]DigestSummaryResponse, 0, len(digests))
+	for _, digest := range digests {
+		response = append(response, toDigestSummaryResponse(digest))
+	}
+
+	logger.Info("Successfully retrieved digests",
+		zap.Int("count", len(digests)),
+	)
+
+	RespondSuccess(w, http.StatusOK, response)
+}
+
+// 指定されたIDのダイジェストを取得
+func (h *DigestHandler) GetDigestByID(w http.ResponseWriter, r *http.Request, id int64) {
+	digest, err := h.digestUsecase.GetDigest(r.Context(), id)
+	if err != nil {
+		HandleError(w, err, "GetDigestByID")
+		return
+	}
+
+	groups := make([]DigestGroupResponse, 0, len(digest.Groups))
+	for _, group := range digest.Groups {
+		articles := make([]DigestArticleResponse, 0, len(group.Articles))
+		for _, article := range group.Articles {
+			articles = append(articles, DigestArticleResponse{
+				ArticleID: article.ArticleID,
+				Title:     article.Title,
+				URL:       article.URL,
+			})
+		}
+		groups = append(groups, DigestGroupResponse{
+			Tag:      group.Tag,
+			Overview: group.Overview,
+			Articles: articles,
+		})
+	}
+
+	RespondSuccess(w, http.StatusOK, DigestResponse{
+		DigestSummaryResponse: toDigestSummaryResponse(digest),
+		Groups:                groups,
+		Markdown:              digest.Markdown,
+		HTML:                  digest.HTML,
+	})
+}
+
+func toDigestSummaryResponse(digest *entity.Digest) DigestSummaryResponse {
+	return DigestSummaryResponse{
+		ID:           digest.ID,
+		PeriodStart:  timeutil.MustFormatInJST(digest.PeriodStart),
+		PeriodEnd:    timeutil.MustFormatInJST(digest.PeriodEnd),
+		ArticleCount: digest.ArticleCount,
+		GroupCount:   len(digest.Groups),
+		CreatedAt:    timeutil.MustFormatInJST(digest.CreatedAt),
+	}
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/domain/service"
+	"article-manager/internal/infrastructure/repository"
+	"article-manager/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// 固定の概要を返すDigestSummarizer
+type stubDigestSummarizer struct{}
+
+func (stubDigestSummarizer) SummarizeDigestGroup(ctx context.Context, req service.DigestGroupRequest) (string, error) {
+	return req.Tag + "の概要", nil
+}
+
+// 1件のダイジェストを生成したハンドラのセットアップ
+func setupDigestHandler(t *testing.T) (*DigestHandler, *entity.Digest) {
+	t.Helper()
+	articleRepo := repository.NewMemoryArticleRepository()
+	article, err := entity.NewArticle("Goroutine入門", "https://example.com/goroutine", "Goの並行処理", []string{"Go"}, "")
+	require.NoError(t, err)
+	_, err = articleRepo.Create(context.Background(), article)
+	require.NoError(t, err)
+
+	uc := usecase.NewDigestUsecase(articleRepo, repository.NewMemoryDigestRepository(), stubDigestSummarizer{}, 7*24*time.Hour)
+	now := time.Now()
+	digest, err := uc.GenerateDigest(context.Background(), now.Add(-time.Hour), now.Add(time.Hour))
+	require.NoError(t, err)
+
+	return NewDigestHandler(uc), digest
+}
+
+// GET /api/digestsのテスト
+func TestGetDigests(t *testing.T) {
+	t.Run("正常系：ダイジェストの一覧を返す", func(t *testing.T) {
+		handler, digest := setupDigestHandler(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/digests", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetDigests(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response []DigestSummaryResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Len(t, response, 1)
+		assert.Equal(t, digest.ID, response[0].ID)
+		assert.Equal(t, 1, response[0].ArticleCount)
+		assert.Equal(t, 1, response[0].GroupCount)
+	})
+}
+
+// GET /api/digests/{id}のテスト
+func TestGetDigestByID(t *testing.T) {
+	t.Run("正常系：ダイジェストの詳細を返す", func(t *testing.T) {
+		handler, digest := setupDigestHandler(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/digests/1", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetDigestByID(rec, req, digest.ID)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response DigestResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Len(t, response.Groups, 1)
+		assert.Equal(t, "Go", response.Groups[0].Tag)
+		assert.Equal(t, "Goの概要", response.Groups[0].Overview)
+		assert.Equal(t, "Goroutine入門", response.Groups[0].Articles[0].Title)
+		assert.Contains(t, response.Markdown, "## Go（1件）")
+		assert.Contains(t, response.HTML, "<h2>Go（1件）</h2>")
+	})
+
+	t.Run("異常系：存在しないダイジェスト", func(t *testing.T) {
+		handler, _ := setupDigestHandler(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/digests/999", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetDigestByID(rec, req, 999)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
@@ -2,6 +2,7 @@ package handler
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	domainerrors "article-manager/internal/domain/errors"
@@ -42,8 +43,9 @@ func mapErrorToResponse(err error) (int, ErrorResponse) {
 		}
 	}
 
-	// AIGeneratorErrorの場合
-	if aiErr, ok := err.(*service.AIGeneratorError); ok {
+	// AIGeneratorErrorの場合（書籍推薦などでラップされている場合も含む）
+	var aiErr *service.AIGeneratorError
+	if errors.As(err, &aiErr) {
 		statusCode := getStatusCodeFromAIError(aiErr)
 		return statusCode, ErrorResponse{
 			Error: aiErr.Message,
@@ -87,12 +89,14 @@ func getStatusCodeFromErrorCode(code domainerrors.ErrorCode) int {
 // AIエラーをHTTPステータスコードにマッピング
 func getStatusCodeFromAIError(aiErr *service.AIGeneratorError) int {
 	switch aiErr.Code {
-	case service.ErrCodeAPILimit:
+	case service.ErrCodeAPILimit, service.ErrCodeBudgetExceeded:
 		return http.StatusTooManyRequests
 	case service.ErrCodeTimeout:
 		return http.StatusGatewayTimeout
 	case service.ErrCodeInvalidResponse, service.ErrCodeNetworkError:
 		return http.StatusBadGateway
+	case service.ErrCodeServiceUnavailable:
+		return http.StatusServiceUnavailable
 	case service.ErrCodeUnauthorized:
 		return http.StatusUnauthorized
 	case service.ErrCodeContentBlocked:
@@ -155,3 +159,12 @@ func respondJSON(w http.ResponseWriter, statusCode int, data interface{}) {
 func RespondSuccess(w http.ResponseWriter, statusCode int, data interface{}) {
 	respondJSON(w, statusCode, data)
 }
+
+// JSON以外の形式の成功レスポンスを送信
+func RespondText(w http.ResponseWriter, contentType string, body string) {
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write([]byte(body)); err != nil {
+		logger.Error("Failed to write response", zap.Error(err))
+	}
+}
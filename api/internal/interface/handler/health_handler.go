@@ -3,17 +3,65 @@ package handler
 import (
 	"encoding/json"
 	"net/http"
+
+	"article-manager/internal/infrastructure/resilience"
+	"article-manager/internal/infrastructure/timeutil"
+)
+
+// ヘルスチェックの状態
+const (
+	healthStatusHealthy  = "healthy"
+	healthStatusDegraded = "degraded"
 )
 
 // HealthResponse はヘルスチェックのレスポンス構造体
 type HealthResponse struct {
-	Status string `json:"status"`
+	Status          string                            `json:"status"`
+	CircuitBreakers map[string]CircuitBreakerResponse `json:"circuit_breakers,omitempty"`
+}
+
+// サーキットブレーカーの状態のレスポンス構造体
+type CircuitBreakerResponse struct {
+	State               string `json:"state"`
+	ConsecutiveFailures int    `json:"consecutive_failures"`
+	OpenedAt            string `json:"opened_at,omitempty"`
+}
+
+// ヘルスチェックハンドラー
+type HealthHandler struct {
+	breakers []*resilience.CircuitBreaker
 }
 
-// HealthCheckHandler はヘルスチェックエンドポイントのハンドラー
-func HealthCheckHandler(w http.ResponseWriter, r *http.Request) {
+// 外部API呼び出しのサーキットブレーカーの状態をヘルスチェックに含める
+func NewHealthHandler(breakers ...*resilience.CircuitBreaker) *HealthHandler {
+	return &HealthHandler{
+		breakers: breakers,
+	}
+}
+
+// HealthCheck はヘルスチェックエンドポイントのハンドラー
+// サーキットが開いている外部APIがある場合はdegradedを返す
+func (h *HealthHandler) HealthCheck(w http.ResponseWriter, r *http.Request) {
 	response := HealthResponse{
-		Status: "healthy",
+		Status: healthStatusHealthy,
+	}
+
+	if len(h.breakers) > 0 {
+		response.CircuitBreakers = make(map[string]CircuitBreakerResponse, len(h.breakers))
+	}
+	for _, breaker := range h.breakers {
+		snapshot := breaker.Snapshot()
+		breakerResponse := CircuitBreakerResponse{
+			State:               string(snapshot.State),
+			ConsecutiveFailures: snapshot.ConsecutiveFailures,
+		}
+		if !snapshot.OpenedAt.IsZero() {
+			breakerResponse.OpenedAt = timeutil.MustFormatInJST(snapshot.OpenedAt)
+		}
+		if snapshot.State != resilience.BreakerStateClosed {
+			response.Status = healthStatusDegraded
+		}
+		response.CircuitBreakers[snapshot.Name] = breakerResponse
 	}
 
 	w.Header().Set("Content-Type", "application/json")
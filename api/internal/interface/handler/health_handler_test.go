@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"article-manager/internal/infrastructure/resilience"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// GET /api/healthのテスト
+func TestHealthCheck(t *testing.T) {
+	t.Run("正常系：全てのサーキットが閉じている場合はhealthy", func(t *testing.T) {
+		handler := NewHealthHandler(resilience.NewCircuitBreaker("gemini", 3, time.Minute))
+
+		rec := httptest.NewRecorder()
+		handler.HealthCheck(rec, httptest.NewRequest(http.MethodGet, "/api/health", nil))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response HealthResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "healthy", response.Status)
+		assert.Equal(t, "closed", response.CircuitBreakers["gemini"].State)
+	})
+
+	t.Run("正常系：サーキットが開いている場合はdegraded", func(t *testing.T) {
+		breaker := resilience.NewCircuitBreaker("gemini", 1, time.Minute)
+		breaker.RecordFailure()
+		handler := NewHealthHandler(breaker)
+
+		rec := httptest.NewRecorder()
+		handler.HealthCheck(rec, httptest.NewRequest(http.MethodGet, "/api/health", nil))
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response HealthResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "degraded", response.Status)
+		assert.Equal(t, "open", response.CircuitBreakers["gemini"].State)
+		assert.Equal(t, 1, response.CircuitBreakers["gemini"].ConsecutiveFailures)
+		assert.NotEmpty(t, response.CircuitBreakers["gemini"].OpenedAt)
+	})
+
+	t.Run("正常系：サーキットブレーカーがない場合", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		NewHealthHandler().HealthCheck(rec, httptest.NewRequest(http.MethodGet, "/api/health", nil))
+
+		assert.JSONEq(t, `{"status":"healthy"}`, rec.Body.String())
+	})
+}
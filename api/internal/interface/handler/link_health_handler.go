@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"net/http"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/infrastructure/logger"
+	"article-manager/internal/infrastructure/timeutil"
+	"article-manager/internal/usecase"
+
+	"go.uber.org/zap"
+)
+
+// リンクヘルスに関するHTTPハンドラ
+type LinkHealthHandler struct {
+	usecase *usecase.LinkHealthUsecase
+}
+
+// LinkHealthHandlerのコンストラクタ
+func NewLinkHealthHandler(uc *usecase.LinkHealthUsecase) *LinkHealthHandler {
+	return &LinkHealthHandler{
+		usecase: uc,
+	}
+}
+
+// リンクヘルスのレスポンス構造体
+type LinkHealthResponse struct {
+	ArticleID         int64  `json:"article_id"`
+	Title             string `json:"title,omitempty"`
+	URL               string `json:"url"`
+	LinkStatus        string `json:"link_status"`
+	StatusCode        int    `json:"status_code"`
+	FinalURL          string `json:"final_url"`
+	PermanentRedirect bool   `json:"permanent_redirect"`
+	Error             string `json:"error,omitempty"`
+	CheckedAt         string `json:"checked_at"`
+}
+
+// リンクチェック開始のレスポンス構造体
+type LinkCheckStartedResponse struct {
+	Message string `json:"message"`
+}
+
+// リンクヘルス一覧を取得する
+func (h *LinkHealthHandler) GetLinkHealth(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	status := r.URL.Query().Get("link_status")
+
+	logger.Info("Getting link health",
+		zap.String("link_status", status),
+	)
+
+	results, err := h.usecase.GetLinkHealth(ctx, status)
+	if err != nil {
+		HandleError(w, err, "GetLinkHealth")
+		return
+	}
+
+	response := make([]LinkHealthResponse, 0, len(results))
+	for _, result := range results {
+		response = append(response, toLinkHealthResponse(result.Article, result.Health))
+	}
+
+	logger.Info("Successfully retrieved link health",
+		zap.Int("count", len(response)),
+	)
+
+	RespondSuccess(w, http.StatusOK, response)
+}
+
+// 全記事のリンクチェックをバックグラウンドで開始する
+func (h *LinkHealthHandler) StartLinkCheck(w http.ResponseWriter, r *http.Request) {
+	logger.Info("Starting link check")
+
+	if err := h.usecase.StartLinkCheck(); err != nil {
+		HandleError(w, err, "StartLinkCheck")
+		return
+	}
+
+	RespondSuccess(w, http.StatusAccepted, LinkCheckStartedResponse{Message: "link check started"})
+}
+
+// 指定された記事のリンクを確認する
+func (h *LinkHealthHandler) CheckArticleLink(w http.ResponseWriter, r *http.Request, id int64) {
+	ctx := r.Context()
+
+	logger.Info("Checking article link",
+		zap.Int64("article_id", id),
+	)
+
+	health, err := h.usecase.CheckArticleLink(ctx, id)
+	if err != nil {
+		HandleError(w, err, "CheckArticleLink")
+		return
+	}
+
+	logger.Info("Successfully checked article link",
+		zap.Int64("article_id", id),
+		zap.String("link_status", string(health.Status)),
+	)
+
+	RespondSuccess(w, http.StatusOK, toLinkHealthResponse(nil, health))
+}
+
+// エンティティをレスポンス形式に変換する
+func toLinkHealthResponse(article *entity.Article, health *entity.LinkHealth) LinkHealthResponse {
+	response := LinkHealthResponse{
+		ArticleID:         health.ArticleID,
+		URL:               health.CheckedURL,
+		LinkStatus:        string(health.Status),
+		StatusCode:        health.StatusCode,
+		FinalURL:          health.FinalURL,
+		PermanentRedirect: health.PermanentRedirect,
+		Error:             health.ErrorMessage,
+		CheckedAt:         timeutil.MustFormatInJST(health.CheckedAt),
+	}
+	if article != nil {
+		response.Title = article.Title
+		response.URL = article.URL
+	}
+	return response
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/domain/service"
+	"article-manager/internal/infrastructure/repository"
+	"article-manager/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// モック LinkChecker
+type mockLinkCheckerForHandler struct {
+	results map[string]*service.LinkCheckResult
+}
+
+func (m *mockLinkCheckerForHandler) CheckLink(ctx context.Context, url string) (*service.LinkCheckResult, error) {
+	return m.results[url], nil
+}
+
+// テスト用のハンドラをセットアップ
+func setupLinkHealthHandler(t *testing.T) (*LinkHealthHandler, *usecase.LinkHealthUsecase) {
+	t.Helper()
+
+	articleRepo := repository.NewMemoryArticleRepository()
+	alive, err := entity.NewArticle("生きている記事", "https://example.com/alive", "要約", nil, "")
+	require.NoError(t, err)
+	gone, err := entity.NewArticle("消えた記事", "https://example.com/gone", "要約", nil, "")
+	require.NoError(t, err)
+	_, err = articleRepo.Create(context.Background(), alive)
+	require.NoError(t, err)
+	_, err = articleRepo.Create(context.Background(), gone)
+	require.NoError(t, err)
+
+	checker := &mockLinkCheckerForHandler{
+		results: map[string]*service.LinkCheckResult{
+			"https://example.com/alive": {StatusCode: 200, FinalURL: "https://example.com/alive"},
+			"https://example.com/gone":  {StatusCode: 404, FinalURL: "https://example.com/gone"},
+		},
+	}
+
+	uc := usecase.NewLinkHealthUsecase(articleRepo, repository.NewMemoryLinkHealthRepository(), checker, false)
+	return NewLinkHealthHandler(uc), uc
+}
+
+// GET /api/link-healthのテスト
+func TestGetLinkHealthHandler(t *testing.T) {
+	t.Run("正常系：リンク切れの記事のみ取得できる", func(t *testing.T) {
+		handler, uc := setupLinkHealthHandler(t)
+		_, err := uc.CheckAllLinks(context.Background())
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/link-health?link_status=broken", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetLinkHealth(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var response []LinkHealthResponse
+		err = json.Unmarshal(rec.Body.Bytes(), &response)
+		require.NoError(t, err)
+		require.Len(t, response, 1)
+		assert.Equal(t, "消えた記事", response[0].Title)
+		assert.Equal(t, "broken", response[0].LinkStatus)
+		assert.Equal(t, 404, response[0].StatusCode)
+		assert.NotEmpty(t, response[0].CheckedAt)
+	})
+
+	t.Run("正常系：未チェックの場合は空配列", func(t *testing.T) {
+		handler, _ := setupLinkHealthHandler(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/link-health", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetLinkHealth(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, "[]", rec.Body.String())
+	})
+
+	t.Run("異常系：不正なlink_status", func(t *testing.T) {
+		handler, _ := setupLinkHealthHandler(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/link-health?link_status=dead", nil)
+		rec := httptest.NewRecorder()
+
+		handler.GetLinkHealth(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// POST /api/articles/{id}/link-health/checkのテスト
+func TestCheckArticleLinkHandler(t *testing.T) {
+	t.Run("正常系：記事のリンクを確認できる", func(t *testing.T) {
+		handler, _ := setupLinkHealthHandler(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/1/link-health/check", nil)
+		rec := httptest.NewRecorder()
+
+		handler.CheckArticleLink(rec, req, 1)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var response LinkHealthResponse
+		err := json.Unmarshal(rec.Body.Bytes(), &response)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), response.ArticleID)
+		assert.Equal(t, "ok", response.LinkStatus)
+	})
+
+	t.Run("異常系：存在しない記事", func(t *testing.T) {
+		handler, _ := setupLinkHealthHandler(t)
+
+		req := httptest.NewRequest(http.MethodPost, "/api/articles/999/link-health/check", nil)
+		rec := httptest.NewRecorder()
+
+		handler.CheckArticleLink(rec, req, 999)
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
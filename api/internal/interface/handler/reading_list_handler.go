@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/infrastructure/logger"
+	"article-manager/internal/infrastructure/timeutil"
+	"article-manager/internal/usecase"
+
+	"go.uber.org/zap"
+)
+
+// 読書リストに関するHTTPハンドラ
+type ReadingListHandler struct {
+	usecase *usecase.ReadingListUsecase
+}
+
+// コンストラクタ
+func NewReadingListHandler(uc *usecase.ReadingListUsecase) *ReadingListHandler {
+	return &ReadingListHandler{
+		usecase: uc,
+	}
+}
+
+// 書籍追加リクエストの構造体
+type AddReadingListItemRequest struct {
+	Title         string  `json:"title"`
+	Author        string  `json:"author"`
+	ISBN          string  `json:"isbn"`
+	CoverImageURL string  `json:"coverImageUrl"`
+	ArticleIDs    []int64 `json:"articleIds"`
+}
+
+// 書籍推薦からの書籍追加リクエストの構造体
+type AddReadingListItemFromRecommendationRequest struct {
+	RecommendationID int64  `json:"recommendationId"`
+	BookKey          string `json:"bookKey"`
+}
+
+// 書籍更新リクエストの構造体
+type UpdateReadingListItemRequest struct {
+	Status     string  `json:"status"`
+	Notes      string  `json:"notes"`
+	Rating     int     `json:"rating"`
+	ArticleIDs []int64 `json:"articleIds"`
+}
+
+// 読書リストの書籍のレスポンス構造体
+type ReadingListItemResponse struct {
+	ID            int64   `json:"id"`
+	Key           string  `json:"key"`
+	Title         string  `json:"title"`
+	Author        string  `json:"author,omitempty"`
+	ISBN13        string  `json:"isbn13,omitempty"`
+	CoverImageURL string  `json:"coverImageUrl,omitempty"`
+	Status        string  `json:"status"`
+	Notes         string  `json:"notes"`
+	Rating        int     `json:"rating"`
+	ArticleIDs    []int64 `json:"articleIds"`
+	CreatedAt     string  `json:"createdAt"`
+	UpdatedAt     string  `json:"updatedAt"`
+}
+
+// 読書リストを取得する（statusクエリを指定した場合はその状態の書籍のみ）
+func (h *ReadingListHandler) GetReadingList(w http.ResponseWriter, r *http.Request) {
+	items, err := h.usecase.GetReadingList(r.Context(), r.URL.Query().Get("status"))
+	if err != nil {
+		HandleError(w, err, "GetReadingList")
+		return
+	}
+
+	response := make([]ReadingListItemResponse, 0, len(items))
+	for _, item := range items {
+		response = append(response, toReadingListItemResponse(item))
+	}
+
+	RespondSuccess(w, http.StatusOK, response)
+}
+
+// 指定されたIDの書籍を取得する
+func (h *ReadingListHandler) GetReadingListItem(w http.ResponseWriter, r *http.Request, id int64) {
+	item, err := h.usecase.GetReadingListItem(r.Context(), id)
+	if err != nil {
+		HandleError(w, err, "GetReadingListItem")
+		return
+	}
+
+	RespondSuccess(w, http.StatusOK, toReadingListItemResponse(item))
+}
+
+// 書籍を読書リストに追加する
+func (h *ReadingListHandler) AddReadingListItem(w http.ResponseWriter, r *http.Request) {
+	var req AddReadingListItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Failed to decode request body",
+			zap.Error(err),
+			zap.String("operation", "AddReadingListItem"),
+		)
+		HandleError(w, domainerrors.InvalidArgumentError("request body", "invalid JSON format"), "AddReadingListItem")
+		return
+	}
+
+	item, err := h.usecase.AddReadingListItem(r.Context(), usecase.AddReadingListItemInput{
+		Title:         req.Title,
+		Author:        req.Author,
+		ISBN:          req.ISBN,
+		CoverImageURL: req.CoverImageURL,
+		ArticleIDs:    req.ArticleIDs,
+	})
+	if err != nil {
+		HandleError(w, err, "AddReadingListItem")
+		return
+	}
+
+	RespondSuccess(w, http.StatusCreated, toReadingListItemResponse(item))
+}
+
+// 書籍推薦に含まれる書籍を読書リストに追加する
+func (h *ReadingListHandler) AddReadingListItemFromRecommendation(w http.ResponseWriter, r *http.Request) {
+	var req AddReadingListItemFromRecommendationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Failed to decode request body",
+			zap.Error(err),
+			zap.String("operation", "AddReadingListItemFromRecommendation"),
+		)
+		HandleError(w, domainerrors.InvalidArgumentError("request body", "invalid JSON format"), "AddReadingListItemFromRecommendation")
+		return
+	}
+
+	item, err := h.usecase.AddReadingListItemFromRecommendation(r.Context(), req.RecommendationID, req.BookKey)
+	if err != nil {
+		HandleError(w, err, "AddReadingListItemFromRecommendation")
+		return
+	}
+
+	RespondSuccess(w, http.StatusCreated, toReadingListItemResponse(item))
+}
+
+// 書籍の状態・メモ・評価・関連する記事を更新する
+func (h *ReadingListHandler) UpdateReadingListItem(w http.ResponseWriter, r *http.Request, id int64) {
+	var req UpdateReadingListItemRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Failed to decode request body",
+			zap.Error(err),
+			zap.String("operation", "UpdateReadingListItem"),
+			zap.Int64("id", id),
+		)
+		HandleError(w, domainerrors.InvalidArgumentError("request body", "invalid JSON format"), "UpdateReadingListItem")
+		return
+	}
+
+	item, err := h.usecase.UpdateReadingListItem(r.Context(), id, usecase.UpdateReadingListItemInput{
+		Status:     req.Status,
+		Notes:      req.Notes,
+		Rating:     req.Rating,
+		ArticleIDs: req.ArticleIDs,
+	})
+	if err != nil {
+		HandleError(w, err, "UpdateReadingListItem")
+		return
+	}
+
+	RespondSuccess(w, http.StatusOK, toReadingListItemResponse(item))
+}
+
+// 書籍を読書リストから削除する
+func (h *ReadingListHandler) DeleteReadingListItem(w http.ResponseWriter, r *http.Request, id int64) {
+	if err := h.usecase.DeleteReadingListItem(r.Context(), id); err != nil {
+		HandleError(w, err, "DeleteReadingListItem")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// エンティティをレスポンス形式に変換する
+func toReadingListItemResponse(item *entity.ReadingListItem) ReadingListItemResponse {
+	articleIDs := item.ArticleIDs
+	if articleIDs == nil {
+		articleIDs = []int64{}
+	}
+
+	return ReadingListItemResponse{
+		ID:            item.ID,
+		Key:           item.Key,
+		Title:         item.Title,
+		Author:        item.Author,
+		ISBN13:        item.ISBN13,
+		CoverImageURL: item.CoverImageURL,
+		Status:        string(item.Status),
+		Notes:         item.Notes,
+		Rating:        item.Rating,
+		ArticleIDs:    articleIDs,
+		CreatedAt:     timeutil.MustFormatInJST(item.CreatedAt),
+		UpdatedAt:     timeutil.MustFormatInJST(item.UpdatedAt),
+	}
+}
@@ -0,0 +1,196 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/infrastructure/repository"
+	"article-manager/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// テスト用のハンドラのセットアップ（ID 1の記事を登録済み）
+func setupReadingListHandler(t *testing.T, recommendationRepo *mockBookRecommendationRepositoryForHandler) *ReadingListHandler {
+	t.Helper()
+	articleRepo := repository.NewMemoryArticleRepository()
+	article, err := entity.NewArticle("Go入門", "https://example.com/go", "Goの基本", nil, "")
+	require.NoError(t, err)
+	_, err = articleRepo.Create(context.Background(), article)
+	require.NoError(t, err)
+
+	uc := usecase.NewReadingListUsecase(repository.NewMemoryReadingListRepository(), articleRepo, recommendationRepo)
+	return NewReadingListHandler(uc)
+}
+
+func postReadingListItem(t *testing.T, handler *ReadingListHandler, requestBody map[string]interface{}) *httptest.ResponseRecorder {
+	t.Helper()
+	body, _ := json.Marshal(requestBody)
+	req := httptest.NewRequest(http.MethodPost, "/api/books", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	handler.AddReadingListItem(rec, req)
+	return rec
+}
+
+// POST /api/booksのテスト
+func TestAddReadingListItem(t *testing.T) {
+	t.Run("正常系：ISBN-10の書籍を追加できる", func(t *testing.T) {
+		handler := setupReadingListHandler(t, &mockBookRecommendationRepositoryForHandler{})
+
+		rec := postReadingListItem(t, handler, map[string]interface{}{
+			"title":      "リーダブルコード",
+			"isbn":       "4-87311-565-5",
+			"articleIds": []int64{1},
+		})
+
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		var response ReadingListItemResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "9784873115658", response.ISBN13)
+		assert.Equal(t, "want", response.Status)
+		assert.Equal(t, []int64{1}, response.ArticleIDs)
+	})
+
+	t.Run("異常系：ISBNのチェックディジットが不正", func(t *testing.T) {
+		handler := setupReadingListHandler(t, &mockBookRecommendationRepositoryForHandler{})
+
+		rec := postReadingListItem(t, handler, map[string]interface{}{
+			"title": "リーダブルコード",
+			"isbn":  "9784873115659",
+		})
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("異常系：存在しない記事は関連付けられない", func(t *testing.T) {
+		handler := setupReadingListHandler(t, &mockBookRecommendationRepositoryForHandler{})
+
+		rec := postReadingListItem(t, handler, map[string]interface{}{
+			"title":      "リーダブルコード",
+			"articleIds": []int64{99},
+		})
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("異常系：同じ書籍は重複", func(t *testing.T) {
+		handler := setupReadingListHandler(t, &mockBookRecommendationRepositoryForHandler{})
+
+		first := postReadingListItem(t, handler, map[string]interface{}{"title": "リーダブルコード", "isbn": "9784873115658"})
+		require.Equal(t, http.StatusCreated, first.Code)
+
+		rec := postReadingListItem(t, handler, map[string]interface{}{"title": "The Art of Readable Code", "isbn": "4873115655"})
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
+// POST /api/books/from-recommendationのテスト
+func TestAddReadingListItemFromRecommendation(t *testing.T) {
+	recommendationRepo := &mockBookRecommendationRepositoryForHandler{
+		findByIDFunc: func(ctx context.Context, id int64) (*entity.BookRecommendationCache, error) {
+			if id != 7 {
+				return nil, domainerrors.NotFoundError("book_recommendation", id)
+			}
+			return &entity.BookRecommendationCache{
+				ID: 7,
+				Books: []entity.Book{
+					{Title: "Go言語による並行処理", ISBN13: "9784873118468", SourceArticleIDs: []int64{1, 2}},
+				},
+			}, nil
+		},
+	}
+
+	post := func(t *testing.T, handler *ReadingListHandler, requestBody map[string]interface{}) *httptest.ResponseRecorder {
+		t.Helper()
+		body, _ := json.Marshal(requestBody)
+		req := httptest.NewRequest(http.MethodPost, "/api/books/from-recommendation", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.AddReadingListItemFromRecommendation(rec, req)
+		return rec
+	}
+
+	t.Run("正常系：推薦された書籍を追加し、存在する根拠の記事を関連付ける", func(t *testing.T) {
+		handler := setupReadingListHandler(t, recommendationRepo)
+
+		rec := post(t, handler, map[string]interface{}{"recommendationId": 7, "bookKey": "isbn:9784873118468"})
+
+		require.Equal(t, http.StatusCreated, rec.Code)
+		var response ReadingListItemResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "Go言語による並行処理", response.Title)
+		assert.Equal(t, []int64{1}, response.ArticleIDs)
+	})
+
+	t.Run("異常系：推薦に含まれない書籍はNotFound", func(t *testing.T) {
+		handler := setupReadingListHandler(t, recommendationRepo)
+
+		rec := post(t, handler, map[string]interface{}{"recommendationId": 7, "bookKey": "isbn:9784873115658"})
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+// GET / PUT / DELETE /api/books/{id}のテスト
+func TestUpdateAndDeleteReadingListItem(t *testing.T) {
+	t.Run("正常系：状態と評価を更新して削除できる", func(t *testing.T) {
+		handler := setupReadingListHandler(t, &mockBookRecommendationRepositoryForHandler{})
+
+		created := postReadingListItem(t, handler, map[string]interface{}{"title": "リーダブルコード"})
+		require.Equal(t, http.StatusCreated, created.Code)
+		var createdResponse ReadingListItemResponse
+		require.NoError(t, json.Unmarshal(created.Body.Bytes(), &createdResponse))
+
+		body, _ := json.Marshal(map[string]interface{}{"status": "done", "notes": "命名の章が良い", "rating": 5, "articleIds": []int64{1}})
+		req := httptest.NewRequest(http.MethodPut, "/api/books/1", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.UpdateReadingListItem(rec, req, createdResponse.ID)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var updated ReadingListItemResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &updated))
+		assert.Equal(t, "done", updated.Status)
+		assert.Equal(t, 5, updated.Rating)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/books?status=reading", nil)
+		rec = httptest.NewRecorder()
+		handler.GetReadingList(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var list []ReadingListItemResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &list))
+		assert.Empty(t, list)
+
+		req = httptest.NewRequest(http.MethodDelete, "/api/books/1", nil)
+		rec = httptest.NewRecorder()
+		handler.DeleteReadingListItem(rec, req, createdResponse.ID)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/books/1", nil)
+		rec = httptest.NewRecorder()
+		handler.GetReadingListItem(rec, req, createdResponse.ID)
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("異常系：評価が範囲外", func(t *testing.T) {
+		handler := setupReadingListHandler(t, &mockBookRecommendationRepositoryForHandler{})
+
+		created := postReadingListItem(t, handler, map[string]interface{}{"title": "リーダブルコード"})
+		require.Equal(t, http.StatusCreated, created.Code)
+
+		body, _ := json.Marshal(map[string]interface{}{"status": "done", "rating": 6})
+		req := httptest.NewRequest(http.MethodPut, "/api/books/1", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.UpdateReadingListItem(rec, req, 1)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -0,0 +1,110 @@
+package handler
+
+import (
+	"net/http"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/infrastructure/logger"
+	"article-manager/internal/usecase"
+
+	"go.uber.org/zap"
+)
+
+// タグの共起グラフに関するHTTPハンドラ
+type TagGraphHandler struct {
+	usecase *usecase.TagGraphUsecase
+}
+
+// コンストラクタ
+func NewTagGraphHandler(uc *usecase.TagGraphUsecase) *TagGraphHandler {
+	return &TagGraphHandler{
+		usecase: uc,
+	}
+}
+
+// 共起グラフのノードのレスポンス構造体
+type TagGraphNodeResponse struct {
+	ID           int64  `json:"id,omitempty"`
+	Name         string `json:"name"`
+	ArticleCount int    `json:"article_count"`
+}
+
+// 共起グラフの辺のレスポンス構造体
+type TagGraphEdgeResponse struct {
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Weight int    `json:"weight"`
+}
+
+// 共起グラフのレスポンス構造体
+type TagGraphResponse struct {
+	Nodes []TagGraphNodeResponse `json:"nodes"`
+	Edges []TagGraphEdgeResponse `json:"edges"`
+}
+
+// タグの共起グラフを取得する
+// min_weightクエリで辺の最小の重みを、limitクエリでノード数を、formatクエリで出力形式（json, graphml, dot）を指定する
+func (h *TagGraphHandler) GetTagGraph(w http.ResponseWriter, r *http.Request) {
+	minWeight, err := parseIntQuery(r, "min_weight")
+	if err != nil {
+		HandleError(w, err, "GetTagGraph")
+		return
+	}
+	limit, err := parseIntQuery(r, "limit")
+	if err != nil {
+		HandleError(w, err, "GetTagGraph")
+		return
+	}
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "graphml" && format != "dot" {
+		HandleError(w, domainerrors.InvalidArgumentError("format", "format must be one of json, graphml, dot"), "GetTagGraph")
+		return
+	}
+
+	graph, err := h.usecase.GetTagGraph(r.Context(), entity.TagGraphOptions{MinWeight: minWeight, Limit: limit})
+	if err != nil {
+		HandleError(w, err, "GetTagGraph")
+		return
+	}
+
+	logger.Info("Successfully retrieved tag graph",
+		zap.Int("node_count", len(graph.Nodes)),
+		zap.Int("edge_count", len(graph.Edges)),
+		zap.String("format", format),
+	)
+
+	switch format {
+	case "graphml":
+		body, err := graph.GraphML()
+		if err != nil {
+			HandleError(w, domainerrors.InternalError("failed to encode graphml", err), "GetTagGraph")
+			return
+		}
+		RespondText(w, "application/graphml+xml; charset=utf-8", body)
+	case "dot":
+		RespondText(w, "text/vnd.graphviz; charset=utf-8", graph.DOT())
+	default:
+		RespondSuccess(w, http.StatusOK, toTagGraphResponse(graph))
+	}
+}
+
+func toTagGraphResponse(graph *entity.TagGraph) TagGraphResponse {
+	nodes := make([]TagGraphNodeResponse, 0, len(graph.Nodes))
+	for _, node := range graph.Nodes {
+		nodes = append(nodes, TagGraphNodeResponse{
+			ID:           node.TagID,
+			Name:         node.Name,
+			ArticleCount: node.ArticleCount,
+		})
+	}
+	edges := make([]TagGraphEdgeResponse, 0, len(graph.Edges))
+	for _, edge := range graph.Edges {
+		edges = append(edges, TagGraphEdgeResponse{
+			Source: edge.Source,
+			Target: edge.Target,
+			Weight: edge.Weight,
+		})
+	}
+	return TagGraphResponse{Nodes: nodes, Edges: edges}
+}
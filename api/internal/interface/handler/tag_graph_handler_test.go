@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"article-manager/internal/domain/entity"
+	"article-manager/internal/infrastructure/repository"
+	"article-manager/internal/usecase"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// テスト用のハンドラのセットアップ（記事のタグは[Go, Docker], [Go, Docker, AWS], [Go]）
+func setupTagGraphHandler(t *testing.T) *TagGraphHandler {
+	repo := repository.NewMemoryArticleRepository()
+	for _, tags := range [][]string{{"Go", "Docker"}, {"Go", "Docker", "AWS"}, {"Go"}} {
+		article, err := entity.NewArticle("タイトル", "https://example.com", "要約", tags, "")
+		require.NoError(t, err)
+		_, err = repo.Create(context.Background(), article)
+		require.NoError(t, err)
+	}
+	return NewTagGraphHandler(usecase.NewTagGraphUsecase(repo))
+}
+
+// GET /api/tags/graphのテスト
+func TestGetTagGraph(t *testing.T) {
+	t.Run("正常系：共起グラフをJSONで取得できる", func(t *testing.T) {
+		handler := setupTagGraphHandler(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/graph?min_weight=2", nil)
+		rec := httptest.NewRecorder()
+		handler.GetTagGraph(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response TagGraphResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Len(t, response.Nodes, 3)
+		assert.Equal(t, TagGraphNodeResponse{Name: "Go", ArticleCount: 3}, response.Nodes[0])
+		assert.Equal(t, []TagGraphEdgeResponse{{Source: "Docker", Target: "Go", Weight: 2}}, response.Edges)
+	})
+
+	t.Run("正常系：GraphML形式で取得できる", func(t *testing.T) {
+		handler := setupTagGraphHandler(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/graph?format=graphml&limit=2", nil)
+		rec := httptest.NewRecorder()
+		handler.GetTagGraph(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Header().Get("Content-Type"), "application/graphml+xml")
+		assert.Contains(t, rec.Body.String(), `<data key="name">Docker</data>`)
+		assert.NotContains(t, rec.Body.String(), `<data key="name">AWS</data>`)
+	})
+
+	t.Run("正常系：DOT形式で取得できる", func(t *testing.T) {
+		handler := setupTagGraphHandler(t)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/graph?format=dot", nil)
+		rec := httptest.NewRecorder()
+		handler.GetTagGraph(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Contains(t, rec.Header().Get("Content-Type"), "text/vnd.graphviz")
+		assert.Contains(t, rec.Body.String(), `"Docker" -- "Go" [weight=2, label="2"];`)
+	})
+
+	t.Run("異常系：不正なクエリの場合は400を返す", func(t *testing.T) {
+		handler := setupTagGraphHandler(t)
+
+		for _, query := range []string{"format=csv", "min_weight=-1", "limit=abc", "limit=501"} {
+			req := httptest.NewRequest(http.MethodGet, "/api/tags/graph?"+query, nil)
+			rec := httptest.NewRecorder()
+			handler.GetTagGraph(rec, req)
+
+			assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+		}
+	})
+}
@@ -3,6 +3,7 @@ package handler
 import (
 	"encoding/json"
 	"net/http"
+	"strconv"
 
 	"article-manager/internal/domain/entity"
 	domainerrors "article-manager/internal/domain/errors"
@@ -27,23 +28,84 @@ func NewTagHandler(uc *usecase.TagUsecase) *TagHandler {
 
 // タグ作成リクエストの構造体
 type CreateTagRequest struct {
-	Name string `json:"name"`
+	Name        string `json:"name"`
+	ParentID    int64  `json:"parent_id"` // 省略または0の場合は親なし
+	Color       string `json:"color"`     // #RRGGBB形式
+	Description string `json:"description"`
 }
 
 // タグ更新リクエストの構造体
 type UpdateTagRequest struct {
-	Name string `json:"name"`
+	Name        string  `json:"name"`
+	ParentID    *int64  `json:"parent_id"` // 省略した場合は親を変更せず、0の場合は親を外す
+	Color       *string `json:"color"`     // 省略した場合は変更せず、空文字の場合は色を外す
+	Description *string `json:"description"`
+}
+
+// タグ統合リクエストの構造体
+type MergeTagsRequest struct {
+	SourceIDs []int64 `json:"source_ids"`
+}
+
+// 別名作成リクエストの構造体
+type CreateTagAliasRequest struct {
+	Alias string `json:"alias"`
+}
+
+// 別名レスポンスの構造体
+type TagAliasResponse struct {
+	Alias     string `json:"alias"`
+	TagID     int64  `json:"tag_id"`
+	CreatedAt string `json:"created_at"`
 }
 
 // タグレスポンスの構造体
 type TagResponse struct {
-	ID        int64  `json:"id"`
-	Name      string `json:"name"`
-	CreatedAt string `json:"created_at"`
-	UpdatedAt string `json:"updated_at"`
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	ParentID    *int64 `json:"parent_id"`
+	Color       string `json:"color,omitempty"`
+	Description string `json:"description,omitempty"`
+	CreatedAt   string `json:"created_at"`
+	UpdatedAt   string `json:"updated_at"`
+}
+
+// 使用状況付きのタグレスポンスの構造体
+type TagSummaryResponse struct {
+	TagResponse
+	ArticleCount int     `json:"article_count"`
+	LastUsedAt   *string `json:"last_used_at"`
+}
+
+// タグ候補のレスポンスの構造体
+type TagSuggestionResponse struct {
+	TagSummaryResponse
+	Match     string `json:"match"`      // exact, prefix, reading, fuzzy, context
+	InContext bool   `json:"in_context"` // 記事のタイトル・要約に含まれるか
+}
+
+// 月ごとの記事数の推移のレスポンスの構造体
+type TagGrowthPointResponse struct {
+	Month string `json:"month"`
+	Count int    `json:"count"`
+	Total int    `json:"total"`
+}
+
+// タグの統計のレスポンスの構造体
+type TagStatsResponse struct {
+	TagSummaryResponse
+	Growth []TagGrowthPointResponse `json:"growth"`
+}
+
+// タグの階層構造のレスポンスの構造体
+type TagTreeResponse struct {
+	ID       int64             `json:"id"`
+	Name     string            `json:"name"`
+	Children []TagTreeResponse `json:"children"`
 }
 
-// 全タグの取得
+// 全タグの取得（記事数と最終使用日時を含む）
+// sortクエリ（name, usage, recent）で並び順を、unused=trueで記事のないタグのみを指定する
 func (h *TagHandler) GetAllTags(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -52,15 +114,25 @@ func (h *TagHandler) GetAllTags(w http.ResponseWriter, r *http.Request) {
 		zap.String("path", r.URL.Path),
 	)
 
-	tags, err := h.usecase.GetAllTags(ctx)
+	opts := usecase.TagListOptions{Sort: r.URL.Query().Get("sort")}
+	if unused := r.URL.Query().Get("unused"); unused != "" {
+		unusedOnly, err := strconv.ParseBool(unused)
+		if err != nil {
+			HandleError(w, domainerrors.InvalidArgumentError("unused", "unused must be a boolean"), "GetAllTags")
+			return
+		}
+		opts.UnusedOnly = unusedOnly
+	}
+
+	tags, err := h.usecase.ListTags(ctx, opts)
 	if err != nil {
 		HandleError(w, err, "GetAllTags")
 		return
 	}
 
-	response := make([]TagResponse, 0, len(tags))
+	response := make([]TagSummaryResponse, 0, len(tags))
 	for _, tag := range tags {
-		response = append(response, toTagResponse(tag))
+		response = append(response, toTagSummaryResponse(tag.Tag, tag.Usage))
 	}
 
 	logger.Info("Successfully retrieved all tags",
@@ -70,6 +142,50 @@ func (h *TagHandler) GetAllTags(w http.ResponseWriter, r *http.Request) {
 	RespondSuccess(w, http.StatusOK, response)
 }
 
+// 入力途中のタグ名から既存タグの候補を取得
+// qクエリで入力途中のタグ名を、titleとsummaryクエリで記事の文脈を、limitクエリで件数を指定する
+func (h *TagHandler) SuggestTags(w http.ResponseWriter, r *http.Request) {
+	limit, err := parseIntQuery(r, "limit")
+	if err != nil {
+		HandleError(w, err, "SuggestTags")
+		return
+	}
+
+	query := r.URL.Query()
+	suggestions, err := h.usecase.SuggestTags(r.Context(), usecase.TagSuggestInput{
+		Query:   query.Get("q"),
+		Title:   query.Get("title"),
+		Summary: query.Get("summary"),
+		Limit:   limit,
+	})
+	if err != nil {
+		HandleError(w, err, "SuggestTags")
+		return
+	}
+
+	response := make([]TagSuggestionResponse, 0, len(suggestions))
+	for _, suggestion := range suggestions {
+		response = append(response, TagSuggestionResponse{
+			TagSummaryResponse: toTagSummaryResponse(suggestion.Tag, suggestion.Usage),
+			Match:              string(suggestion.Match),
+			InContext:          suggestion.InContext,
+		})
+	}
+
+	RespondSuccess(w, http.StatusOK, response)
+}
+
+// タグの階層構造を取得
+func (h *TagHandler) GetTagTree(w http.ResponseWriter, r *http.Request) {
+	tree, err := h.usecase.GetTagTree(r.Context())
+	if err != nil {
+		HandleError(w, err, "GetTagTree")
+		return
+	}
+
+	RespondSuccess(w, http.StatusOK, toTagTreeResponses(tree))
+}
+
 // 指定されたIDのタグを取得
 func (h *TagHandler) GetTagByID(w http.ResponseWriter, r *http.Request, id int64) {
 	ctx := r.Context()
@@ -110,9 +226,15 @@ func (h *TagHandler) CreateTag(w http.ResponseWriter, r *http.Request) {
 
 	logger.Info("Creating tag",
 		zap.String("name", req.Name),
+		zap.Int64("parent_id", req.ParentID),
 	)
 
-	tag, err := h.usecase.CreateTag(ctx, req.Name)
+	tag, err := h.usecase.CreateTag(ctx, usecase.CreateTagInput{
+		Name:        req.Name,
+		ParentID:    req.ParentID,
+		Color:       req.Color,
+		Description: req.Description,
+	})
 	if err != nil {
 		HandleError(w, err, "CreateTag")
 		return
@@ -146,7 +268,12 @@ func (h *TagHandler) UpdateTag(w http.ResponseWriter, r *http.Request, id int64)
 		zap.String("name", req.Name),
 	)
 
-	tag, err := h.usecase.UpdateTag(ctx, id, req.Name)
+	tag, err := h.usecase.UpdateTag(ctx, id, usecase.UpdateTagInput{
+		Name:        req.Name,
+		ParentID:    req.ParentID,
+		Color:       req.Color,
+		Description: req.Description,
+	})
 	if err != nil {
 		HandleError(w, err, "UpdateTag")
 		return
@@ -181,12 +308,167 @@ func (h *TagHandler) DeleteTag(w http.ResponseWriter, r *http.Request, id int64)
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// タグの記事数・最終使用日時と月ごとの記事数の推移を取得する（monthsクエリで月数を指定する）
+func (h *TagHandler) GetTagStats(w http.ResponseWriter, r *http.Request, id int64) {
+	months, err := parseIntQuery(r, "months")
+	if err != nil {
+		HandleError(w, err, "GetTagStats")
+		return
+	}
+
+	stats, err := h.usecase.GetTagStats(r.Context(), id, months)
+	if err != nil {
+		HandleError(w, err, "GetTagStats")
+		return
+	}
+
+	growth := make([]TagGrowthPointResponse, 0, len(stats.Growth))
+	for _, point := range stats.Growth {
+		growth = append(growth, TagGrowthPointResponse{Month: point.Month, Count: point.Count, Total: point.Total})
+	}
+
+	RespondSuccess(w, http.StatusOK, TagStatsResponse{
+		TagSummaryResponse: toTagSummaryResponse(stats.Tag, stats.Usage),
+		Growth:             growth,
+	})
+}
+
+// 記事にも子タグにも使われていないタグを削除し、削除したタグを返す
+func (h *TagHandler) DeleteUnusedTags(w http.ResponseWriter, r *http.Request) {
+	logger.Info("Deleting unused tags")
+
+	deleted, err := h.usecase.DeleteUnusedTags(r.Context())
+	if err != nil {
+		HandleError(w, err, "DeleteUnusedTags")
+		return
+	}
+
+	response := make([]TagResponse, 0, len(deleted))
+	for _, tag := range deleted {
+		response = append(response, toTagResponse(tag))
+	}
+
+	RespondSuccess(w, http.StatusOK, response)
+}
+
+// 統合元のタグを指定されたタグに統合する
+func (h *TagHandler) MergeTags(w http.ResponseWriter, r *http.Request, id int64) {
+	var req MergeTagsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Failed to decode request body",
+			zap.Error(err),
+			zap.String("operation", "MergeTags"),
+			zap.Int64("id", id),
+		)
+		HandleError(w, domainerrors.InvalidArgumentError("request body", "invalid JSON format"), "MergeTags")
+		return
+	}
+
+	tag, err := h.usecase.MergeTags(r.Context(), id, req.SourceIDs)
+	if err != nil {
+		HandleError(w, err, "MergeTags")
+		return
+	}
+
+	RespondSuccess(w, http.StatusOK, toTagResponse(tag))
+}
+
+// タグの別名を取得する
+func (h *TagHandler) GetTagAliases(w http.ResponseWriter, r *http.Request, id int64) {
+	aliases, err := h.usecase.GetTagAliases(r.Context(), id)
+	if err != nil {
+		HandleError(w, err, "GetTagAliases")
+		return
+	}
+
+	response := make([]TagAliasResponse, 0, len(aliases))
+	for _, alias := range aliases {
+		response = append(response, toTagAliasResponse(alias))
+	}
+
+	RespondSuccess(w, http.StatusOK, response)
+}
+
+// タグに別名を追加する
+func (h *TagHandler) CreateTagAlias(w http.ResponseWriter, r *http.Request, id int64) {
+	var req CreateTagAliasRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		logger.Warn("Failed to decode request body",
+			zap.Error(err),
+			zap.String("operation", "CreateTagAlias"),
+			zap.Int64("id", id),
+		)
+		HandleError(w, domainerrors.InvalidArgumentError("request body", "invalid JSON format"), "CreateTagAlias")
+		return
+	}
+
+	alias, err := h.usecase.CreateTagAlias(r.Context(), id, req.Alias)
+	if err != nil {
+		HandleError(w, err, "CreateTagAlias")
+		return
+	}
+
+	RespondSuccess(w, http.StatusCreated, toTagAliasResponse(alias))
+}
+
+// タグの別名を削除する
+func (h *TagHandler) DeleteTagAlias(w http.ResponseWriter, r *http.Request, id int64) {
+	if err := h.usecase.DeleteTagAlias(r.Context(), id, r.PathValue("alias")); err != nil {
+		HandleError(w, err, "DeleteTagAlias")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
 // エンティティをレスポンス形式に変換する
 func toTagResponse(tag *entity.Tag) TagResponse {
-	return TagResponse{
-		ID:        tag.ID,
-		Name:      tag.Name,
-		CreatedAt: timeutil.MustFormatInJST(tag.CreatedAt),
-		UpdatedAt: timeutil.MustFormatInJST(tag.UpdatedAt),
+	response := TagResponse{
+		ID:          tag.ID,
+		Name:        tag.Name,
+		Color:       tag.Color,
+		Description: tag.Description,
+		CreatedAt:   timeutil.MustFormatInJST(tag.CreatedAt),
+		UpdatedAt:   timeutil.MustFormatInJST(tag.UpdatedAt),
+	}
+	if tag.ParentID != 0 {
+		parentID := tag.ParentID
+		response.ParentID = &parentID
+	}
+	return response
+}
+
+// 使用状況付きのタグをレスポンス形式に変換する
+func toTagSummaryResponse(tag *entity.Tag, usage entity.TagUsage) TagSummaryResponse {
+	response := TagSummaryResponse{
+		TagResponse:  toTagResponse(tag),
+		ArticleCount: usage.ArticleCount,
+	}
+	if !usage.LastUsedAt.IsZero() {
+		lastUsedAt := timeutil.MustFormatInJST(usage.LastUsedAt)
+		response.LastUsedAt = &lastUsedAt
+	}
+	return response
+}
+
+// 階層構造をレスポンス形式に変換する
+func toTagTreeResponses(nodes []*entity.TagNode) []TagTreeResponse {
+	responses := make([]TagTreeResponse, 0, len(nodes))
+	for _, node := range nodes {
+		responses = append(responses, TagTreeResponse{
+			ID:       node.Tag.ID,
+			Name:     node.Tag.Name,
+			Children: toTagTreeResponses(node.Children),
+		})
+	}
+	return responses
+}
+
+// 別名をレスポンス形式に変換する
+func toTagAliasResponse(alias *entity.TagAlias) TagAliasResponse {
+	return TagAliasResponse{
+		Alias:     alias.Alias,
+		TagID:     alias.TagID,
+		CreatedAt: timeutil.MustFormatInJST(alias.CreatedAt),
 	}
 }
@@ -6,9 +6,11 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strings"
 	"testing"
 
+	"article-manager/internal/domain/entity"
 	"article-manager/internal/infrastructure/repository"
 	"article-manager/internal/usecase"
 
@@ -18,7 +20,7 @@ import (
 
 // テスト用のハンドラのセットアップ
 func setupTagHandler() *TagHandler {
-	repo := repository.NewMemoryTagRepository()
+	repo := repository.NewMemoryTagRepository(entity.DefaultTagNamePolicy())
 	uc := usecase.NewTagUsecase(repo)
 	return NewTagHandler(uc)
 }
@@ -94,6 +96,54 @@ func TestCreateTag(t *testing.T) {
 		assert.Contains(t, response["error"], "validation failed")
 	})
 
+	t.Run("正常系：色と説明を指定して作成できる", func(t *testing.T) {
+		handler := setupTagHandler()
+
+		body, _ := json.Marshal(map[string]interface{}{"name": "Go", "color": "#00add8", "description": "Go言語"})
+		req := httptest.NewRequest(http.MethodPost, "/api/tags", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		handler.CreateTag(rec, req)
+
+		require.Equal(t, http.StatusCreated, rec.Code)
+		var response TagResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, "#00ADD8", response.Color)
+		assert.Equal(t, "Go言語", response.Description)
+	})
+
+	t.Run("異常系：不正な色", func(t *testing.T) {
+		handler := setupTagHandler()
+
+		body, _ := json.Marshal(map[string]interface{}{"name": "Go", "color": "blue"})
+		req := httptest.NewRequest(http.MethodPost, "/api/tags", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+
+		handler.CreateTag(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("異常系：全角・大文字小文字・空白の違いのみの名前は重複", func(t *testing.T) {
+		handler := setupTagHandler()
+		_, err := handler.usecase.CreateTag(context.Background(), usecase.CreateTagInput{Name: "ＡＷＳ"})
+		require.NoError(t, err)
+
+		for _, name := range []string{"AWS", "aws "} {
+			body, _ := json.Marshal(map[string]interface{}{"name": name})
+			req := httptest.NewRequest(http.MethodPost, "/api/tags", bytes.NewReader(body))
+			rec := httptest.NewRecorder()
+
+			handler.CreateTag(rec, req)
+
+			assert.Equal(t, http.StatusConflict, rec.Code, name)
+		}
+
+		tag, err := handler.usecase.GetTagByName(context.Background(), "aws")
+		require.NoError(t, err)
+		assert.Equal(t, "AWS", tag.Name)
+	})
+
 	t.Run("異常系：不正なJSON", func(t *testing.T) {
 		handler := setupTagHandler()
 
@@ -116,8 +166,8 @@ func TestGetAllTags(t *testing.T) {
 
 		// テストデータ作成
 		ctx := context.Background()
-		handler.usecase.CreateTag(ctx, "Go")
-		handler.usecase.CreateTag(ctx, "Next.js")
+		handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "Go"})
+		handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "Next.js"})
 
 		// リクエスト作成
 		req := httptest.NewRequest(http.MethodGet, "/api/tags", nil)
@@ -161,7 +211,7 @@ func TestGetTagByID(t *testing.T) {
 
 		// テストデータ作成
 		ctx := context.Background()
-		created, _ := handler.usecase.CreateTag(ctx, "Go")
+		created, _ := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "Go"})
 
 		// リクエスト作成
 		req := httptest.NewRequest(http.MethodGet, "/api/tags/1", nil)
@@ -220,7 +270,7 @@ func TestUpdateTag(t *testing.T) {
 
 		// テストデータ作成
 		ctx := context.Background()
-		created, _ := handler.usecase.CreateTag(ctx, "旧タグ名")
+		created, _ := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "旧タグ名"})
 
 		requestBody := map[string]interface{}{
 			"name": "NewName",
@@ -270,7 +320,7 @@ func TestUpdateTag(t *testing.T) {
 		handler := setupTagHandler()
 
 		ctx := context.Background()
-		created, _ := handler.usecase.CreateTag(ctx, "旧タイトル")
+		created, _ := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "旧タイトル"})
 
 		// 不正なデータ(タイトルが空)
 		requestBody := map[string]interface{}{
@@ -317,7 +367,7 @@ func TestDeleteTag(t *testing.T) {
 
 		// テストデータ作成
 		ctx := context.Background()
-		created, _ := handler.usecase.CreateTag(ctx, "DeleteTarget")
+		created, _ := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "DeleteTarget"})
 
 		// リクエスト作成
 		req := httptest.NewRequest(http.MethodDelete, "/api/tags/1", nil)
@@ -362,3 +412,244 @@ func TestDeleteTag(t *testing.T) {
 		require.Equal(t, http.StatusBadRequest, rec.Code)
 	})
 }
+
+// GET /api/tags/treeのテスト
+func TestGetTagTree(t *testing.T) {
+	t.Run("正常系：親子関係を階層構造で取得できる", func(t *testing.T) {
+		handler := setupTagHandler()
+		ctx := context.Background()
+		infra, err := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "Infrastructure"})
+		require.NoError(t, err)
+		_, err = handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "Kubernetes", ParentID: infra.ID})
+		require.NoError(t, err)
+		_, err = handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "Docker", ParentID: infra.ID})
+		require.NoError(t, err)
+		_, err = handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "Go"})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/tree", nil)
+		rec := httptest.NewRecorder()
+		handler.GetTagTree(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var response []TagTreeResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		require.Len(t, response, 2)
+		assert.Equal(t, "Go", response[0].Name)
+		assert.Empty(t, response[0].Children)
+		assert.Equal(t, "Infrastructure", response[1].Name)
+		require.Len(t, response[1].Children, 2)
+		assert.Equal(t, "Docker", response[1].Children[0].Name)
+		assert.Equal(t, "Kubernetes", response[1].Children[1].Name)
+	})
+
+	t.Run("異常系：循環する親は設定できない", func(t *testing.T) {
+		handler := setupTagHandler()
+		ctx := context.Background()
+		parent, err := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "Infrastructure"})
+		require.NoError(t, err)
+		child, err := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "Kubernetes", ParentID: parent.ID})
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(map[string]interface{}{"name": "Infrastructure", "parent_id": child.ID})
+		req := httptest.NewRequest(http.MethodPut, "/api/tags/1", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.UpdateTag(rec, req, parent.ID)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// POST /api/tags/{id}/mergeのテスト
+func TestMergeTags(t *testing.T) {
+	t.Run("正常系：統合元の子タグを移し、名前を別名として残す", func(t *testing.T) {
+		handler := setupTagHandler()
+		ctx := context.Background()
+		target, err := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "Go"})
+		require.NoError(t, err)
+		source, err := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "golang"})
+		require.NoError(t, err)
+		child, err := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "goroutine", ParentID: source.ID})
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(map[string]interface{}{"source_ids": []int64{source.ID}})
+		req := httptest.NewRequest(http.MethodPost, "/api/tags/1/merge", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.MergeTags(rec, req, target.ID)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		movedChild, err := handler.usecase.GetTagByID(ctx, child.ID)
+		require.NoError(t, err)
+		assert.Equal(t, target.ID, movedChild.ParentID)
+
+		resolved, err := handler.usecase.GetTagByName(ctx, "golang")
+		require.NoError(t, err)
+		assert.Equal(t, target.ID, resolved.ID)
+
+		req = httptest.NewRequest(http.MethodGet, "/api/tags/1/aliases", nil)
+		rec = httptest.NewRecorder()
+		handler.GetTagAliases(rec, req, target.ID)
+		require.Equal(t, http.StatusOK, rec.Code)
+		var aliases []TagAliasResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &aliases))
+		require.Len(t, aliases, 1)
+		assert.Equal(t, "golang", aliases[0].Alias)
+	})
+
+	t.Run("異常系：自身には統合できない", func(t *testing.T) {
+		handler := setupTagHandler()
+		target, err := handler.usecase.CreateTag(context.Background(), usecase.CreateTagInput{Name: "Go"})
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(map[string]interface{}{"source_ids": []int64{target.ID}})
+		req := httptest.NewRequest(http.MethodPost, "/api/tags/1/merge", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.MergeTags(rec, req, target.ID)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// /api/tags/{id}/aliasesのテスト
+func TestTagAliases(t *testing.T) {
+	t.Run("正常系：別名を追加すると別名でのタグ作成は重複になる", func(t *testing.T) {
+		handler := setupTagHandler()
+		ctx := context.Background()
+		tag, err := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "Go"})
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(map[string]interface{}{"alias": "Go言語"})
+		req := httptest.NewRequest(http.MethodPost, "/api/tags/1/aliases", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.CreateTagAlias(rec, req, tag.ID)
+		require.Equal(t, http.StatusCreated, rec.Code)
+
+		body, _ = json.Marshal(map[string]interface{}{"name": "Go言語"})
+		req = httptest.NewRequest(http.MethodPost, "/api/tags", bytes.NewReader(body))
+		rec = httptest.NewRecorder()
+		handler.CreateTag(rec, req)
+		assert.Equal(t, http.StatusConflict, rec.Code)
+
+		req = httptest.NewRequest(http.MethodDelete, "/api/tags/1/aliases/Go言語", nil)
+		req.SetPathValue("alias", "Go言語")
+		rec = httptest.NewRecorder()
+		handler.DeleteTagAlias(rec, req, tag.ID)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("異常系：既存のタグ名は別名にできない", func(t *testing.T) {
+		handler := setupTagHandler()
+		ctx := context.Background()
+		tag, err := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "Go"})
+		require.NoError(t, err)
+		_, err = handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "golang"})
+		require.NoError(t, err)
+
+		body, _ := json.Marshal(map[string]interface{}{"alias": "golang"})
+		req := httptest.NewRequest(http.MethodPost, "/api/tags/1/aliases", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		handler.CreateTagAlias(rec, req, tag.ID)
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
+// 未使用タグの取得と削除のテスト
+func TestUnusedTags(t *testing.T) {
+	t.Run("正常系：一覧に記事数を含め、子タグのない未使用タグを削除できる", func(t *testing.T) {
+		handler := setupTagHandler()
+		ctx := context.Background()
+		parent, err := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "Infrastructure"})
+		require.NoError(t, err)
+		_, err = handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: "Kubernetes", ParentID: parent.ID})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/tags?unused=true&sort=usage", nil)
+		rec := httptest.NewRecorder()
+		handler.GetAllTags(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var tags []TagSummaryResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &tags))
+		require.Len(t, tags, 2)
+		assert.Equal(t, 0, tags[0].ArticleCount)
+		assert.Nil(t, tags[0].LastUsedAt)
+
+		req = httptest.NewRequest(http.MethodDelete, "/api/tags/unused", nil)
+		rec = httptest.NewRecorder()
+		handler.DeleteUnusedTags(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var deleted []TagResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &deleted))
+		require.Len(t, deleted, 1)
+		assert.Equal(t, "Kubernetes", deleted[0].Name)
+
+		_, err = handler.usecase.GetTagByID(ctx, parent.ID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("異常系：不正なunusedクエリ", func(t *testing.T) {
+		handler := setupTagHandler()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/tags?unused=maybe", nil)
+		rec := httptest.NewRecorder()
+		handler.GetAllTags(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+// GET /api/tags/suggestのテスト
+func TestSuggestTags(t *testing.T) {
+	t.Run("正常系：入力途中のタグ名から候補を取得できる", func(t *testing.T) {
+		handler := setupTagHandler()
+		ctx := context.Background()
+		for _, name := range []string{"Go", "ドッカー", "Python"} {
+			_, err := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: name})
+			require.NoError(t, err)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/suggest?q=dok", nil)
+		rec := httptest.NewRecorder()
+		handler.SuggestTags(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var suggestions []TagSuggestionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &suggestions))
+		require.Len(t, suggestions, 1)
+		assert.Equal(t, "ドッカー", suggestions[0].Name)
+		assert.Equal(t, "reading", suggestions[0].Match)
+	})
+
+	t.Run("正常系：記事の文脈から候補を取得できる", func(t *testing.T) {
+		handler := setupTagHandler()
+		ctx := context.Background()
+		for _, name := range []string{"Go", "Python"} {
+			_, err := handler.usecase.CreateTag(ctx, usecase.CreateTagInput{Name: name})
+			require.NoError(t, err)
+		}
+
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/suggest?title="+url.QueryEscape("Pythonで始める機械学習"), nil)
+		rec := httptest.NewRecorder()
+		handler.SuggestTags(rec, req)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		var suggestions []TagSuggestionResponse
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &suggestions))
+		require.Len(t, suggestions, 1)
+		assert.Equal(t, "Python", suggestions[0].Name)
+		assert.True(t, suggestions[0].InContext)
+	})
+
+	t.Run("異常系：入力も文脈もない場合は400を返す", func(t *testing.T) {
+		handler := setupTagHandler()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/tags/suggest", nil)
+		rec := httptest.NewRecorder()
+		handler.SuggestTags(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
@@ -0,0 +1,186 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/domain/service"
+	"article-manager/internal/infrastructure/logger"
+	"article-manager/internal/infrastructure/timeutil"
+
+	"go.uber.org/zap"
+)
+
+// 使用量レポートの集計期間
+const (
+	DefaultUsageReportDays   = 30
+	DefaultUsageReportMonths = 12
+	maxUsageReportDays       = 366
+	maxUsageReportMonths     = 24
+)
+
+// 当月の予算の状況
+type AIBudgetStatus struct {
+	MonthlyTokenLimit int64 // 0の場合は無制限
+	UsedTokens        int64
+	RemainingTokens   int64
+	Exceeded          bool
+}
+
+// AI使用量のレポート
+type AIUsageReport struct {
+	Daily   []*entity.AIUsageSummary
+	Monthly []*entity.AIUsageSummary
+	Budget  AIBudgetStatus
+}
+
+// AI使用量の記録と予算管理に関するユースケース
+type AIUsageUsecase struct {
+	usageRepo          repository.AIUsageRepository
+	monthlyTokenBudget int64
+	now                func() time.Time
+}
+
+// monthlyTokenBudgetが0以下の場合、予算を制限しない
+func NewAIUsageUsecase(usageRepo repository.AIUsageRepository, monthlyTokenBudget int64) *AIUsageUsecase {
+	return &AIUsageUsecase{
+		usageRepo:          usageRepo,
+		monthlyTokenBudget: max(monthlyTokenBudget, 0),
+		now:                time.Now,
+	}
+}
+
+// service.AIUsageTrackerの実装であることを保証
+var _ service.AIUsageTracker = (*AIUsageUsecase)(nil)
+
+// 当月の予算を使い切っている場合はエラーを返す
+func (u *AIUsageUsecase) CheckBudget(ctx context.Context) error {
+	if u.monthlyTokenBudget == 0 {
+		return nil
+	}
+
+	status, err := u.budgetStatus(ctx)
+	if err != nil {
+		// 台帳を参照できない場合でもAI呼び出しは止めない
+		logger.Warn("Failed to check AI budget",
+			zap.Error(err),
+		)
+		return nil
+	}
+
+	if status.Exceeded {
+		logger.Warn("Monthly AI token budget exceeded",
+			zap.Int64("limit", status.MonthlyTokenLimit),
+			zap.Int64("used", status.UsedTokens),
+		)
+		return &service.AIGeneratorError{
+			Code:    service.ErrCodeBudgetExceeded,
+			Message: fmt.Sprintf("Monthly AI token budget exceeded (%d/%d tokens)", status.UsedTokens, status.MonthlyTokenLimit),
+		}
+	}
+	return nil
+}
+
+// 呼び出し1回分の使用量を記録
+func (u *AIUsageUsecase) RecordUsage(ctx context.Context, usage *entity.AIUsage) {
+	if _, err := u.usageRepo.Create(ctx, usage); err != nil {
+		logger.Warn("Failed to record AI usage",
+			zap.Error(err),
+			zap.String("operation", usage.Operation),
+			zap.Int("total_tokens", usage.TotalTokens),
+		)
+		return
+	}
+
+	logger.Debug("Recorded AI usage",
+		zap.String("operation", usage.Operation),
+		zap.String("model", usage.Model),
+		zap.Int("total_tokens", usage.TotalTokens),
+		zap.Duration("latency", usage.Latency),
+		zap.String("outcome", string(usage.Outcome)),
+	)
+}
+
+// 直近days日の日次集計と直近monthsか月の月次集計を取得
+func (u *AIUsageUsecase) GetUsageReport(ctx context.Context, days, months int) (*AIUsageReport, error) {
+	if days == 0 {
+		days = DefaultUsageReportDays
+	}
+	if months == 0 {
+		months = DefaultUsageReportMonths
+	}
+	if days < 1 || days > maxUsageReportDays {
+		return nil, domainerrors.InvalidArgumentError("days", fmt.Sprintf("days must be between 1 and %d", maxUsageReportDays))
+	}
+	if months < 1 || months > maxUsageReportMonths {
+		return nil, domainerrors.InvalidArgumentError("months", fmt.Sprintf("months must be between 1 and %d", maxUsageReportMonths))
+	}
+
+	jst, err := timeutil.GetJST()
+	if err != nil {
+		return nil, err
+	}
+	now := u.now().In(jst)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, jst)
+	dailyFrom := today.AddDate(0, 0, -(days - 1))
+	monthlyFrom := time.Date(now.Year(), now.Month()-time.Month(months-1), 1, 0, 0, 0, 0, jst)
+	to := today.AddDate(0, 0, 1)
+
+	daily, err := u.usageRepo.SummarizeByPeriod(ctx, dailyFrom, to, entity.AIUsagePeriodDay, jst)
+	if err != nil {
+		logger.Error("Failed to summarize daily AI usage",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	monthly, err := u.usageRepo.SummarizeByPeriod(ctx, monthlyFrom, to, entity.AIUsagePeriodMonth, jst)
+	if err != nil {
+		logger.Error("Failed to summarize monthly AI usage",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	budget, err := u.budgetStatus(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve AI budget status",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return &AIUsageReport{
+		Daily:   daily,
+		Monthly: monthly,
+		Budget:  *budget,
+	}, nil
+}
+
+// 当月（JST）の予算の状況を取得
+func (u *AIUsageUsecase) budgetStatus(ctx context.Context) (*AIBudgetStatus, error) {
+	jst, err := timeutil.GetJST()
+	if err != nil {
+		return nil, err
+	}
+	now := u.now().In(jst)
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, jst)
+
+	used, err := u.usageRepo.SumTotalTokens(ctx, monthStart, monthStart.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	status := &AIBudgetStatus{
+		MonthlyTokenLimit: u.monthlyTokenBudget,
+		UsedTokens:        used,
+	}
+	if u.monthlyTokenBudget > 0 {
+		status.RemainingTokens = max(u.monthlyTokenBudget-used, 0)
+		status.Exceeded = used >= u.monthlyTokenBudget
+	}
+	return status, nil
+}
@@ -0,0 +1,179 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// モックAIUsageRepository
+type mockAIUsageRepository struct {
+	createFunc         func(ctx context.Context, usage *entity.AIUsage) (*entity.AIUsage, error)
+	summarizeFunc      func(ctx context.Context, from, to time.Time, period entity.AIUsagePeriod, location *time.Location) ([]*entity.AIUsageSummary, error)
+	sumTotalTokensFunc func(ctx context.Context, from, to time.Time) (int64, error)
+}
+
+func (m *mockAIUsageRepository) Create(ctx context.Context, usage *entity.AIUsage) (*entity.AIUsage, error) {
+	return m.createFunc(ctx, usage)
+}
+
+func (m *mockAIUsageRepository) SummarizeByPeriod(ctx context.Context, from, to time.Time, period entity.AIUsagePeriod, location *time.Location) ([]*entity.AIUsageSummary, error) {
+	return m.summarizeFunc(ctx, from, to, period, location)
+}
+
+func (m *mockAIUsageRepository) SumTotalTokens(ctx context.Context, from, to time.Time) (int64, error) {
+	return m.sumTotalTokensFunc(ctx, from, to)
+}
+
+// 2025-03-15 12:00 JST
+var aiUsageTestNow = time.Date(2025, 3, 15, 3, 0, 0, 0, time.UTC)
+
+func newAIUsageTestUsecase(repo *mockAIUsageRepository, budget int64) *AIUsageUsecase {
+	uc := NewAIUsageUsecase(repo, budget)
+	uc.now = func() time.Time { return aiUsageTestNow }
+	return uc
+}
+
+func TestAIUsageUsecase_CheckBudget(t *testing.T) {
+	tests := []struct {
+		name          string
+		budget        int64
+		used          int64
+		sumErr        error
+		expectedError bool
+	}{
+		{name: "正常系：予算内", budget: 1000, used: 999},
+		{name: "正常系：予算が無制限", budget: 0, used: 1_000_000},
+		{name: "正常系：台帳の参照に失敗した場合は呼び出しを止めない", budget: 1000, sumErr: errors.New("db error")},
+		{name: "異常系：予算超過", budget: 1000, used: 1000, expectedError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var receivedFrom, receivedTo time.Time
+			repo := &mockAIUsageRepository{
+				sumTotalTokensFunc: func(ctx context.Context, from, to time.Time) (int64, error) {
+					receivedFrom, receivedTo = from, to
+					return tt.used, tt.sumErr
+				},
+			}
+			uc := newAIUsageTestUsecase(repo, tt.budget)
+
+			err := uc.CheckBudget(context.Background())
+
+			if tt.expectedError {
+				var aiErr *service.AIGeneratorError
+				require.ErrorAs(t, err, &aiErr)
+				assert.Equal(t, service.ErrCodeBudgetExceeded, aiErr.Code)
+				// 当月（JST）の範囲で集計される
+				assert.Equal(t, "2025-02-28T15:00:00Z", receivedFrom.UTC().Format(time.RFC3339))
+				assert.Equal(t, "2025-03-31T15:00:00Z", receivedTo.UTC().Format(time.RFC3339))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAIUsageUsecase_RecordUsage(t *testing.T) {
+	t.Run("正常系：使用量が記録される", func(t *testing.T) {
+		var recorded *entity.AIUsage
+		repo := &mockAIUsageRepository{
+			createFunc: func(ctx context.Context, usage *entity.AIUsage) (*entity.AIUsage, error) {
+				recorded = usage
+				return usage, nil
+			},
+		}
+		usage, err := entity.NewAIUsage(entity.AIOperationArticleGeneration, "gemini", 10, 5, 15, time.Second, "")
+		require.NoError(t, err)
+
+		newAIUsageTestUsecase(repo, 0).RecordUsage(context.Background(), usage)
+
+		assert.Equal(t, usage, recorded)
+	})
+
+	t.Run("正常系：記録に失敗してもパニックしない", func(t *testing.T) {
+		repo := &mockAIUsageRepository{
+			createFunc: func(ctx context.Context, usage *entity.AIUsage) (*entity.AIUsage, error) {
+				return nil, errors.New("db error")
+			},
+		}
+		usage, err := entity.NewAIUsage(entity.AIOperationArticleGeneration, "gemini", 10, 5, 15, time.Second, "")
+		require.NoError(t, err)
+
+		assert.NotPanics(t, func() {
+			newAIUsageTestUsecase(repo, 0).RecordUsage(context.Background(), usage)
+		})
+	})
+}
+
+func TestAIUsageUsecase_GetUsageReport(t *testing.T) {
+	type summarizeCall struct {
+		from, to time.Time
+		location string
+	}
+	newRepo := func(calls map[entity.AIUsagePeriod]summarizeCall) *mockAIUsageRepository {
+		return &mockAIUsageRepository{
+			summarizeFunc: func(ctx context.Context, from, to time.Time, period entity.AIUsagePeriod, location *time.Location) ([]*entity.AIUsageSummary, error) {
+				calls[period] = summarizeCall{from: from, to: to, location: location.String()}
+				return []*entity.AIUsageSummary{
+					{Period: aiUsageTestNow.Format(period.Layout()), Operation: entity.AIOperationArticleGeneration, Calls: 2, TotalTokens: 100},
+				}, nil
+			},
+			sumTotalTokensFunc: func(ctx context.Context, from, to time.Time) (int64, error) {
+				return 600, nil
+			},
+		}
+	}
+
+	t.Run("正常系：日次・月次の集計をJSTの期間で取得し、予算の状況と合わせて返す", func(t *testing.T) {
+		calls := make(map[entity.AIUsagePeriod]summarizeCall)
+
+		report, err := newAIUsageTestUsecase(newRepo(calls), 1000).GetUsageReport(context.Background(), 7, 3)
+
+		require.NoError(t, err)
+		require.Len(t, report.Daily, 1)
+		assert.Equal(t, "2025-03-15", report.Daily[0].Period)
+		require.Len(t, report.Monthly, 1)
+		assert.Equal(t, "2025-03", report.Monthly[0].Period)
+
+		// 日次は2025-03-09〜2025-03-15、月次は2025-01〜2025-03（JST）
+		require.Len(t, calls, 2)
+		assert.Equal(t, "2025-03-08T15:00:00Z", calls[entity.AIUsagePeriodDay].from.UTC().Format(time.RFC3339))
+		assert.Equal(t, "2024-12-31T15:00:00Z", calls[entity.AIUsagePeriodMonth].from.UTC().Format(time.RFC3339))
+		for _, call := range calls {
+			assert.Equal(t, "2025-03-15T15:00:00Z", call.to.UTC().Format(time.RFC3339))
+			assert.Equal(t, "Asia/Tokyo", call.location)
+		}
+
+		assert.Equal(t, AIBudgetStatus{MonthlyTokenLimit: 1000, UsedTokens: 600, RemainingTokens: 400}, report.Budget)
+	})
+
+	t.Run("異常系：期間が範囲外", func(t *testing.T) {
+		uc := newAIUsageTestUsecase(newRepo(make(map[entity.AIUsagePeriod]summarizeCall)), 0)
+
+		_, err := uc.GetUsageReport(context.Background(), 400, 0)
+		assert.True(t, domainerrors.IsValidationError(err))
+
+		_, err = uc.GetUsageReport(context.Background(), 0, -1)
+		assert.True(t, domainerrors.IsValidationError(err))
+	})
+
+	t.Run("異常系：台帳の集計に失敗", func(t *testing.T) {
+		failingRepo := &mockAIUsageRepository{
+			summarizeFunc: func(ctx context.Context, from, to time.Time, period entity.AIUsagePeriod, location *time.Location) ([]*entity.AIUsageSummary, error) {
+				return nil, errors.New("db error")
+			},
+		}
+
+		_, err := newAIUsageTestUsecase(failingRepo, 0).GetUsageReport(context.Background(), 0, 0)
+		assert.Error(t, err)
+	})
+}
@@ -1,8 +1,12 @@
 package usecase
 
 import (
+	"cmp"
 	"context"
+	"regexp"
+	"slices"
 	"strings"
+	"time"
 
 	"article-manager/internal/domain/entity"
 	domainerrors "article-manager/internal/domain/errors"
@@ -13,30 +17,101 @@ import (
 	"go.uber.org/zap"
 )
 
+// 生成オプションの許容範囲
+const (
+	minSummaryLength = 20
+	maxSummaryLength = 1000
+	maxTagCount      = 10
+)
+
+// プロンプトに含める既存タグの最大数
+// タグが多くてもプロンプトが長くなりすぎないよう、記事数の多いタグから順にこの数だけ含める
+// 上限を超えたタグも提案されたタグの対応付けには使うため、提案されれば既存タグとして扱われる
+const maxPromptTags = 200
+
+// AIの呼び出しと並行して行うメタデータ取得の待ち時間の上限
+// 記事の生成の応答を遅らせないよう短くし、取得できなかったメタデータは後でバックフィルする
+const generationMetadataTimeout = 3 * time.Second
+
+// "ja", "en", "zh-TW"などの言語コード
+var languageCodePattern = regexp.MustCompile(`^[a-z]{2,3}(-[A-Za-z]{2,4})?$`)
+
+// AIのタグ提案の扱いに関する設定
+type TagSuggestionConfig struct {
+	Policy              TagPolicy // リクエストで指定がない場合のポリシー
+	SimilarityThreshold float64   // 既存タグとみなす類似度のしきい値
+}
+
+// デフォルトのタグ提案設定
+func DefaultTagSuggestionConfig() TagSuggestionConfig {
+	return TagSuggestionConfig{
+		Policy:              TagPolicyAllowNew,
+		SimilarityThreshold: DefaultTagSimilarityThreshold,
+	}
+}
+
+// 記事自動生成の入力
+type GenerateArticleInput struct {
+	URL       string
+	Memo      string
+	Options   service.ArticleGenerationOptions
+	TagPolicy TagPolicy // 空の場合は設定のデフォルトを使用
+	Force     bool      // trueの場合は生成キャッシュを使用せずAIを呼び出す
+}
+
+// 記事自動生成の結果
+type GenerateArticleResult struct {
+	Article     *entity.Article
+	PendingTags []string // TagPolicyAskの場合に確認待ちとなった新規タグ
+	CacheHit    bool     // 生成キャッシュの結果を使用した場合true
+}
+
 // 記事自動生成ユースケース
 type ArticleGeneratorUsecase struct {
-	aiGenerator service.AIGeneratorService
-	articleRepo repository.ArticleRepository
-	tagRepo     repository.TagRepository
+	aiGenerator     service.AIGeneratorService
+	articleRepo     repository.ArticleRepository
+	tagRepo         repository.TagRepository
+	metadataFetcher service.MetadataFetcher
+	generationCache service.GenerationCache
+	tagPolicy       TagPolicy
+	tagResolver     *TagResolver
 }
 
+// metadataFetcherがnilの場合、メタデータは取得しない
+// generationCacheがnilの場合、生成結果はキャッシュしない
 func NewArticleGeneratorUsecase(
 	aiGenerator service.AIGeneratorService,
 	articleRepo repository.ArticleRepository,
 	tagRepo repository.TagRepository,
+	metadataFetcher service.MetadataFetcher,
+	generationCache service.GenerationCache,
+	tagConfig TagSuggestionConfig,
 ) *ArticleGeneratorUsecase {
 	return &ArticleGeneratorUsecase{
-		aiGenerator: aiGenerator,
-		articleRepo: articleRepo,
-		tagRepo:     tagRepo,
+		aiGenerator:     aiGenerator,
+		articleRepo:     articleRepo,
+		tagRepo:         tagRepo,
+		metadataFetcher: metadataFetcher,
+		generationCache: generationCache,
+		tagPolicy:       tagConfig.Policy,
+		tagResolver:     NewTagResolver(tagConfig.SimilarityThreshold, tagRepo),
 	}
 }
 
 // URLから記事情報を自動生成してDBに保存
-func (u *ArticleGeneratorUsecase) GenerateArticleFromURL(ctx context.Context, url string, memo string) (*entity.Article, error) {
+func (u *ArticleGeneratorUsecase) GenerateArticleFromURL(ctx context.Context, input GenerateArticleInput) (*GenerateArticleResult, error) {
+	url := input.URL
+	memo := input.Memo
+	options := input.Options
+
 	logger.Debug("Generating article from URL",
 		zap.String("url", url),
 		zap.String("memo", memo),
+		zap.Int("summary_length", options.SummaryLength),
+		zap.String("language", options.Language),
+		zap.Int("tag_count", options.TagCount),
+		zap.String("tag_policy", string(input.TagPolicy)),
+		zap.Bool("force", input.Force),
 	)
 
 	if url == "" {
@@ -49,20 +124,69 @@ func (u *ArticleGeneratorUsecase) GenerateArticleFromURL(ctx context.Context, ur
 		)
 		return nil, domainerrors.InvalidArgumentError("url", "invalid url format")
 	}
+	if err := validateGenerationOptions(options); err != nil {
+		logger.Warn("Invalid generation options",
+			zap.Error(err),
+		)
+		return nil, err
+	}
 
-	logger.Info("Calling AI generator service",
-		zap.String("url", url),
-	)
+	tagPolicy := input.TagPolicy
+	if tagPolicy == "" {
+		tagPolicy = u.tagPolicy
+	}
+	if _, err := ParseTagPolicy(string(tagPolicy)); err != nil {
+		logger.Warn("Invalid tag policy",
+			zap.String("tag_policy", string(tagPolicy)),
+		)
+		return nil, domainerrors.InvalidArgumentError("tag_policy", "tag_policy must be one of existing_only, allow_new, ask")
+	}
 
-	generated, err := u.aiGenerator.GenerateArticleFromURL(ctx, service.ArticleGenerationRequest{URL: url})
+	// 既存タグの語彙をプロンプトとタグの対応付けに使用する
+	existingTags, err := u.tagRepo.FindAll(ctx)
 	if err != nil {
-		logger.Error("AI generator service failed",
+		logger.Error("Failed to retrieve existing tags",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	usages, err := u.tagRepo.FindUsages(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve tag usages",
 			zap.Error(err),
-			zap.String("url", url),
 		)
 		return nil, err
 	}
 
+	cacheKey := u.generationCacheKey(url, options)
+	generated, cacheHit := u.lookupGenerationCache(ctx, cacheKey, input.Force)
+	// キャッシュヒット時はメタデータを取得せず、後のバックフィルに任せる
+	var pendingMetadata <-chan *entity.ArticleMetadata
+	if cacheHit {
+		service.ReportGenerationProgress(ctx, service.GenerationProgress{Stage: service.GenerationStageCacheHit})
+	} else {
+		pendingMetadata = u.startMetadataFetch(ctx, url)
+
+		logger.Info("Calling AI generator service",
+			zap.String("url", url),
+			zap.Int("existing_tags", len(existingTags)),
+		)
+		service.ReportGenerationProgress(ctx, service.GenerationProgress{Stage: service.GenerationStageCallingModel, Attempt: 1})
+
+		generated, err = u.aiGenerator.GenerateArticleFromURL(ctx, service.ArticleGenerationRequest{
+			URL:          url,
+			Options:      options,
+			ExistingTags: promptTagNames(existingTags, usages),
+		})
+		if err != nil {
+			logger.Error("AI generator service failed",
+				zap.Error(err),
+				zap.String("url", url),
+			)
+			return nil, err
+		}
+	}
+
 	if generated.Title == "" {
 		logger.Warn("Generated title is empty",
 			zap.String("url", url),
@@ -79,55 +203,48 @@ func (u *ArticleGeneratorUsecase) GenerateArticleFromURL(ctx context.Context, ur
 	logger.Info("AI successfully generated article content",
 		zap.String("title", generated.Title),
 		zap.Strings("suggested_tags", generated.SuggestedTags),
+		zap.Bool("cache_hit", cacheHit),
 	)
 
-	tags := []string{}
-	for _, tagName := range generated.SuggestedTags {
-		if tagName == "" {
-			logger.Warn("Tag name is empty, skipping")
-			continue
-		}
+	if !cacheHit {
+		u.storeGenerationCache(ctx, cacheKey, generated)
+	}
 
-		logger.Debug("Processing tag",
-			zap.String("tag", tagName),
+	service.ReportGenerationProgress(ctx, service.GenerationProgress{Stage: service.GenerationStageCreatingTags})
+	resolution, err := u.tagResolver.Resolve(ctx, generated.SuggestedTags, existingTags)
+	if err != nil {
+		logger.Error("Failed to resolve suggested tags",
+			zap.Error(err),
 		)
+		return nil, err
+	}
+	tags := resolution.Matched
+	pendingTags := []string{}
 
-		existingTag, err := u.tagRepo.FindByName(ctx, tagName)
-		if err != nil {
-			logger.Debug("Tag not found, creating new tag",
-				zap.String("tag", tagName),
-			)
-
-			newTag, err := entity.NewTag(tagName)
-			if err != nil {
-				logger.Warn("Failed to create tag entity",
-					zap.Error(err),
-					zap.String("tag", tagName),
-				)
-				return nil, domainerrors.ValidationError("tag", err.Error())
+	if len(resolution.New) > 0 {
+		switch tagPolicy {
+		case TagPolicyAllowNew:
+			for _, tagName := range resolution.New {
+				createdName, err := u.createTag(ctx, tagName)
+				if err != nil {
+					return nil, err
+				}
+				tags = append(tags, createdName)
 			}
-			createdTag, err := u.tagRepo.Create(ctx, newTag)
-			if err != nil {
-				logger.Error("Failed to create tag in repository",
-					zap.Error(err),
-					zap.String("tag", tagName),
-				)
-				return nil, err
-			}
-			tags = append(tags, createdTag.Name)
-			logger.Debug("Created new tag",
-				zap.Int64("tag_id", createdTag.ID),
-				zap.String("tag", createdTag.Name),
-			)
-		} else {
-			tags = append(tags, existingTag.Name)
-			logger.Debug("Using existing tag",
-				zap.Int64("tag_id", existingTag.ID),
-				zap.String("tag", existingTag.Name),
+		case TagPolicyAsk:
+			pendingTags = resolution.New
+		case TagPolicyExistingOnly:
+			logger.Info("Discarding suggested tags not in existing vocabulary",
+				zap.Strings("tags", resolution.New),
 			)
 		}
 	}
 
+	logger.Debug("Resolved suggested tags",
+		zap.Strings("tags", tags),
+		zap.Strings("pending_tags", pendingTags),
+	)
+
 	article, err := entity.NewArticle(generated.Title, url, generated.Summary, tags, memo)
 	if err != nil {
 		logger.Warn("Failed to create article entity",
@@ -136,7 +253,13 @@ func (u *ArticleGeneratorUsecase) GenerateArticleFromURL(ctx context.Context, ur
 		)
 		return nil, domainerrors.ValidationError("article", err.Error())
 	}
+	article.PromptVersion = generated.PromptVersion
 
+	if metadata := awaitMetadata(pendingMetadata); metadata != nil {
+		article.ApplyMetadata(*metadata)
+	}
+
+	service.ReportGenerationProgress(ctx, service.GenerationProgress{Stage: service.GenerationStageSaving})
 	savedArticle, err := u.articleRepo.Create(ctx, article)
 	if err != nil {
 		logger.Error("Failed to save article to repository",
@@ -153,5 +276,165 @@ func (u *ArticleGeneratorUsecase) GenerateArticleFromURL(ctx context.Context, ur
 		zap.Strings("tags", savedArticle.Tags),
 	)
 
-	return savedArticle, nil
+	return &GenerateArticleResult{
+		Article:     savedArticle,
+		PendingTags: pendingTags,
+		CacheHit:    cacheHit,
+	}, nil
+}
+
+// 正規化したURLとプロンプトバージョンから生成キャッシュのキーを作成
+// キャッシュが無効な場合は空文字を返す
+func (u *ArticleGeneratorUsecase) generationCacheKey(url string, options service.ArticleGenerationOptions) string {
+	if u.generationCache == nil {
+		return ""
+	}
+	canonicalURL, err := entity.CanonicalizeURL(url)
+	if err != nil {
+		logger.Warn("Failed to canonicalize URL for generation cache",
+			zap.Error(err),
+			zap.String("url", url),
+		)
+		return ""
+	}
+	return service.GenerationCacheKey(canonicalURL, u.aiGenerator.ArticlePromptVersion(), options)
+}
+
+// 生成キャッシュを参照（取得エラーはキャッシュミスとして扱う）
+func (u *ArticleGeneratorUsecase) lookupGenerationCache(ctx context.Context, key string, force bool) (*service.GeneratedArticle, bool) {
+	if key == "" || force {
+		return nil, false
+	}
+	cached, ok, err := u.generationCache.Get(ctx, key)
+	if err != nil {
+		logger.Warn("Failed to read generation cache",
+			zap.Error(err),
+		)
+		return nil, false
+	}
+	if ok {
+		logger.Info("Using cached generation result",
+			zap.String("cache_key", key),
+		)
+	}
+	return cached, ok
+}
+
+// 生成結果をキャッシュに保存（失敗しても記事の生成は継続する）
+func (u *ArticleGeneratorUsecase) storeGenerationCache(ctx context.Context, key string, generated *service.GeneratedArticle) {
+	if key == "" {
+		return
+	}
+	if err := u.generationCache.Set(ctx, key, generated); err != nil {
+		logger.Warn("Failed to write generation cache",
+			zap.Error(err),
+		)
+	}
+}
+
+// 記事ページのメタデータの取得をバックグラウンドで開始し、結果を受け取るチャネルを返す
+// 取得に失敗しても記事の保存は継続する（後でバックフィルされる）
+func (u *ArticleGeneratorUsecase) startMetadataFetch(ctx context.Context, url string) <-chan *entity.ArticleMetadata {
+	if u.metadataFetcher == nil {
+		return nil
+	}
+
+	service.ReportGenerationProgress(ctx, service.GenerationProgress{Stage: service.GenerationStageFetching})
+	result := make(chan *entity.ArticleMetadata, 1)
+	go func() {
+		fetchCtx, cancel := context.WithTimeout(ctx, generationMetadataTimeout)
+		defer cancel()
+
+		metadata, err := u.metadataFetcher.FetchMetadata(fetchCtx, url)
+		if err != nil {
+			logger.Warn("Failed to fetch article metadata",
+				zap.Error(err),
+				zap.String("url", url),
+			)
+			metadata = nil
+		}
+		result <- metadata
+	}()
+	return result
+}
+
+// メタデータの取得結果を待つ（取得していない場合はnil）
+func awaitMetadata(pending <-chan *entity.ArticleMetadata) *entity.ArticleMetadata {
+	if pending == nil {
+		return nil
+	}
+	return <-pending
+}
+
+// 新しいタグを作成（同時に作成済みの場合は既存のタグを使用）
+func (u *ArticleGeneratorUsecase) createTag(ctx context.Context, tagName string) (string, error) {
+	newTag, err := entity.NewTag(tagName)
+	if err != nil {
+		logger.Warn("Failed to create tag entity",
+			zap.Error(err),
+			zap.String("tag", tagName),
+		)
+		return "", domainerrors.ValidationError("tag", err.Error())
+	}
+
+	createdTag, err := u.tagRepo.Create(ctx, newTag)
+	if err != nil {
+		if domainerrors.IsAlreadyExistsError(err) {
+			if existingTag, findErr := u.tagRepo.FindByName(ctx, tagName); findErr == nil {
+				return existingTag.Name, nil
+			}
+		}
+		logger.Error("Failed to create tag in repository",
+			zap.Error(err),
+			zap.String("tag", tagName),
+		)
+		return "", err
+	}
+
+	logger.Debug("Created new tag",
+		zap.Int64("tag_id", createdTag.ID),
+		zap.String("tag", createdTag.Name),
+	)
+	return createdTag.Name, nil
+}
+
+// プロンプトに含める既存タグ名を記事数の多い順に最大maxPromptTags件選ぶ
+func promptTagNames(tags []*entity.Tag, usages []entity.TagUsage) []string {
+	usageByTagID := make(map[int64]entity.TagUsage, len(usages))
+	for _, usage := range usages {
+		usageByTagID[usage.TagID] = usage
+	}
+
+	// 記事数の多い順、同じ場合は最後に使われた順とし、それも同じ場合は取得した順（名前順）のままとする
+	ranked := slices.Clone(tags)
+	slices.SortStableFunc(ranked, func(a, b *entity.Tag) int {
+		ua, ub := usageByTagID[a.ID], usageByTagID[b.ID]
+		if c := cmp.Compare(ub.ArticleCount, ua.ArticleCount); c != 0 {
+			return c
+		}
+		return ub.LastUsedAt.Compare(ua.LastUsedAt)
+	})
+
+	names := make([]string, 0, min(len(ranked), maxPromptTags))
+	for _, tag := range ranked {
+		if len(names) >= maxPromptTags {
+			break
+		}
+		names = append(names, tag.Name)
+	}
+	return names
+}
+
+// 生成オプションを検証（ゼロ値は未指定として許可）
+func validateGenerationOptions(options service.ArticleGenerationOptions) error {
+	if options.SummaryLength != 0 && (options.SummaryLength < minSummaryLength || options.SummaryLength > maxSummaryLength) {
+		return domainerrors.InvalidArgumentError("summary_length", "summary_length must be between 20 and 1000")
+	}
+	if options.TagCount != 0 && (options.TagCount < 1 || options.TagCount > maxTagCount) {
+		return domainerrors.InvalidArgumentError("tag_count", "tag_count must be between 1 and 10")
+	}
+	if options.Language != "" && !languageCodePattern.MatchString(options.Language) {
+		return domainerrors.InvalidArgumentError("language", "language must be a language code such as ja or en")
+	}
+	return nil
 }
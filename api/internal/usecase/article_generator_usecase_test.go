@@ -3,10 +3,13 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
 	"article-manager/internal/domain/service"
 
 	"github.com/stretchr/testify/assert"
@@ -22,6 +25,10 @@ func (m *mockAIGeneratorService) GenerateArticleFromURL(ctx context.Context, req
 	return m.generateFunc(ctx, req)
 }
 
+func (m *mockAIGeneratorService) ArticlePromptVersion() string {
+	return "article-test"
+}
+
 // GenerateArticleFromURLのテスト
 func TestGenerateArticleFromURL(t *testing.T) {
 	tests := []struct {
@@ -51,15 +58,8 @@ func TestGenerateArticleFromURL(t *testing.T) {
 					},
 				}
 				tagRepo := &mockTagRepository{
-					findByNameFunc: func(ctx context.Context, name string) (*entity.Tag, error) {
-						switch name {
-						case "Go":
-							return &entity.Tag{ID: 1, Name: "Go"}, nil
-						case "AI":
-							return &entity.Tag{ID: 2, Name: "AI"}, nil
-						default:
-							return nil, errors.New("tag not found")
-						}
+					findAllFunc: func(ctx context.Context) ([]*entity.Tag, error) {
+						return []*entity.Tag{{ID: 1, Name: "Go"}, {ID: 2, Name: "AI"}}, nil
 					},
 				}
 				articleRepo := &mockArticleRepository{
@@ -98,9 +98,6 @@ func TestGenerateArticleFromURL(t *testing.T) {
 					},
 				}
 				tagRepo := &mockTagRepository{
-					findByNameFunc: func(ctx context.Context, name string) (*entity.Tag, error) {
-						return nil, errors.New("tag not found")
-					},
 					createFunc: func(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
 						tag.ID = int64(len(tag.Name))
 						return tag, nil
@@ -175,11 +172,8 @@ func TestGenerateArticleFromURL(t *testing.T) {
 					},
 				}
 				tagRepo := &mockTagRepository{
-					findByNameFunc: func(ctx context.Context, name string) (*entity.Tag, error) {
-						if name == "Go" {
-							return &entity.Tag{ID: 1, Name: "Go"}, nil
-						}
-						return nil, errors.New("tag not found")
+					findAllFunc: func(ctx context.Context) ([]*entity.Tag, error) {
+						return []*entity.Tag{{ID: 1, Name: "Go"}}, nil
 					},
 					createFunc: func(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
 						tag.ID = 10
@@ -340,8 +334,8 @@ func TestGenerateArticleFromURL(t *testing.T) {
 					},
 				}
 				tagRepo := &mockTagRepository{
-					findByNameFunc: func(ctx context.Context, name string) (*entity.Tag, error) {
-						return &entity.Tag{ID: 1, Name: "Go"}, nil
+					findAllFunc: func(ctx context.Context) ([]*entity.Tag, error) {
+						return []*entity.Tag{{ID: 1, Name: "Go"}}, nil
 					},
 				}
 				articleRepo := &mockArticleRepository{
@@ -372,10 +366,7 @@ func TestGenerateArticleFromURL(t *testing.T) {
 					},
 				}
 				tagRepo := &mockTagRepository{
-					findByNameFunc: func(ctx context.Context, name string) (*entity.Tag, error) {
-						return nil, errors.New("database connection failed")
-					},
-					createFunc: func(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
+					findAllFunc: func(ctx context.Context) ([]*entity.Tag, error) {
 						return nil, errors.New("database connection failed")
 					},
 				}
@@ -402,9 +393,6 @@ func TestGenerateArticleFromURL(t *testing.T) {
 					},
 				}
 				tagRepo := &mockTagRepository{
-					findByNameFunc: func(ctx context.Context, name string) (*entity.Tag, error) {
-						return nil, errors.New("tag not found")
-					},
 					createFunc: func(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
 						return nil, errors.New("failed to create tag: unique constraint violation")
 					},
@@ -432,11 +420,8 @@ func TestGenerateArticleFromURL(t *testing.T) {
 					},
 				}
 				tagRepo := &mockTagRepository{
-					findByNameFunc: func(ctx context.Context, name string) (*entity.Tag, error) {
-						if name == "Go" {
-							return &entity.Tag{ID: 1, Name: "Go"}, nil
-						}
-						return nil, errors.New("tag not found")
+					findAllFunc: func(ctx context.Context) ([]*entity.Tag, error) {
+						return []*entity.Tag{{ID: 1, Name: "Go"}}, nil
 					},
 				}
 				articleRepo := &mockArticleRepository{
@@ -461,9 +446,9 @@ func TestGenerateArticleFromURL(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			aiService, articleRepo, tagRepo := tt.setupMocks()
-			usecase := NewArticleGeneratorUsecase(aiService, articleRepo, tagRepo)
+			usecase := NewArticleGeneratorUsecase(aiService, articleRepo, tagRepo, nil, nil, DefaultTagSuggestionConfig())
 
-			result, err := usecase.GenerateArticleFromURL(context.Background(), tt.url, tt.memo)
+			result, err := usecase.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: tt.url, Memo: tt.memo})
 
 			if tt.expectedError {
 				require.Error(t, err)
@@ -475,9 +460,390 @@ func TestGenerateArticleFromURL(t *testing.T) {
 				require.NoError(t, err)
 				require.NotNil(t, result)
 				if tt.validateResult != nil {
-					tt.validateResult(t, result)
+					tt.validateResult(t, result.Article)
 				}
 			}
 		})
 	}
 }
+
+// メタデータ取得を伴う記事生成のテスト
+func TestGenerateArticleFromURLWithMetadata(t *testing.T) {
+	aiService := &mockAIGeneratorService{
+		generateFunc: func(ctx context.Context, req service.ArticleGenerationRequest) (*service.GeneratedArticle, error) {
+			return &service.GeneratedArticle{
+				Title:       "記事タイトル",
+				Summary:     "記事の要約",
+				SourceURL:   req.URL,
+				GeneratedAt: time.Now(),
+			}, nil
+		},
+	}
+	articleRepo := &mockArticleRepository{
+		createFunc: func(ctx context.Context, article *entity.Article) (*entity.Article, error) {
+			article.ID = 1
+			return article, nil
+		},
+	}
+
+	t.Run("正常系：取得したメタデータが保存される", func(t *testing.T) {
+		uc := NewArticleGeneratorUsecase(aiService, articleRepo, &mockTagRepository{}, metadataTestFetcher(), nil, DefaultTagSuggestionConfig())
+
+		result, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: "https://example.com/article"})
+
+		require.NoError(t, err)
+		article := result.Article
+		assert.Equal(t, "Example", article.Metadata.SiteName)
+		assert.True(t, article.Metadata.IsFetched())
+	})
+
+	t.Run("正常系：メタデータの取得に失敗しても記事は保存される", func(t *testing.T) {
+		uc := NewArticleGeneratorUsecase(aiService, articleRepo, &mockTagRepository{}, metadataTestFetcher(), nil, DefaultTagSuggestionConfig())
+
+		result, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: "https://example.com/broken"})
+
+		require.NoError(t, err)
+		article := result.Article
+		assert.Equal(t, "記事タイトル", article.Title)
+		assert.False(t, article.Metadata.IsFetched())
+	})
+
+	t.Run("正常系：メタデータはAIの呼び出しと並行して取得する", func(t *testing.T) {
+		aiCalled := make(chan struct{})
+		concurrentAIService := &mockAIGeneratorService{
+			generateFunc: func(ctx context.Context, req service.ArticleGenerationRequest) (*service.GeneratedArticle, error) {
+				close(aiCalled)
+				return aiService.generateFunc(ctx, req)
+			},
+		}
+		// AIの呼び出しを待ってから応答する（順番に実行すると取得の待ち時間の上限を超えて失敗する）
+		fetcher := &mockMetadataFetcher{
+			fetchFunc: func(ctx context.Context, url string) (*entity.ArticleMetadata, error) {
+				select {
+				case <-aiCalled:
+					return &entity.ArticleMetadata{SiteName: "Example"}, nil
+				case <-ctx.Done():
+					return nil, ctx.Err()
+				}
+			},
+		}
+		uc := NewArticleGeneratorUsecase(concurrentAIService, articleRepo, &mockTagRepository{}, fetcher, nil, DefaultTagSuggestionConfig())
+
+		result, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: "https://example.com/article"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Example", result.Article.Metadata.SiteName)
+	})
+
+	t.Run("正常系：生成キャッシュを使用した場合はメタデータを取得しない", func(t *testing.T) {
+		var fetches atomic.Int32
+		fetcher := &mockMetadataFetcher{
+			fetchFunc: func(ctx context.Context, url string) (*entity.ArticleMetadata, error) {
+				fetches.Add(1)
+				return &entity.ArticleMetadata{SiteName: "Example"}, nil
+			},
+		}
+		uc := NewArticleGeneratorUsecase(aiService, articleRepo, &mockTagRepository{}, fetcher, &mockGenerationCache{}, DefaultTagSuggestionConfig())
+
+		_, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: "https://example.com/article"})
+		require.NoError(t, err)
+		second, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: "https://example.com/article"})
+		require.NoError(t, err)
+
+		assert.True(t, second.CacheHit)
+		assert.False(t, second.Article.Metadata.IsFetched())
+		assert.Equal(t, int32(1), fetches.Load())
+	})
+}
+
+// 生成オプションのテスト
+func TestGenerateArticleFromURLWithOptions(t *testing.T) {
+	t.Run("正常系：オプションがAIサービスに渡され、プロンプトバージョンが記録される", func(t *testing.T) {
+		var received service.ArticleGenerationRequest
+		aiService := &mockAIGeneratorService{
+			generateFunc: func(ctx context.Context, req service.ArticleGenerationRequest) (*service.GeneratedArticle, error) {
+				received = req
+				return &service.GeneratedArticle{
+					Title:         "Article Title",
+					Summary:       "Summary in English",
+					PromptVersion: "article-v2",
+					GeneratedAt:   time.Now(),
+				}, nil
+			},
+		}
+		articleRepo := &mockArticleRepository{
+			createFunc: func(ctx context.Context, article *entity.Article) (*entity.Article, error) {
+				article.ID = 1
+				return article, nil
+			},
+		}
+		options := service.ArticleGenerationOptions{SummaryLength: 400, Language: "en", TagCount: 3}
+
+		uc := NewArticleGeneratorUsecase(aiService, articleRepo, &mockTagRepository{}, nil, nil, DefaultTagSuggestionConfig())
+		result, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: "https://example.com/article", Options: options})
+
+		require.NoError(t, err)
+		assert.Equal(t, options, received.Options)
+		assert.Equal(t, "article-v2", result.Article.PromptVersion)
+	})
+
+	tests := []struct {
+		name    string
+		options service.ArticleGenerationOptions
+	}{
+		{name: "異常系：要約の文字数が短すぎる", options: service.ArticleGenerationOptions{SummaryLength: 10}},
+		{name: "異常系：要約の文字数が長すぎる", options: service.ArticleGenerationOptions{SummaryLength: 5000}},
+		{name: "異常系：タグ数が多すぎる", options: service.ArticleGenerationOptions{TagCount: 11}},
+		{name: "異常系：不正な言語コード", options: service.ArticleGenerationOptions{Language: "Japanese"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewArticleGeneratorUsecase(&mockAIGeneratorService{}, &mockArticleRepository{}, &mockTagRepository{}, nil, nil, DefaultTagSuggestionConfig())
+			result, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: "https://example.com/article", Options: tt.options})
+
+			require.Error(t, err)
+			assert.Nil(t, result)
+			assert.True(t, domainerrors.IsValidationError(err))
+		})
+	}
+}
+
+// タグ提案ポリシーのテスト
+func TestGenerateArticleFromURLWithTagPolicy(t *testing.T) {
+	newAIService := func(received *service.ArticleGenerationRequest) *mockAIGeneratorService {
+		return &mockAIGeneratorService{
+			generateFunc: func(ctx context.Context, req service.ArticleGenerationRequest) (*service.GeneratedArticle, error) {
+				*received = req
+				return &service.GeneratedArticle{
+					Title:         "記事タイトル",
+					Summary:       "記事の要約",
+					SuggestedTags: []string{"golang", "Ｄｏｃｋｅｒ", "NewTech"},
+					GeneratedAt:   time.Now(),
+				}, nil
+			},
+		}
+	}
+	newTagRepo := func(created *[]string) *mockTagRepository {
+		return &mockTagRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Tag, error) {
+				return []*entity.Tag{{ID: 1, Name: "Go"}, {ID: 2, Name: "Docker"}}, nil
+			},
+			// golangはGoの別名
+			findByNameFunc: func(ctx context.Context, name string) (*entity.Tag, error) {
+				if name == "golang" {
+					return &entity.Tag{ID: 1, Name: "Go"}, nil
+				}
+				return nil, domainerrors.NotFoundError("tag", name)
+			},
+			createFunc: func(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
+				*created = append(*created, tag.Name)
+				tag.ID = 10
+				return tag, nil
+			},
+		}
+	}
+	articleRepo := &mockArticleRepository{
+		createFunc: func(ctx context.Context, article *entity.Article) (*entity.Article, error) {
+			article.ID = 1
+			return article, nil
+		},
+	}
+
+	tests := []struct {
+		name                string
+		policy              TagPolicy
+		expectedTags        []string
+		expectedPendingTags []string
+		expectedCreated     []string
+	}{
+		{
+			name:            "正常系：allow_newでは未登録のタグを作成する",
+			policy:          TagPolicyAllowNew,
+			expectedTags:    []string{"Go", "Docker", "NewTech"},
+			expectedCreated: []string{"NewTech"},
+		},
+		{
+			name:         "正常系：existing_onlyでは未登録のタグを破棄する",
+			policy:       TagPolicyExistingOnly,
+			expectedTags: []string{"Go", "Docker"},
+		},
+		{
+			name:                "正常系：askでは未登録のタグを確認待ちとして返す",
+			policy:              TagPolicyAsk,
+			expectedTags:        []string{"Go", "Docker"},
+			expectedPendingTags: []string{"NewTech"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var received service.ArticleGenerationRequest
+			var created []string
+			uc := NewArticleGeneratorUsecase(newAIService(&received), articleRepo, newTagRepo(&created), nil, nil, DefaultTagSuggestionConfig())
+
+			result, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{
+				URL:       "https://example.com/article",
+				TagPolicy: tt.policy,
+			})
+
+			require.NoError(t, err)
+			assert.Equal(t, []string{"Go", "Docker"}, received.ExistingTags)
+			assert.Equal(t, tt.expectedTags, result.Article.Tags)
+			assert.ElementsMatch(t, tt.expectedPendingTags, result.PendingTags)
+			assert.ElementsMatch(t, tt.expectedCreated, created)
+		})
+	}
+
+	t.Run("正常系：指定がない場合は設定のポリシーを使用する", func(t *testing.T) {
+		var received service.ArticleGenerationRequest
+		var created []string
+		config := TagSuggestionConfig{Policy: TagPolicyExistingOnly, SimilarityThreshold: DefaultTagSimilarityThreshold}
+		uc := NewArticleGeneratorUsecase(newAIService(&received), articleRepo, newTagRepo(&created), nil, nil, config)
+
+		result, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: "https://example.com/article"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Go", "Docker"}, result.Article.Tags)
+		assert.Empty(t, created)
+	})
+
+	t.Run("異常系：不正なポリシー", func(t *testing.T) {
+		uc := NewArticleGeneratorUsecase(&mockAIGeneratorService{}, articleRepo, &mockTagRepository{}, nil, nil, DefaultTagSuggestionConfig())
+
+		result, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{
+			URL:       "https://example.com/article",
+			TagPolicy: "unknown",
+		})
+
+		require.Error(t, err)
+		assert.Nil(t, result)
+		assert.True(t, domainerrors.IsValidationError(err))
+	})
+}
+
+// 生成キャッシュのモック
+type mockGenerationCache struct {
+	entries map[string]*service.GeneratedArticle
+	getErr  error
+}
+
+func (m *mockGenerationCache) Get(ctx context.Context, key string) (*service.GeneratedArticle, bool, error) {
+	if m.getErr != nil {
+		return nil, false, m.getErr
+	}
+	article, ok := m.entries[key]
+	return article, ok, nil
+}
+
+func (m *mockGenerationCache) Set(ctx context.Context, key string, article *service.GeneratedArticle) error {
+	if m.entries == nil {
+		m.entries = make(map[string]*service.GeneratedArticle)
+	}
+	m.entries[key] = article
+	return nil
+}
+
+// 生成キャッシュのテスト
+func TestGenerateArticleFromURLWithCache(t *testing.T) {
+	newAIService := func(calls *int) *mockAIGeneratorService {
+		return &mockAIGeneratorService{
+			generateFunc: func(ctx context.Context, req service.ArticleGenerationRequest) (*service.GeneratedArticle, error) {
+				*calls++
+				return &service.GeneratedArticle{
+					Title:       "記事タイトル",
+					Summary:     "記事の要約",
+					SourceURL:   req.URL,
+					GeneratedAt: time.Now(),
+				}, nil
+			},
+		}
+	}
+	articleRepo := &mockArticleRepository{
+		createFunc: func(ctx context.Context, article *entity.Article) (*entity.Article, error) {
+			article.ID = 1
+			return article, nil
+		},
+	}
+
+	t.Run("正常系：同じURLの2回目はキャッシュを使用しAIを呼び出さない", func(t *testing.T) {
+		calls := 0
+		uc := NewArticleGeneratorUsecase(newAIService(&calls), articleRepo, &mockTagRepository{}, nil, &mockGenerationCache{}, DefaultTagSuggestionConfig())
+
+		first, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: "https://example.com/article?utm_source=x"})
+		require.NoError(t, err)
+		second, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: "https://EXAMPLE.com/article/#top"})
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, calls)
+		assert.False(t, first.CacheHit)
+		assert.True(t, second.CacheHit)
+		assert.Equal(t, "記事タイトル", second.Article.Title)
+		assert.Equal(t, "https://EXAMPLE.com/article/#top", second.Article.URL)
+	})
+
+	t.Run("正常系：forceを指定するとキャッシュを使用せずAIを呼び出す", func(t *testing.T) {
+		calls := 0
+		uc := NewArticleGeneratorUsecase(newAIService(&calls), articleRepo, &mockTagRepository{}, nil, &mockGenerationCache{}, DefaultTagSuggestionConfig())
+
+		_, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: "https://example.com/article"})
+		require.NoError(t, err)
+		result, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: "https://example.com/article", Force: true})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, calls)
+		assert.False(t, result.CacheHit)
+	})
+
+	t.Run("正常系：生成オプションが異なる場合はキャッシュを共有しない", func(t *testing.T) {
+		calls := 0
+		uc := NewArticleGeneratorUsecase(newAIService(&calls), articleRepo, &mockTagRepository{}, nil, &mockGenerationCache{}, DefaultTagSuggestionConfig())
+
+		_, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: "https://example.com/article"})
+		require.NoError(t, err)
+		_, err = uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{
+			URL:     "https://example.com/article",
+			Options: service.ArticleGenerationOptions{Language: "en"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("正常系：キャッシュの取得に失敗した場合はAIを呼び出す", func(t *testing.T) {
+		calls := 0
+		cache := &mockGenerationCache{getErr: errors.New("cache unavailable")}
+		uc := NewArticleGeneratorUsecase(newAIService(&calls), articleRepo, &mockTagRepository{}, nil, cache, DefaultTagSuggestionConfig())
+
+		result, err := uc.GenerateArticleFromURL(context.Background(), GenerateArticleInput{URL: "https://example.com/article"})
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		assert.False(t, result.CacheHit)
+	})
+}
+
+func TestPromptTagNames(t *testing.T) {
+	t.Run("正常系：記事数の多い順、同じ場合は最後に使われた順に並べる", func(t *testing.T) {
+		tags := []*entity.Tag{{ID: 1, Name: "AWS"}, {ID: 2, Name: "Docker"}, {ID: 3, Name: "Go"}, {ID: 4, Name: "Rust"}}
+		usages := []entity.TagUsage{
+			{TagID: 2, ArticleCount: 3, LastUsedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{TagID: 3, ArticleCount: 10, LastUsedAt: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+			{TagID: 4, ArticleCount: 3, LastUsedAt: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		}
+
+		assert.Equal(t, []string{"Go", "Rust", "Docker", "AWS"}, promptTagNames(tags, usages))
+	})
+
+	t.Run("正常系：上限を超える場合は記事数の多いタグを残す", func(t *testing.T) {
+		tags := make([]*entity.Tag, 0, maxPromptTags+50)
+		for i := range maxPromptTags + 50 {
+			tags = append(tags, &entity.Tag{ID: int64(i + 1), Name: fmt.Sprintf("tag%03d", i)})
+		}
+		// 名前順で最後のタグが最も使われている
+		usages := []entity.TagUsage{{TagID: int64(len(tags)), ArticleCount: 5}}
+
+		names := promptTagNames(tags, usages)
+
+		assert.Len(t, names, maxPromptTags)
+		assert.Equal(t, tags[len(tags)-1].Name, names[0])
+	})
+}
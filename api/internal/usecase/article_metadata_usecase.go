@@ -0,0 +1,171 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/domain/service"
+	"article-manager/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// メタデータ取得の同時実行数
+const metadataFetchConcurrency = 4
+
+// バックフィル結果の集計
+type MetadataBackfillSummary struct {
+	Targets int
+	Updated int
+	Failed  int
+	Skipped int // 失敗が続いているため今回は見送った記事の数
+}
+
+// 記事メタデータに関するユースケース
+type ArticleMetadataUsecase struct {
+	articleRepo     repository.ArticleRepository
+	metadataFetcher service.MetadataFetcher
+	now             func() time.Time
+}
+
+// コンストラクタ
+func NewArticleMetadataUsecase(
+	articleRepo repository.ArticleRepository,
+	metadataFetcher service.MetadataFetcher,
+) *ArticleMetadataUsecase {
+	return &ArticleMetadataUsecase{
+		articleRepo:     articleRepo,
+		metadataFetcher: metadataFetcher,
+		now:             time.Now,
+	}
+}
+
+// メタデータ未取得の記事に対してメタデータを取得して保存
+// 取得に失敗した記事は間隔を空けて再試行し、上限に達した記事は対象外とする
+func (u *ArticleMetadataUsecase) BackfillMetadata(ctx context.Context) (*MetadataBackfillSummary, error) {
+	logger.Info("Starting article metadata backfill")
+
+	articles, err := u.articleRepo.FindAll(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve articles for metadata backfill",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	summary := &MetadataBackfillSummary{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, metadataFetchConcurrency)
+
+	now := u.now()
+	for _, article := range articles {
+		if article.Metadata.IsFetched() {
+			continue
+		}
+		if !article.Metadata.ShouldAutoFetch(now) {
+			summary.Skipped++
+			continue
+		}
+		summary.Targets++
+
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return summary, ctx.Err()
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(article *entity.Article) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			_, err := u.refresh(ctx, article)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				summary.Failed++
+				return
+			}
+			summary.Updated++
+		}(article)
+	}
+	wg.Wait()
+
+	logger.Info("Article metadata backfill completed",
+		zap.Int("targets", summary.Targets),
+		zap.Int("updated", summary.Updated),
+		zap.Int("failed", summary.Failed),
+		zap.Int("skipped", summary.Skipped),
+	)
+
+	return summary, nil
+}
+
+// 指定された記事のメタデータを再取得
+func (u *ArticleMetadataUsecase) RefreshArticleMetadata(ctx context.Context, articleID int64) (*entity.Article, error) {
+	logger.Debug("Refreshing article metadata",
+		zap.Int64("article_id", articleID),
+	)
+
+	if articleID <= 0 {
+		logger.Warn("Invalid article ID",
+			zap.Int64("article_id", articleID),
+		)
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	article, err := u.articleRepo.FindByID(ctx, articleID)
+	if err != nil {
+		logger.Warn("Failed to find article for metadata refresh",
+			zap.Error(err),
+			zap.Int64("article_id", articleID),
+		)
+		return nil, err
+	}
+
+	return u.refresh(ctx, article)
+}
+
+// メタデータを取得して記事に反映・保存
+// 取得中のユーザーの編集を上書きしないよう、メタデータのカラムのみを更新する
+func (u *ArticleMetadataUsecase) refresh(ctx context.Context, article *entity.Article) (*entity.Article, error) {
+	metadata, err := u.metadataFetcher.FetchMetadata(ctx, article.URL)
+	if err != nil {
+		logger.Warn("Failed to fetch article metadata",
+			zap.Error(err),
+			zap.Int64("article_id", article.ID),
+			zap.String("url", article.URL),
+		)
+		if recordErr := u.articleRepo.RecordMetadataFetchFailure(ctx, article.ID, article.URL, u.now()); recordErr != nil {
+			logger.Warn("Failed to record metadata fetch failure",
+				zap.Error(recordErr),
+				zap.Int64("article_id", article.ID),
+			)
+		}
+		return nil, domainerrors.ExternalServiceError("metadata", err)
+	}
+
+	article.ApplyMetadata(*metadata)
+
+	if err := u.articleRepo.UpdateMetadata(ctx, article.ID, article.URL, article.Metadata); err != nil {
+		logger.Error("Failed to save article metadata",
+			zap.Error(err),
+			zap.Int64("article_id", article.ID),
+		)
+		return nil, err
+	}
+
+	logger.Debug("Successfully refreshed article metadata",
+		zap.Int64("article_id", article.ID),
+		zap.String("site_name", article.Metadata.SiteName),
+	)
+
+	return article, nil
+}
@@ -0,0 +1,206 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// モック MetadataFetcher
+type mockMetadataFetcher struct {
+	fetchFunc func(ctx context.Context, url string) (*entity.ArticleMetadata, error)
+}
+
+func (m *mockMetadataFetcher) FetchMetadata(ctx context.Context, url string) (*entity.ArticleMetadata, error) {
+	return m.fetchFunc(ctx, url)
+}
+
+func metadataTestFetcher() *mockMetadataFetcher {
+	return &mockMetadataFetcher{
+		fetchFunc: func(ctx context.Context, url string) (*entity.ArticleMetadata, error) {
+			if url == "https://example.com/broken" {
+				return nil, errors.New("connection refused")
+			}
+			return &entity.ArticleMetadata{
+				SiteName:           "Example",
+				ImageURL:           "https://example.com/thumb.png",
+				ReadingTimeMinutes: 3,
+			}, nil
+		},
+	}
+}
+
+// BackfillMetadataのテスト
+func TestBackfillMetadata(t *testing.T) {
+	t.Run("正常系：メタデータ未取得の記事のみ取得し、メタデータのみを保存する", func(t *testing.T) {
+		fetchedAt := time.Now()
+		articles := []*entity.Article{
+			{ID: 1, Title: "未取得", URL: "https://example.com/1", Summary: "要約"},
+			{ID: 2, Title: "取得済み", URL: "https://example.com/2", Summary: "要約", Metadata: entity.ArticleMetadata{FetchedAt: &fetchedAt}},
+			{ID: 3, Title: "取得失敗", URL: "https://example.com/broken", Summary: "要約"},
+		}
+
+		var mu sync.Mutex
+		updated := map[int64]entity.ArticleMetadata{}
+		failed := map[int64]string{}
+		articleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return articles, nil
+			},
+			updateMetadataFunc: func(ctx context.Context, id int64, url string, metadata entity.ArticleMetadata) error {
+				mu.Lock()
+				defer mu.Unlock()
+				updated[id] = metadata
+				return nil
+			},
+			recordFailureFunc: func(ctx context.Context, id int64, url string, failedAt time.Time) error {
+				mu.Lock()
+				defer mu.Unlock()
+				failed[id] = url
+				return nil
+			},
+		}
+
+		uc := NewArticleMetadataUsecase(articleRepo, metadataTestFetcher())
+		summary, err := uc.BackfillMetadata(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, summary.Targets)
+		assert.Equal(t, 1, summary.Updated)
+		assert.Equal(t, 1, summary.Failed)
+		require.Len(t, updated, 1)
+		assert.Equal(t, "Example", updated[1].SiteName)
+		assert.True(t, updated[1].IsFetched())
+		assert.Equal(t, map[int64]string{3: "https://example.com/broken"}, failed)
+	})
+
+	t.Run("正常系：失敗が続いている記事は間隔を空けるまで取得しない", func(t *testing.T) {
+		now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+		recentFailure := now.Add(-time.Hour)
+		oldFailure := now.Add(-48 * time.Hour)
+		articles := []*entity.Article{
+			{ID: 1, URL: "https://example.com/1", Metadata: entity.ArticleMetadata{FetchFailures: 1, LastFailedAt: &recentFailure}},
+			{ID: 2, URL: "https://example.com/2", Metadata: entity.ArticleMetadata{FetchFailures: 1, LastFailedAt: &oldFailure}},
+			{ID: 3, URL: "https://example.com/3", Metadata: entity.ArticleMetadata{FetchFailures: entity.MaxMetadataFetchFailures, LastFailedAt: &oldFailure}},
+		}
+
+		var mu sync.Mutex
+		var fetchedURLs []string
+		fetcher := &mockMetadataFetcher{
+			fetchFunc: func(ctx context.Context, url string) (*entity.ArticleMetadata, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				fetchedURLs = append(fetchedURLs, url)
+				return &entity.ArticleMetadata{SiteName: "Example"}, nil
+			},
+		}
+		articleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return articles, nil
+			},
+			updateMetadataFunc: func(ctx context.Context, id int64, url string, metadata entity.ArticleMetadata) error {
+				return nil
+			},
+		}
+
+		uc := NewArticleMetadataUsecase(articleRepo, fetcher)
+		uc.now = func() time.Time { return now }
+		summary, err := uc.BackfillMetadata(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.Targets)
+		assert.Equal(t, 2, summary.Skipped)
+		assert.Equal(t, []string{"https://example.com/2"}, fetchedURLs)
+	})
+
+	t.Run("異常系：記事の取得に失敗した場合", func(t *testing.T) {
+		articleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		uc := NewArticleMetadataUsecase(articleRepo, metadataTestFetcher())
+		summary, err := uc.BackfillMetadata(context.Background())
+
+		require.Error(t, err)
+		assert.Nil(t, summary)
+	})
+}
+
+// RefreshArticleMetadataのテスト
+func TestRefreshArticleMetadata(t *testing.T) {
+	t.Run("正常系：指定した記事のメタデータを再取得する", func(t *testing.T) {
+		articleRepo := &mockArticleRepository{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.Article, error) {
+				return &entity.Article{ID: id, Title: "記事", URL: "https://example.com/1", Summary: "要約"}, nil
+			},
+			updateMetadataFunc: func(ctx context.Context, id int64, url string, metadata entity.ArticleMetadata) error {
+				assert.Equal(t, "https://example.com/1", url)
+				return nil
+			},
+		}
+
+		uc := NewArticleMetadataUsecase(articleRepo, metadataTestFetcher())
+		article, err := uc.RefreshArticleMetadata(context.Background(), 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/thumb.png", article.Metadata.ImageURL)
+		assert.Equal(t, 3, article.Metadata.ReadingTimeMinutes)
+	})
+
+	t.Run("異常系：取得に失敗した場合は失敗を記録して外部サービスエラー", func(t *testing.T) {
+		recorded := false
+		articleRepo := &mockArticleRepository{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.Article, error) {
+				return &entity.Article{ID: id, Title: "記事", URL: "https://example.com/broken", Summary: "要約"}, nil
+			},
+			recordFailureFunc: func(ctx context.Context, id int64, url string, failedAt time.Time) error {
+				recorded = true
+				return nil
+			},
+		}
+
+		uc := NewArticleMetadataUsecase(articleRepo, metadataTestFetcher())
+		article, err := uc.RefreshArticleMetadata(context.Background(), 1)
+
+		require.Error(t, err)
+		assert.Nil(t, article)
+		assert.Equal(t, domainerrors.ErrCodeExternalService, domainerrors.GetErrorCode(err))
+		assert.True(t, recorded)
+	})
+
+	t.Run("異常系：取得中にURLが変わった場合は保存しない", func(t *testing.T) {
+		articleRepo := &mockArticleRepository{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.Article, error) {
+				return &entity.Article{ID: id, Title: "記事", URL: "https://example.com/1", Summary: "要約"}, nil
+			},
+			updateMetadataFunc: func(ctx context.Context, id int64, url string, metadata entity.ArticleMetadata) error {
+				return domainerrors.NotFoundError("article", id)
+			},
+		}
+
+		uc := NewArticleMetadataUsecase(articleRepo, metadataTestFetcher())
+		article, err := uc.RefreshArticleMetadata(context.Background(), 1)
+
+		assert.Nil(t, article)
+		assert.True(t, domainerrors.IsNotFoundError(err))
+	})
+
+	t.Run("異常系：IDが不正な場合", func(t *testing.T) {
+		uc := NewArticleMetadataUsecase(&mockArticleRepository{}, metadataTestFetcher())
+		article, err := uc.RefreshArticleMetadata(context.Background(), 0)
+
+		require.Error(t, err)
+		assert.Nil(t, article)
+		assert.True(t, domainerrors.IsValidationError(err))
+	})
+}
@@ -2,6 +2,7 @@ package usecase
 
 import (
 	"context"
+	"slices"
 	"strings"
 
 	"article-manager/internal/domain/entity"
@@ -14,12 +15,13 @@ import (
 
 // 記事に関するユースケース
 type ArticleUsecase struct {
-	repo repository.ArticleRepository
+	repo    repository.ArticleRepository
+	tagRepo repository.TagRepository
 }
 
 // コンストラクタ
-func NewArticleUsecase(repo repository.ArticleRepository) *ArticleUsecase {
-	return &ArticleUsecase{repo: repo}
+func NewArticleUsecase(repo repository.ArticleRepository, tagRepo repository.TagRepository) *ArticleUsecase {
+	return &ArticleUsecase{repo: repo, tagRepo: tagRepo}
 }
 
 // 新しい記事を作成
@@ -105,6 +107,20 @@ func (u *ArticleUsecase) GetAllArticles(ctx context.Context) ([]*entity.Article,
 	return articles, nil
 }
 
+// 指定されたタグ（子孫のタグを含む）が付いた記事を取得
+func (u *ArticleUsecase) GetArticlesByTag(ctx context.Context, tag string) ([]*entity.Article, error) {
+	logger.Debug("Getting articles by tag",
+		zap.String("tag", tag),
+	)
+
+	articles, err := u.GetAllArticles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.filterArticlesByTag(ctx, articles, tag)
+}
+
 // 記事を更新
 func (u *ArticleUsecase) UpdateArticle(ctx context.Context, id int64, title, url, summary string, tags []string, memo string) (*entity.Article, error) {
 	logger.Debug("Updating article",
@@ -174,10 +190,11 @@ func (u *ArticleUsecase) DeleteArticle(ctx context.Context, id int64) error {
 	return nil
 }
 
-// キーワードで記事を検索
-func (u *ArticleUsecase) SearchArticles(ctx context.Context, keyword string) ([]*entity.Article, error) {
+// キーワードで記事を検索（tagを指定した場合はそのタグと子孫のタグが付いた記事に絞り込む）
+func (u *ArticleUsecase) SearchArticles(ctx context.Context, keyword, tag string) ([]*entity.Article, error) {
 	logger.Debug("Searching articles",
 		zap.String("keyword", keyword),
+		zap.String("tag", tag),
 	)
 
 	trimmedKeyword := strings.TrimSpace(keyword)
@@ -195,6 +212,11 @@ func (u *ArticleUsecase) SearchArticles(ctx context.Context, keyword string) ([]
 		return nil, err
 	}
 
+	articles, err = u.filterArticlesByTag(ctx, articles, tag)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Info("Successfully searched articles",
 		zap.String("keyword", trimmedKeyword),
 		zap.Int("count", len(articles)),
@@ -202,3 +224,65 @@ func (u *ArticleUsecase) SearchArticles(ctx context.Context, keyword string) ([]
 
 	return articles, nil
 }
+
+// タグとその子孫のタグのいずれかが付いた記事に絞り込む（tagが空の場合は絞り込まない）
+// タグは表記ゆれを吸収して比較する
+func (u *ArticleUsecase) filterArticlesByTag(ctx context.Context, articles []*entity.Article, tag string) ([]*entity.Article, error) {
+	tag = strings.TrimSpace(tag)
+	if tag == "" {
+		return articles, nil
+	}
+
+	_, tagKeys, err := resolveTagFilterKeys(ctx, u.tagRepo, tag)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := u.tagRepo.NamePolicy()
+	filtered := make([]*entity.Article, 0, len(articles))
+	for _, article := range articles {
+		if slices.ContainsFunc(article.Tags, func(name string) bool {
+			return tagKeys[policy.Key(name)]
+		}) {
+			filtered = append(filtered, article)
+		}
+	}
+	return filtered, nil
+}
+
+// タグの絞り込みに使う正規化キーを求める
+// 別名の場合は代表のタグに解決し、代表のタグのキーと、子孫のタグを含めたキーの集合を返す
+func resolveTagFilterKeys(ctx context.Context, tagRepo repository.TagRepository, tag string) (string, map[string]bool, error) {
+	tags, err := tagRepo.FindAll(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve tags for filtering",
+			zap.Error(err),
+		)
+		return "", nil, err
+	}
+
+	// 別名の場合は代表のタグで絞り込む
+	resolved, err := tagRepo.FindByName(ctx, tag)
+	if err == nil {
+		tag = resolved.Name
+	} else if !domainerrors.IsNotFoundError(err) {
+		logger.Error("Failed to resolve tag for filtering",
+			zap.Error(err),
+			zap.String("tag", tag),
+		)
+		return "", nil, err
+	}
+
+	policy := tagRepo.NamePolicy()
+	tagKey := policy.Key(tag)
+	tagKeys := map[string]bool{tagKey: true}
+	for _, t := range tags {
+		if policy.Key(t.Name) != tagKey {
+			continue
+		}
+		for _, descendant := range entity.TagWithDescendants(tags, t.ID) {
+			tagKeys[policy.Key(descendant.Name)] = true
+		}
+	}
+	return tagKey, tagKeys, nil
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -20,6 +21,11 @@ type mockArticleRepository struct {
 	updateFunc   func(ctx context.Context, article *entity.Article) (*entity.Article, error)
 	deleteFunc   func(ctx context.Context, id int64) error
 	searchFunc   func(ctx context.Context, keyword string) ([]*entity.Article, error)
+
+	findByCreatedAtRangeFunc func(ctx context.Context, from, to time.Time) ([]*entity.Article, error)
+	updateURLFunc            func(ctx context.Context, article *entity.Article, previousURL string) error
+	updateMetadataFunc       func(ctx context.Context, id int64, url string, metadata entity.ArticleMetadata) error
+	recordFailureFunc        func(ctx context.Context, id int64, url string, failedAt time.Time) error
 }
 
 func (m *mockArticleRepository) Create(ctx context.Context, article *entity.Article) (*entity.Article, error) {
@@ -46,6 +52,26 @@ func (m *mockArticleRepository) Search(ctx context.Context, keyword string) ([]*
 	return m.searchFunc(ctx, keyword)
 }
 
+func (m *mockArticleRepository) FindByCreatedAtRange(ctx context.Context, from, to time.Time) ([]*entity.Article, error) {
+	return m.findByCreatedAtRangeFunc(ctx, from, to)
+}
+
+func (m *mockArticleRepository) UpdateURL(ctx context.Context, article *entity.Article, previousURL string) error {
+	return m.updateURLFunc(ctx, article, previousURL)
+}
+
+func (m *mockArticleRepository) UpdateMetadata(ctx context.Context, id int64, url string, metadata entity.ArticleMetadata) error {
+	return m.updateMetadataFunc(ctx, id, url, metadata)
+}
+
+func (m *mockArticleRepository) RecordMetadataFetchFailure(ctx context.Context, id int64, url string, failedAt time.Time) error {
+	return m.recordFailureFunc(ctx, id, url, failedAt)
+}
+
+func (m *mockArticleRepository) FindTagGraph(ctx context.Context, opts entity.TagGraphOptions) (*entity.TagGraph, error) {
+	return entity.NewTagGraph(nil, nil), nil
+}
+
 // CreateArticleのテスト
 func TestCreateArticle(t *testing.T) {
 	t.Run("正常系：記事を作成できる", func(t *testing.T) {
@@ -58,7 +84,7 @@ func TestCreateArticle(t *testing.T) {
 		}
 
 		// ユースケース作成
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 
 		// テスト実行
 		result, err := usecase.CreateArticle(
@@ -82,7 +108,7 @@ func TestCreateArticle(t *testing.T) {
 
 	t.Run("異常系：タイトルが空の場合エラー", func(t *testing.T) {
 		mockRepo := &mockArticleRepository{}
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 
 		result, err := usecase.CreateArticle(
 			context.Background(),
@@ -100,7 +126,7 @@ func TestCreateArticle(t *testing.T) {
 
 	t.Run("異常系：URLが空の場合エラー", func(t *testing.T) {
 		mockRepo := &mockArticleRepository{}
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 
 		result, err := usecase.CreateArticle(
 			context.Background(),
@@ -118,7 +144,7 @@ func TestCreateArticle(t *testing.T) {
 
 	t.Run("異常系：URLが不正な形式の場合エラー", func(t *testing.T) {
 		mockRepo := &mockArticleRepository{}
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 
 		result, err := usecase.CreateArticle(
 			context.Background(),
@@ -136,7 +162,7 @@ func TestCreateArticle(t *testing.T) {
 
 	t.Run("異常系：要約が空の場合エラー", func(t *testing.T) {
 		mockRepo := &mockArticleRepository{}
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 
 		result, err := usecase.CreateArticle(
 			context.Background(),
@@ -158,7 +184,7 @@ func TestCreateArticle(t *testing.T) {
 				return nil, errors.New("database error")
 			},
 		}
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 
 		result, err := usecase.CreateArticle(
 			context.Background(),
@@ -195,7 +221,7 @@ func TestGetArticleByID(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 		result, err := usecase.GetArticleByID(context.Background(), 1)
 
 		require.NoError(t, err)
@@ -209,7 +235,7 @@ func TestGetArticleByID(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 		result, err := usecase.GetArticleByID(context.Background(), 999)
 
 		require.Error(t, err)
@@ -219,7 +245,7 @@ func TestGetArticleByID(t *testing.T) {
 
 	t.Run("異常系：不正なID（0以下）", func(t *testing.T) {
 		mockRepo := &mockArticleRepository{}
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 
 		result, err := usecase.GetArticleByID(context.Background(), 0)
 
@@ -257,7 +283,7 @@ func TestGetAllArticles(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 		result, err := usecase.GetAllArticles(context.Background())
 
 		require.NoError(t, err)
@@ -272,7 +298,7 @@ func TestGetAllArticles(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 		result, err := usecase.GetAllArticles(context.Background())
 
 		require.NoError(t, err)
@@ -287,7 +313,7 @@ func TestGetAllArticles(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 		result, err := usecase.GetAllArticles(context.Background())
 
 		require.Error(t, err)
@@ -319,7 +345,7 @@ func TestUpdateArticle(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 		result, err := usecase.UpdateArticle(
 			context.Background(),
 			1,
@@ -345,7 +371,7 @@ func TestUpdateArticle(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 		result, err := usecase.UpdateArticle(
 			context.Background(),
 			999,
@@ -379,7 +405,7 @@ func TestUpdateArticle(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 		result, err := usecase.UpdateArticle(
 			context.Background(),
 			1,
@@ -416,7 +442,7 @@ func TestUpdateArticle(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 		result, err := usecase.UpdateArticle(
 			context.Background(),
 			1,
@@ -442,7 +468,7 @@ func TestDeleteArticle(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 		err := usecase.DeleteArticle(context.Background(), 1)
 
 		require.NoError(t, err)
@@ -450,7 +476,7 @@ func TestDeleteArticle(t *testing.T) {
 
 	t.Run("異常系：不正なID（0以下）", func(t *testing.T) {
 		mockRepo := &mockArticleRepository{}
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 
 		err := usecase.DeleteArticle(context.Background(), 0)
 
@@ -465,7 +491,7 @@ func TestDeleteArticle(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 		err := usecase.DeleteArticle(context.Background(), 999)
 
 		require.Error(t, err)
@@ -479,7 +505,7 @@ func TestDeleteArticle(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 		err := usecase.DeleteArticle(context.Background(), 1)
 
 		require.Error(t, err)
@@ -515,8 +541,8 @@ func TestSearchArticles(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
-		result, err := usecase.SearchArticles(context.Background(), "Go")
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
+		result, err := usecase.SearchArticles(context.Background(), "Go", "")
 
 		require.NoError(t, err)
 		assert.Equal(t, 2, len(result))
@@ -530,8 +556,8 @@ func TestSearchArticles(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
-		result, err := usecase.SearchArticles(context.Background(), "存在しないキーワード")
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
+		result, err := usecase.SearchArticles(context.Background(), "存在しないキーワード", "")
 
 		require.NoError(t, err)
 		assert.Equal(t, 0, len(result))
@@ -558,8 +584,8 @@ func TestSearchArticles(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
-		result, err := usecase.SearchArticles(context.Background(), "  Go  ")
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
+		result, err := usecase.SearchArticles(context.Background(), "  Go  ", "")
 
 		require.NoError(t, err)
 		assert.Equal(t, 1, len(result))
@@ -567,9 +593,9 @@ func TestSearchArticles(t *testing.T) {
 
 	t.Run("異常系：キーワードが空の場合エラー", func(t *testing.T) {
 		mockRepo := &mockArticleRepository{}
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 
-		result, err := usecase.SearchArticles(context.Background(), "")
+		result, err := usecase.SearchArticles(context.Background(), "", "")
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -578,9 +604,9 @@ func TestSearchArticles(t *testing.T) {
 
 	t.Run("異常系：キーワードがスペースのみの場合エラー", func(t *testing.T) {
 		mockRepo := &mockArticleRepository{}
-		usecase := NewArticleUsecase(mockRepo)
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
 
-		result, err := usecase.SearchArticles(context.Background(), "   ")
+		result, err := usecase.SearchArticles(context.Background(), "   ", "")
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -594,11 +620,94 @@ func TestSearchArticles(t *testing.T) {
 			},
 		}
 
-		usecase := NewArticleUsecase(mockRepo)
-		result, err := usecase.SearchArticles(context.Background(), "Go")
+		usecase := NewArticleUsecase(mockRepo, &mockTagRepository{})
+		result, err := usecase.SearchArticles(context.Background(), "Go", "")
 
 		require.Error(t, err)
 		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "database error")
 	})
 }
+
+// GetArticlesByTagのテスト
+func TestGetArticlesByTag(t *testing.T) {
+	articles := []*entity.Article{
+		{ID: 1, Title: "Docker入門", Tags: []string{"Docker"}},
+		{ID: 2, Title: "Helmチャート", Tags: []string{"helm"}},
+		{ID: 3, Title: "Go入門", Tags: []string{"Go"}},
+		{ID: 4, Title: "インフラ全般", Tags: []string{"Infrastructure"}},
+	}
+	mockRepo := &mockArticleRepository{
+		findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+			return articles, nil
+		},
+		searchFunc: func(ctx context.Context, keyword string) ([]*entity.Article, error) {
+			return articles, nil
+		},
+	}
+	// Infrastructure ─ Docker, Kubernetes ─ Helm
+	mockTagRepo := &mockTagRepository{
+		findAllFunc: func(ctx context.Context) ([]*entity.Tag, error) {
+			return []*entity.Tag{
+				{ID: 1, Name: "Infrastructure"},
+				{ID: 2, Name: "Docker", ParentID: 1},
+				{ID: 3, Name: "Kubernetes", ParentID: 1},
+				{ID: 4, Name: "Helm", ParentID: 3},
+				{ID: 5, Name: "Go"},
+			}, nil
+		},
+		// k8sはKubernetesの別名
+		findByNameFunc: func(ctx context.Context, name string) (*entity.Tag, error) {
+			if name == "k8s" {
+				return &entity.Tag{ID: 3, Name: "Kubernetes", ParentID: 1}, nil
+			}
+			return nil, domainerrors.NotFoundError("tag", name)
+		},
+	}
+
+	articleIDs := func(articles []*entity.Article) []int64 {
+		ids := make([]int64, 0, len(articles))
+		for _, article := range articles {
+			ids = append(ids, article.ID)
+		}
+		return ids
+	}
+
+	t.Run("正常系：親タグで子孫のタグが付いた記事も取得できる", func(t *testing.T) {
+		usecase := NewArticleUsecase(mockRepo, mockTagRepo)
+
+		result, err := usecase.GetArticlesByTag(context.Background(), "infrastructure")
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 4}, articleIDs(result))
+	})
+
+	t.Run("正常系：別名の子タグでは代表のタグで絞り込み、親タグの記事を含まない", func(t *testing.T) {
+		usecase := NewArticleUsecase(mockRepo, mockTagRepo)
+
+		result, err := usecase.GetArticlesByTag(context.Background(), "k8s")
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{2}, articleIDs(result))
+	})
+
+	t.Run("正常系：大文字小文字を区別する方針では大文字小文字の違うタグで絞り込まない", func(t *testing.T) {
+		caseSensitiveTagRepo := *mockTagRepo
+		caseSensitiveTagRepo.namePolicy = &entity.TagNamePolicy{FoldCase: false}
+		usecase := NewArticleUsecase(mockRepo, &caseSensitiveTagRepo)
+
+		result, err := usecase.GetArticlesByTag(context.Background(), "go")
+
+		require.NoError(t, err)
+		assert.Empty(t, result)
+	})
+
+	t.Run("正常系：検索結果も子孫のタグを含めて絞り込める", func(t *testing.T) {
+		usecase := NewArticleUsecase(mockRepo, mockTagRepo)
+
+		result, err := usecase.SearchArticles(context.Background(), "入門", "Infrastructure")
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 4}, articleIDs(result))
+	})
+}
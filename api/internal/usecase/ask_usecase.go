@@ -0,0 +1,283 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/domain/service"
+	"article-manager/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// 質問応答の制限
+const (
+	defaultAskSources     = 5
+	maxAskSources         = 10
+	maxQuestionLength     = 1000
+	maxAskSearchTerms     = 8
+	minSearchTermRunes    = 2
+	maxSourceMemoRunes    = 500
+	maxSourceSummaryRunes = 1000
+)
+
+// 検索語として使用しない英単語
+var askStopWords = map[string]bool{
+	"a": true, "about": true, "an": true, "and": true, "are": true, "did": true, "do": true,
+	"does": true, "for": true, "from": true, "have": true, "how": true, "in": true, "is": true,
+	"it": true, "of": true, "on": true, "or": true, "our": true, "save": true, "saved": true,
+	"the": true, "to": true, "we": true, "what": true, "when": true, "which": true, "who": true,
+	"why": true, "with": true, "you": true,
+}
+
+// 質問応答の入力
+type AskInput struct {
+	Question   string
+	MaxSources int    // 回答の根拠として渡す記事の最大数（0の場合はデフォルト）
+	Language   string // 回答の言語コード（空の場合はデフォルト）
+}
+
+// 質問応答の結果
+// 関連する記事が見つからない場合はAIを呼び出さず、Answerは空になる
+type AskResult struct {
+	Answer    string
+	Citations []*entity.Article // 回答の根拠として引用された記事
+	Sources   []*entity.Article // 回答の根拠として渡した記事
+}
+
+// 保存済み記事への質問応答ユースケース
+type AskUsecase struct {
+	articleRepo repository.ArticleRepository
+	answerer    service.QuestionAnswerer
+}
+
+// コンストラクタ
+func NewAskUsecase(articleRepo repository.ArticleRepository, answerer service.QuestionAnswerer) *AskUsecase {
+	return &AskUsecase{
+		articleRepo: articleRepo,
+		answerer:    answerer,
+	}
+}
+
+// 保存済み記事を検索し、それらを根拠に質問へ回答する
+func (u *AskUsecase) Ask(ctx context.Context, input AskInput) (*AskResult, error) {
+	question := strings.TrimSpace(input.Question)
+	logger.Debug("Answering question over saved articles",
+		zap.String("question", question),
+		zap.Int("max_sources", input.MaxSources),
+	)
+
+	if question == "" {
+		logger.Warn("Question is empty")
+		return nil, domainerrors.InvalidArgumentError("question", "question is required")
+	}
+	if utf8.RuneCountInString(question) > maxQuestionLength {
+		return nil, domainerrors.InvalidArgumentError("question", "question must be 1000 characters or less")
+	}
+	maxSources := input.MaxSources
+	if maxSources == 0 {
+		maxSources = defaultAskSources
+	}
+	if maxSources < 1 || maxSources > maxAskSources {
+		return nil, domainerrors.InvalidArgumentError("max_sources", "max_sources must be between 1 and 10")
+	}
+	if input.Language != "" && !languageCodePattern.MatchString(input.Language) {
+		return nil, domainerrors.InvalidArgumentError("language", "language must be a language code such as ja or en")
+	}
+
+	sources, err := u.retrieve(ctx, question, maxSources)
+	if err != nil {
+		return nil, err
+	}
+	if len(sources) == 0 {
+		logger.Info("No saved articles matched the question",
+			zap.String("question", question),
+		)
+		return &AskResult{
+			Citations: []*entity.Article{},
+			Sources:   []*entity.Article{},
+		}, nil
+	}
+
+	answerSources := make([]service.SourceArticle, 0, len(sources))
+	for _, article := range sources {
+		answerSources = append(answerSources, service.SourceArticle{
+			ArticleID: article.ID,
+			Title:     article.Title,
+			URL:       article.URL,
+			Summary:   truncateRunes(article.Summary, maxSourceSummaryRunes),
+			Memo:      truncateRunes(article.Memo, maxSourceMemoRunes),
+			Tags:      article.Tags,
+		})
+	}
+
+	answer, err := u.answerer.AnswerQuestion(ctx, service.QuestionAnswerRequest{
+		Question: question,
+		Sources:  answerSources,
+		Language: input.Language,
+	})
+	if err != nil {
+		logger.Error("Failed to answer question",
+			zap.Error(err),
+			zap.String("question", question),
+		)
+		return nil, err
+	}
+
+	citations := citedArticles(answer.CitedArticleIDs, sources)
+
+	logger.Info("Successfully answered question",
+		zap.Int("source_count", len(sources)),
+		zap.Int("citation_count", len(citations)),
+		zap.Int("token_used", answer.TokenUsed),
+	)
+
+	return &AskResult{
+		Answer:    answer.Answer,
+		Citations: citations,
+		Sources:   sources,
+	}, nil
+}
+
+// 質問から検索語を取り出し、既存の検索で候補の記事を集める
+// 一致した検索語の数が多い記事ほど上位とし、同数の場合は新しい記事を優先する
+func (u *AskUsecase) retrieve(ctx context.Context, question string, limit int) ([]*entity.Article, error) {
+	terms := extractSearchTerms(question)
+	logger.Debug("Extracted search terms from question",
+		zap.Strings("terms", terms),
+	)
+
+	scores := make(map[int64]int)
+	articles := make(map[int64]*entity.Article)
+	for _, term := range terms {
+		found, err := u.articleRepo.Search(ctx, term)
+		if err != nil {
+			logger.Error("Failed to search articles for question",
+				zap.Error(err),
+				zap.String("term", term),
+			)
+			return nil, err
+		}
+		for _, article := range found {
+			scores[article.ID]++
+			articles[article.ID] = article
+		}
+	}
+
+	candidates := make([]*entity.Article, 0, len(articles))
+	for _, article := range articles {
+		candidates = append(candidates, article)
+	}
+	slices.SortFunc(candidates, func(a, b *entity.Article) int {
+		if scores[a.ID] != scores[b.ID] {
+			return scores[b.ID] - scores[a.ID]
+		}
+		return cmp.Or(b.CreatedAt.Compare(a.CreatedAt), cmp.Compare(b.ID, a.ID))
+	})
+
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates, nil
+}
+
+// 質問文から検索語を取り出す
+// 文字種（英数字・カタカナ・漢字）が続く範囲を1語とし、ひらがな（助詞など）と記号で区切る
+func extractSearchTerms(question string) []string {
+	type runeClass int
+	const (
+		classOther runeClass = iota
+		classAlnum
+		classKatakana
+		classHan
+	)
+	classify := func(r rune) runeClass {
+		switch {
+		case unicode.In(r, unicode.Katakana) || r == 'ー':
+			return classKatakana
+		case unicode.In(r, unicode.Han):
+			return classHan
+		case unicode.In(r, unicode.Hiragana):
+			return classOther
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			return classAlnum
+		default:
+			return classOther
+		}
+	}
+
+	var terms []string
+	seen := make(map[string]bool)
+	add := func(word []rune) {
+		term := string(word)
+		key := strings.ToLower(term)
+		if utf8.RuneCountInString(term) < minSearchTermRunes || askStopWords[key] || seen[key] {
+			return
+		}
+		seen[key] = true
+		terms = append(terms, term)
+	}
+
+	var word []rune
+	current := classOther
+	for _, r := range question {
+		class := classify(r)
+		if class != current && len(word) > 0 {
+			add(word)
+			word = word[:0]
+		}
+		current = class
+		if class != classOther {
+			word = append(word, r)
+		}
+	}
+	if len(word) > 0 {
+		add(word)
+	}
+
+	if len(terms) > maxAskSearchTerms {
+		terms = terms[:maxAskSearchTerms]
+	}
+	return terms
+}
+
+// 引用された記事IDのうち、根拠として渡した記事のみを重複なく返す
+func citedArticles(ids []int64, sources []*entity.Article) []*entity.Article {
+	byID := make(map[int64]*entity.Article, len(sources))
+	for _, article := range sources {
+		byID[article.ID] = article
+	}
+
+	citations := make([]*entity.Article, 0, len(ids))
+	cited := make(map[int64]bool, len(ids))
+	for _, id := range ids {
+		if cited[id] {
+			continue
+		}
+		article, ok := byID[id]
+		if !ok {
+			logger.Warn("Ignoring citation to an article that was not provided",
+				zap.Int64("article_id", id),
+			)
+			continue
+		}
+		citations = append(citations, article)
+		cited[id] = true
+	}
+	return citations
+}
+
+// 文字数の上限で切り詰める
+func truncateRunes(s string, limit int) string {
+	if utf8.RuneCountInString(s) <= limit {
+		return s
+	}
+	return string([]rune(s)[:limit]) + "…"
+}
@@ -0,0 +1,173 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// モックQuestionAnswerer
+type mockQuestionAnswerer struct {
+	answerFunc func(ctx context.Context, req service.QuestionAnswerRequest) (*service.QuestionAnswer, error)
+}
+
+func (m *mockQuestionAnswerer) AnswerQuestion(ctx context.Context, req service.QuestionAnswerRequest) (*service.QuestionAnswer, error) {
+	return m.answerFunc(ctx, req)
+}
+
+// 検索語ごとの検索結果を返すリポジトリ
+func newAskArticleRepository(articles []*entity.Article) *mockArticleRepository {
+	return &mockArticleRepository{
+		searchFunc: func(ctx context.Context, keyword string) ([]*entity.Article, error) {
+			var found []*entity.Article
+			for _, article := range articles {
+				text := strings.ToLower(article.Title + " " + article.Summary)
+				if strings.Contains(text, strings.ToLower(keyword)) {
+					found = append(found, article)
+				}
+			}
+			return found, nil
+		},
+	}
+}
+
+func TestExtractSearchTerms(t *testing.T) {
+	tests := []struct {
+		name     string
+		question string
+		expected []string
+	}{
+		{
+			name:     "英語の質問からストップワードを除く",
+			question: "What did we save about Kubernetes autoscaling?",
+			expected: []string{"Kubernetes", "autoscaling"},
+		},
+		{
+			name:     "日本語の質問をひらがなで区切る",
+			question: "Kubernetesのオートスケーリングについて保存した記事は？",
+			expected: []string{"Kubernetes", "オートスケーリング", "保存", "記事"},
+		},
+		{
+			name:     "重複と1文字の語を除く",
+			question: "Go and go, a Go",
+			expected: []string{"Go"},
+		},
+		{
+			name:     "検索語がない",
+			question: "これはなに？",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, extractSearchTerms(tt.question))
+		})
+	}
+}
+
+func TestAsk(t *testing.T) {
+	now := time.Now()
+	articles := []*entity.Article{
+		{ID: 1, Title: "Kubernetes入門", Summary: "Podとデプロイの基本", CreatedAt: now.Add(-2 * time.Hour)},
+		{ID: 2, Title: "HPAによるKubernetes autoscaling", Summary: "負荷に応じてPodを増減する", Memo: "本番で採用", CreatedAt: now.Add(-time.Hour)},
+		{ID: 3, Title: "Go言語の並行処理", Summary: "goroutineとchannel", CreatedAt: now},
+	}
+
+	t.Run("正常系：一致した検索語が多い記事を根拠として渡し、引用を返す", func(t *testing.T) {
+		var received service.QuestionAnswerRequest
+		answerer := &mockQuestionAnswerer{
+			answerFunc: func(ctx context.Context, req service.QuestionAnswerRequest) (*service.QuestionAnswer, error) {
+				received = req
+				return &service.QuestionAnswer{Answer: "HPAを使います [#2]", CitedArticleIDs: []int64{2, 2, 99}}, nil
+			},
+		}
+		uc := NewAskUsecase(newAskArticleRepository(articles), answerer)
+
+		result, err := uc.Ask(context.Background(), AskInput{Question: "What did we save about Kubernetes autoscaling?"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "HPAを使います [#2]", result.Answer)
+		require.Len(t, result.Citations, 1)
+		assert.Equal(t, int64(2), result.Citations[0].ID)
+
+		require.Len(t, received.Sources, 2)
+		assert.Equal(t, int64(2), received.Sources[0].ArticleID)
+		assert.Equal(t, "本番で採用", received.Sources[0].Memo)
+		assert.Equal(t, int64(1), received.Sources[1].ArticleID)
+		assert.Equal(t, "What did we save about Kubernetes autoscaling?", received.Question)
+	})
+
+	t.Run("正常系：根拠として渡す記事数を制限できる", func(t *testing.T) {
+		var received service.QuestionAnswerRequest
+		answerer := &mockQuestionAnswerer{
+			answerFunc: func(ctx context.Context, req service.QuestionAnswerRequest) (*service.QuestionAnswer, error) {
+				received = req
+				return &service.QuestionAnswer{Answer: "回答"}, nil
+			},
+		}
+		uc := NewAskUsecase(newAskArticleRepository(articles), answerer)
+
+		result, err := uc.Ask(context.Background(), AskInput{Question: "Kubernetes autoscaling", MaxSources: 1})
+
+		require.NoError(t, err)
+		assert.Len(t, received.Sources, 1)
+		assert.Len(t, result.Sources, 1)
+		assert.Empty(t, result.Citations)
+	})
+
+	t.Run("正常系：関連する記事がない場合はAIを呼び出さない", func(t *testing.T) {
+		answerer := &mockQuestionAnswerer{
+			answerFunc: func(ctx context.Context, req service.QuestionAnswerRequest) (*service.QuestionAnswer, error) {
+				t.Fatal("answerer should not be called")
+				return nil, nil
+			},
+		}
+		uc := NewAskUsecase(newAskArticleRepository(articles), answerer)
+
+		result, err := uc.Ask(context.Background(), AskInput{Question: "Rustのマクロについて"})
+
+		require.NoError(t, err)
+		assert.Empty(t, result.Answer)
+		assert.Empty(t, result.Citations)
+		assert.Empty(t, result.Sources)
+	})
+
+	t.Run("異常系：入力が不正", func(t *testing.T) {
+		uc := NewAskUsecase(newAskArticleRepository(articles), &mockQuestionAnswerer{})
+
+		for _, input := range []AskInput{
+			{Question: "  "},
+			{Question: strings.Repeat("あ", 1001)},
+			{Question: "Kubernetes", MaxSources: 11},
+			{Question: "Kubernetes", MaxSources: -1},
+			{Question: "Kubernetes", Language: "japanese"},
+		} {
+			_, err := uc.Ask(context.Background(), input)
+			assert.True(t, domainerrors.GetErrorCode(err) == domainerrors.ErrCodeInvalidArgument, "input: %+v", input)
+		}
+	})
+
+	t.Run("異常系：AIの呼び出しに失敗", func(t *testing.T) {
+		aiErr := &service.AIGeneratorError{Code: service.ErrCodeAPILimit, Message: "Rate limit exceeded"}
+		answerer := &mockQuestionAnswerer{
+			answerFunc: func(ctx context.Context, req service.QuestionAnswerRequest) (*service.QuestionAnswer, error) {
+				return nil, aiErr
+			},
+		}
+		uc := NewAskUsecase(newAskArticleRepository(articles), answerer)
+
+		_, err := uc.Ask(context.Background(), AskInput{Question: "Kubernetes"})
+
+		assert.True(t, errors.Is(err, aiErr))
+	})
+}
@@ -0,0 +1,159 @@
+package usecase
+
+import (
+	"context"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// 書籍フィードバック作成の入力
+type CreateBookFeedbackInput struct {
+	Title  string
+	Author string
+	ISBN13 string
+	State  string
+}
+
+// 書籍へのフィードバックに関するビジネスロジック
+type BookFeedbackUsecase struct {
+	repo repository.BookFeedbackRepository
+}
+
+// コンストラクタ
+func NewBookFeedbackUsecase(repo repository.BookFeedbackRepository) *BookFeedbackUsecase {
+	return &BookFeedbackUsecase{repo: repo}
+}
+
+// 新しいフィードバックを作成
+func (u *BookFeedbackUsecase) CreateBookFeedback(ctx context.Context, input CreateBookFeedbackInput) (*entity.BookFeedback, error) {
+	logger.Debug("Creating book feedback",
+		zap.String("title", input.Title),
+		zap.String("state", input.State),
+	)
+
+	state, err := entity.ParseBookFeedbackState(input.State)
+	if err != nil {
+		return nil, domainerrors.InvalidArgumentError("state", err.Error())
+	}
+
+	feedback, err := entity.NewBookFeedback(input.Title, input.Author, input.ISBN13, state)
+	if err != nil {
+		logger.Warn("Failed to create book feedback entity",
+			zap.Error(err),
+			zap.String("title", input.Title),
+		)
+		return nil, domainerrors.ValidationError("book_feedback", err.Error())
+	}
+
+	saved, err := u.repo.Create(ctx, feedback)
+	if err != nil {
+		logger.Error("Failed to save book feedback to repository",
+			zap.Error(err),
+			zap.String("key", feedback.Key),
+		)
+		return nil, err
+	}
+
+	logger.Info("Successfully created book feedback",
+		zap.Int64("id", saved.ID),
+		zap.String("key", saved.Key),
+		zap.String("state", string(saved.State)),
+	)
+
+	return saved, nil
+}
+
+// 指定されたIDのフィードバックを取得
+func (u *BookFeedbackUsecase) GetBookFeedbackByID(ctx context.Context, id int64) (*entity.BookFeedback, error) {
+	if id <= 0 {
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	feedback, err := u.repo.FindByID(ctx, id)
+	if err != nil {
+		logger.Warn("Failed to find book feedback",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return nil, err
+	}
+
+	return feedback, nil
+}
+
+// 全てのフィードバックを取得
+func (u *BookFeedbackUsecase) GetAllBookFeedback(ctx context.Context) ([]*entity.BookFeedback, error) {
+	feedback, err := u.repo.FindAll(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve all book feedback",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return feedback, nil
+}
+
+// フィードバックの状態を更新
+func (u *BookFeedbackUsecase) UpdateBookFeedback(ctx context.Context, id int64, stateValue string) (*entity.BookFeedback, error) {
+	logger.Debug("Updating book feedback",
+		zap.Int64("id", id),
+		zap.String("state", stateValue),
+	)
+
+	state, err := entity.ParseBookFeedbackState(stateValue)
+	if err != nil {
+		return nil, domainerrors.InvalidArgumentError("state", err.Error())
+	}
+
+	feedback, err := u.GetBookFeedbackByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := feedback.UpdateState(state); err != nil {
+		return nil, domainerrors.ValidationError("book_feedback", err.Error())
+	}
+
+	updated, err := u.repo.Update(ctx, feedback)
+	if err != nil {
+		logger.Error("Failed to update book feedback in repository",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return nil, err
+	}
+
+	logger.Info("Successfully updated book feedback",
+		zap.Int64("id", id),
+		zap.String("state", string(updated.State)),
+	)
+
+	return updated, nil
+}
+
+// 指定されたIDのフィードバックを削除
+func (u *BookFeedbackUsecase) DeleteBookFeedback(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	if err := u.repo.Delete(ctx, id); err != nil {
+		logger.Error("Failed to delete book feedback from repository",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return err
+	}
+
+	logger.Info("Successfully deleted book feedback",
+		zap.Int64("id", id),
+	)
+
+	return nil
+}
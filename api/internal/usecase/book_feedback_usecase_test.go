@@ -0,0 +1,166 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// モック BookFeedbackRepository
+type mockBookFeedbackRepository struct {
+	createFunc   func(ctx context.Context, feedback *entity.BookFeedback) (*entity.BookFeedback, error)
+	findByIDFunc func(ctx context.Context, id int64) (*entity.BookFeedback, error)
+	findAllFunc  func(ctx context.Context) ([]*entity.BookFeedback, error)
+	updateFunc   func(ctx context.Context, feedback *entity.BookFeedback) (*entity.BookFeedback, error)
+	deleteFunc   func(ctx context.Context, id int64) error
+}
+
+func (m *mockBookFeedbackRepository) Create(ctx context.Context, feedback *entity.BookFeedback) (*entity.BookFeedback, error) {
+	return m.createFunc(ctx, feedback)
+}
+
+func (m *mockBookFeedbackRepository) FindByID(ctx context.Context, id int64) (*entity.BookFeedback, error) {
+	return m.findByIDFunc(ctx, id)
+}
+
+// フィードバックが未登録の場合を既定とする
+func (m *mockBookFeedbackRepository) FindAll(ctx context.Context) ([]*entity.BookFeedback, error) {
+	if m.findAllFunc == nil {
+		return nil, nil
+	}
+	return m.findAllFunc(ctx)
+}
+
+func (m *mockBookFeedbackRepository) Update(ctx context.Context, feedback *entity.BookFeedback) (*entity.BookFeedback, error) {
+	return m.updateFunc(ctx, feedback)
+}
+
+func (m *mockBookFeedbackRepository) Delete(ctx context.Context, id int64) error {
+	return m.deleteFunc(ctx, id)
+}
+
+// CreateBookFeedbackのテスト
+func TestCreateBookFeedback(t *testing.T) {
+	t.Run("正常系：フィードバックを作成できる", func(t *testing.T) {
+		mockRepo := &mockBookFeedbackRepository{
+			createFunc: func(ctx context.Context, feedback *entity.BookFeedback) (*entity.BookFeedback, error) {
+				feedback.ID = 1
+				return feedback, nil
+			},
+		}
+		usecase := NewBookFeedbackUsecase(mockRepo)
+
+		result, err := usecase.CreateBookFeedback(context.Background(), CreateBookFeedbackInput{
+			Title:  "リーダブルコード",
+			Author: "Dustin Boswell",
+			ISBN13: "978-4-87311-565-8",
+			State:  "owned",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.ID)
+		assert.Equal(t, "9784873115658", result.ISBN13)
+		assert.Equal(t, "isbn:9784873115658", result.Key)
+		assert.Equal(t, entity.BookFeedbackOwned, result.State)
+	})
+
+	t.Run("異常系：不正な状態はInvalidArgumentエラー", func(t *testing.T) {
+		usecase := NewBookFeedbackUsecase(&mockBookFeedbackRepository{})
+
+		_, err := usecase.CreateBookFeedback(context.Background(), CreateBookFeedbackInput{
+			Title: "リーダブルコード",
+			State: "wishlist",
+		})
+
+		require.Error(t, err)
+		assert.Equal(t, domainerrors.ErrCodeInvalidArgument, domainerrors.GetErrorCode(err))
+	})
+
+	t.Run("異常系：タイトルが空の場合はバリデーションエラー", func(t *testing.T) {
+		usecase := NewBookFeedbackUsecase(&mockBookFeedbackRepository{})
+
+		_, err := usecase.CreateBookFeedback(context.Background(), CreateBookFeedbackInput{
+			Title: "  ",
+			State: "liked",
+		})
+
+		require.Error(t, err)
+		assert.True(t, domainerrors.IsValidationError(err))
+	})
+
+	t.Run("異常系：同じ書籍へのフィードバックは重複エラー", func(t *testing.T) {
+		mockRepo := &mockBookFeedbackRepository{
+			createFunc: func(ctx context.Context, feedback *entity.BookFeedback) (*entity.BookFeedback, error) {
+				return nil, domainerrors.AlreadyExistsError("book_feedback", feedback.Key)
+			},
+		}
+		usecase := NewBookFeedbackUsecase(mockRepo)
+
+		_, err := usecase.CreateBookFeedback(context.Background(), CreateBookFeedbackInput{
+			Title: "リーダブルコード",
+			State: "dismissed",
+		})
+
+		require.Error(t, err)
+		assert.True(t, domainerrors.IsAlreadyExistsError(err))
+	})
+}
+
+// UpdateBookFeedbackのテスト
+func TestUpdateBookFeedback(t *testing.T) {
+	t.Run("正常系：状態を更新できる", func(t *testing.T) {
+		existing, err := entity.NewBookFeedback("リーダブルコード", "", "", entity.BookFeedbackOwned)
+		require.NoError(t, err)
+		existing.ID = 1
+
+		var updated *entity.BookFeedback
+		mockRepo := &mockBookFeedbackRepository{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.BookFeedback, error) {
+				return existing, nil
+			},
+			updateFunc: func(ctx context.Context, feedback *entity.BookFeedback) (*entity.BookFeedback, error) {
+				updated = feedback
+				return feedback, nil
+			},
+		}
+		usecase := NewBookFeedbackUsecase(mockRepo)
+
+		result, err := usecase.UpdateBookFeedback(context.Background(), 1, "read")
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.BookFeedbackRead, result.State)
+		require.NotNil(t, updated)
+		assert.Equal(t, entity.BookFeedbackRead, updated.State)
+	})
+
+	t.Run("異常系：存在しないフィードバックはNotFoundエラー", func(t *testing.T) {
+		mockRepo := &mockBookFeedbackRepository{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.BookFeedback, error) {
+				return nil, domainerrors.NotFoundError("book_feedback", id)
+			},
+		}
+		usecase := NewBookFeedbackUsecase(mockRepo)
+
+		_, err := usecase.UpdateBookFeedback(context.Background(), 99, "liked")
+
+		require.Error(t, err)
+		assert.True(t, domainerrors.IsNotFoundError(err))
+	})
+}
+
+// DeleteBookFeedbackのテスト
+func TestDeleteBookFeedback(t *testing.T) {
+	t.Run("異常系：IDが0以下の場合はInvalidArgumentエラー", func(t *testing.T) {
+		usecase := NewBookFeedbackUsecase(&mockBookFeedbackRepository{})
+
+		err := usecase.DeleteBookFeedback(context.Background(), 0)
+
+		require.Error(t, err)
+		assert.Equal(t, domainerrors.ErrCodeInvalidArgument, domainerrors.GetErrorCode(err))
+	})
+}
@@ -1,79 +1,250 @@
 package usecase
 
 import (
+	"cmp"
 	"context"
+	"errors"
+	"slices"
+	"time"
 
 	"article-manager/internal/domain/entity"
 	domainerrors "article-manager/internal/domain/errors"
 	"article-manager/internal/domain/repository"
 	"article-manager/internal/domain/service"
 	"article-manager/internal/infrastructure/logger"
+	"article-manager/internal/infrastructure/resilience"
 
 	"go.uber.org/zap"
 )
 
+// 推薦のプロンプトに含める記事の上限
+const (
+	maxRecommendationArticles = 30
+	// 上限のうち新しい順に選ぶ記事数（残りはまだ含まれていないタグを持つ記事から選ぶ）
+	recentRecommendationArticles = 20
+)
+
+// 推薦の履歴の取得件数
+const (
+	DefaultBookRecommendationHistoryLimit = 20
+	MaxBookRecommendationHistoryLimit     = 100
+)
+
+// 一度の事前生成で生成し直すキャッシュの上限（AIの呼び出し回数を抑えるため）
+const maxPrewarmBookRecommendations = 10
+
+// 書籍推薦の設定
+type BookRecommendationConfig struct {
+	TTL           time.Duration        // 全記事・タグごとの推薦の有効期間
+	ArticleTTL    time.Duration        // 記事ごとの推薦の有効期間
+	PrewarmBefore time.Duration        // 有効期限のどれだけ前に事前生成するか（0以下の場合は事前生成しない）
+	TagNamePolicy entity.TagNamePolicy // タグごとの推薦で記事のタグを比較する正規化方針
+}
+
+// デフォルトの書籍推薦設定
+func DefaultBookRecommendationConfig() BookRecommendationConfig {
+	return BookRecommendationConfig{
+		TTL:           24 * time.Hour,
+		ArticleTTL:    7 * 24 * time.Hour,
+		PrewarmBefore: time.Hour,
+		TagNamePolicy: entity.DefaultTagNamePolicy(),
+	}
+}
+
+// 設定値を検証する（有効期間は正の値が必要）
+func (c BookRecommendationConfig) Validate() error {
+	if c.TTL <= 0 {
+		return errors.New("book recommendation ttl must be positive")
+	}
+	if c.ArticleTTL <= 0 {
+		return errors.New("book recommendation article ttl must be positive")
+	}
+	return nil
+}
+
 // 書籍推薦ユースケース
 type BookRecommendationUsecase struct {
 	articleRepo               repository.ArticleRepository
+	tagRepo                   repository.TagRepository
 	bookRecommendationRepo    repository.BookRecommendationRepository
 	bookRecommendationService service.BookRecommendationService
+	bookFeedbackRepo          repository.BookFeedbackRepository
+	config                    BookRecommendationConfig
+	// 同じ対象範囲の推薦の生成が同時に実行されないようにする
+	inflight *resilience.SingleFlight[*entity.BookRecommendationCache]
 }
 
 // コンストラクタ
 func NewBookRecommendationUsecase(
 	articleRepo repository.ArticleRepository,
+	tagRepo repository.TagRepository,
 	bookRecommendationRepo repository.BookRecommendationRepository,
 	bookRecommendationService service.BookRecommendationService,
+	bookFeedbackRepo repository.BookFeedbackRepository,
+	config BookRecommendationConfig,
 ) *BookRecommendationUsecase {
 	return &BookRecommendationUsecase{
 		articleRepo:               articleRepo,
+		tagRepo:                   tagRepo,
 		bookRecommendationRepo:    bookRecommendationRepo,
 		bookRecommendationService: bookRecommendationService,
+		bookFeedbackRepo:          bookFeedbackRepo,
+		config:                    config,
+		inflight:                  resilience.NewSingleFlight[*entity.BookRecommendationCache](),
 	}
 }
 
-// 書籍推薦を取得
-func (u *BookRecommendationUsecase) GetBookRecommendations(ctx context.Context) (*entity.BookRecommendationCache, error) {
-	logger.Debug("Getting book recommendations")
+// 指定された対象範囲の書籍推薦を取得
+func (u *BookRecommendationUsecase) GetBookRecommendations(ctx context.Context, scope entity.BookRecommendationScope) (*entity.BookRecommendationCache, error) {
+	return u.getBookRecommendations(ctx, scope, false)
+}
 
-	// キャッシュ確認
-	cache, err := u.bookRecommendationRepo.FindLatestValid(ctx)
-	if err == nil && cache != nil && cache.IsValid() {
-		logger.Info("Returning cached book recommendations",
-			zap.Time("generated_at", cache.GeneratedAt),
-			zap.Time("expires_at", cache.ExpiresAt),
-			zap.Int("count", len(cache.Books)),
+// キャッシュを使用せずに書籍推薦を生成し直す
+func (u *BookRecommendationUsecase) RefreshBookRecommendations(ctx context.Context, scope entity.BookRecommendationScope) (*entity.BookRecommendationCache, error) {
+	return u.getBookRecommendations(ctx, scope, true)
+}
+
+// 有効期限が近いキャッシュを、期限が切れる前に生成し直す
+// 期限切れ直後のリクエストがAIの応答を待たずに済むよう、バックグラウンドで定期的に実行する
+func (u *BookRecommendationUsecase) PrewarmBookRecommendations(ctx context.Context) (int, error) {
+	if u.config.PrewarmBefore <= 0 {
+		return 0, nil
+	}
+
+	caches, err := u.bookRecommendationRepo.FindValidExpiringBefore(ctx, time.Now().Add(u.config.PrewarmBefore))
+	if err != nil {
+		logger.Error("Failed to find expiring book recommendation caches",
+			zap.Error(err),
 		)
-		return cache, nil
+		return 0, err
 	}
 
-	logger.Debug("Cache not found or expired, generating new recommendations")
+	refreshed := 0
+	for _, cache := range caches {
+		if refreshed >= maxPrewarmBookRecommendations {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return refreshed, err
+		}
 
-	// 全記事を取得
-	articles, err := u.articleRepo.FindAll(ctx)
+		scope, err := entity.ParseBookRecommendationScopeKey(cache.ScopeKey)
+		if err != nil {
+			logger.Warn("Skipping book recommendation cache with invalid scope key",
+				zap.Error(err),
+				zap.Int64("cache_id", cache.ID),
+			)
+			continue
+		}
+		if _, err := u.RefreshBookRecommendations(ctx, scope); err != nil {
+			logger.Warn("Failed to prewarm book recommendations",
+				zap.Error(err),
+				zap.String("scope_key", cache.ScopeKey),
+			)
+			continue
+		}
+		refreshed++
+	}
+
+	logger.Info("Prewarmed book recommendations",
+		zap.Int("expiring", len(caches)),
+		zap.Int("refreshed", refreshed),
+	)
+
+	return refreshed, nil
+}
+
+func (u *BookRecommendationUsecase) getBookRecommendations(ctx context.Context, scope entity.BookRecommendationScope, force bool) (*entity.BookRecommendationCache, error) {
+	scope, err := u.normalizeScope(ctx, scope)
 	if err != nil {
-		logger.Error("Failed to retrieve articles for recommendations",
+		return nil, err
+	}
+	if scope.ArticleID < 0 {
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+	scopeKey := scope.Key()
+	logger.Debug("Getting book recommendations",
+		zap.String("scope_key", scopeKey),
+		zap.Bool("force", force),
+	)
+
+	// 書籍へのフィードバックを取得
+	feedback, err := u.bookFeedbackRepo.FindAll(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve book feedback",
 			zap.Error(err),
 		)
 		return nil, err
 	}
 
+	// 対象範囲の記事を取得（キャッシュ後に記事が変わっていないかの確認にも使用する）
+	articles, err := u.scopeArticles(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	// キャッシュ確認
+	// キャッシュ後に所有・興味なしとした書籍も除外されるよう、返す時点で除外する
+	if !force {
+		cache, err := u.bookRecommendationRepo.FindLatestValid(ctx, scopeKey)
+		if err == nil && cache != nil && cache.IsValid() {
+			if !cache.IsStale(articles) {
+				logger.Info("Returning cached book recommendations",
+					zap.Time("generated_at", cache.GeneratedAt),
+					zap.Time("expires_at", cache.ExpiresAt),
+					zap.Int("count", len(cache.Books)),
+				)
+				return withoutFeedbackBooks(cache, feedback), nil
+			}
+			logger.Info("Cached book recommendations are stale, regenerating",
+				zap.Int64("cache_id", cache.ID),
+				zap.String("scope_key", scopeKey),
+			)
+		} else {
+			logger.Debug("Cache not found or expired, generating new recommendations")
+		}
+	}
+
 	// 記事が0件の場合は空の推薦を返す
 	if len(articles) == 0 {
-		logger.Info("No articles found, returning empty recommendations")
-		emptyCache, err := entity.NewBookRecommendationCache([]entity.Book{})
+		logger.Info("No articles found, returning empty recommendations",
+			zap.String("scope_key", scopeKey),
+		)
+		emptyCache, err := entity.NewBookRecommendationCache(scope, []entity.Book{}, u.ttl(scope))
 		if err != nil {
 			return nil, err
 		}
 		return emptyCache, nil
 	}
 
+	// 同じ対象範囲の生成が実行中の場合は、その結果を共有する
+	// 呼び出し元がキャンセルしても、結果を待っている他のリクエストのために生成は続ける
+	cache, shared, err := u.inflight.Do(scopeKey, func() (*entity.BookRecommendationCache, error) {
+		return u.generateBookRecommendations(context.WithoutCancel(ctx), scope, articles, feedback)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if shared {
+		logger.Debug("Shared in-flight book recommendation generation",
+			zap.String("scope_key", scopeKey),
+		)
+	}
+
+	return withoutFeedbackBooks(cache, feedback), nil
+}
+
+// AIで書籍を推薦し、キャッシュとして保存する
+func (u *BookRecommendationUsecase) generateBookRecommendations(ctx context.Context, scope entity.BookRecommendationScope, articles []*entity.Article, feedback []*entity.BookFeedback) (*entity.BookRecommendationCache, error) {
+	// プロンプトが大きくなりすぎないよう記事を絞り込む
+	sampled := sampleRecommendationArticles(articles, maxRecommendationArticles, u.config.TagNamePolicy)
 	logger.Debug("Retrieved articles for recommendation",
 		zap.Int("article_count", len(articles)),
+		zap.Int("sampled_count", len(sampled)),
 	)
 
 	// AIで書籍を推薦
-	books, err := u.bookRecommendationService.RecommendBooks(ctx, articles)
+	books, err := u.bookRecommendationService.RecommendBooks(ctx, sampled, feedback)
 	if err != nil {
 		logger.Error("Failed to generate book recommendations from AI",
 			zap.Error(err),
@@ -86,13 +257,17 @@ func (u *BookRecommendationUsecase) GetBookRecommendations(ctx context.Context)
 	)
 
 	// キャッシュを作成して保存
-	cache, err = entity.NewBookRecommendationCache(books)
+	cache, err := entity.NewBookRecommendationCache(scope, books, u.ttl(scope))
 	if err != nil {
 		logger.Error("Failed to create book recommendations cache",
 			zap.Error(err),
 		)
 		return nil, domainerrors.ValidationError("book_recommendation_cache", err.Error())
 	}
+	// プロンプトに含めた記事数ではなく、対象範囲の記事数を記録する
+	cache.ArticleCount = len(articles)
+	cache.Model = u.bookRecommendationService.RecommendationModel()
+	cache.PromptVersion = u.bookRecommendationService.RecommendationPromptVersion()
 
 	savedCache, err := u.bookRecommendationRepo.Save(ctx, cache)
 	if err != nil {
@@ -104,9 +279,212 @@ func (u *BookRecommendationUsecase) GetBookRecommendations(ctx context.Context)
 
 	logger.Info("Successfully generated and cached book recommendations",
 		zap.Int64("cache_id", savedCache.ID),
+		zap.String("scope_key", savedCache.ScopeKey),
 		zap.Int("book_count", len(savedCache.Books)),
 		zap.Time("expires_at", savedCache.ExpiresAt),
 	)
 
 	return savedCache, nil
 }
+
+// 対象範囲ごとのキャッシュの有効期間
+func (u *BookRecommendationUsecase) ttl(scope entity.BookRecommendationScope) time.Duration {
+	if scope.ArticleID > 0 {
+		return u.config.ArticleTTL
+	}
+	return u.config.TTL
+}
+
+// フィードバックを反映した推薦を返す（生成結果は複数のリクエストで共有されるため、コピーして変更する）
+func withoutFeedbackBooks(cache *entity.BookRecommendationCache, feedback []*entity.BookFeedback) *entity.BookRecommendationCache {
+	result := *cache
+	result.Books = excludeBooksByFeedback(cache.Books, feedback)
+	return &result
+}
+
+// 指定された対象範囲の推薦の履歴を、1つ前の推薦からの変化とともに新しい順に取得
+func (u *BookRecommendationUsecase) GetBookRecommendationHistory(ctx context.Context, scope entity.BookRecommendationScope, limit int) ([]*entity.BookRecommendationHistoryEntry, error) {
+	scope, err := u.normalizeScope(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	if scope.ArticleID < 0 {
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+	if limit <= 0 {
+		limit = DefaultBookRecommendationHistoryLimit
+	}
+	if limit > MaxBookRecommendationHistoryLimit {
+		return nil, domainerrors.InvalidArgumentError("limit", "limit must be 100 or less")
+	}
+
+	// 最も古い推薦の差分を求めるため、1件多く取得する
+	history, err := u.bookRecommendationRepo.FindHistory(ctx, scope.Key(), limit+1)
+	if err != nil {
+		logger.Error("Failed to retrieve book recommendation history",
+			zap.Error(err),
+			zap.String("scope_key", scope.Key()),
+		)
+		return nil, err
+	}
+
+	entries := make([]*entity.BookRecommendationHistoryEntry, 0, min(len(history), limit))
+	for i := 0; i < len(history) && i < limit; i++ {
+		var previous *entity.BookRecommendationCache
+		if i+1 < len(history) {
+			previous = history[i+1]
+		}
+		added, removed := entity.DiffBookRecommendations(previous, history[i])
+		entries = append(entries, &entity.BookRecommendationHistoryEntry{
+			Recommendation: history[i],
+			Added:          added,
+			Removed:        removed,
+		})
+	}
+
+	return entries, nil
+}
+
+// 保持期間を過ぎた推薦の履歴を削除する
+func (u *BookRecommendationUsecase) PruneBookRecommendationHistory(ctx context.Context, retention time.Duration) (int64, error) {
+	if retention <= 0 {
+		return 0, domainerrors.InvalidArgumentError("retention", "retention must be positive")
+	}
+
+	deleted, err := u.bookRecommendationRepo.DeleteGeneratedBefore(ctx, time.Now().Add(-retention))
+	if err != nil {
+		logger.Error("Failed to prune book recommendation history",
+			zap.Error(err),
+		)
+		return 0, err
+	}
+
+	logger.Info("Pruned book recommendation history",
+		zap.Int64("deleted", deleted),
+		zap.Duration("retention", retention),
+	)
+
+	return deleted, nil
+}
+
+// 所有している・興味がないとフィードバックされた書籍を除外する
+func excludeBooksByFeedback(books []entity.Book, feedback []*entity.BookFeedback) []entity.Book {
+	filtered := make([]entity.Book, 0, len(books))
+	for _, book := range books {
+		excluded := slices.ContainsFunc(feedback, func(f *entity.BookFeedback) bool {
+			return f.ExcludesRecommendation() && f.Matches(book)
+		})
+		if excluded {
+			logger.Debug("Excluding book by feedback",
+				zap.String("title", book.Title),
+			)
+			continue
+		}
+		filtered = append(filtered, book)
+	}
+	return filtered
+}
+
+// 対象範囲のタグを正規化キーに揃え、別名の場合は代表のタグのキーにする
+// 別名で指定しても代表のタグと同じキャッシュと履歴を使うため
+func (u *BookRecommendationUsecase) normalizeScope(ctx context.Context, scope entity.BookRecommendationScope) (entity.BookRecommendationScope, error) {
+	scope = scope.Normalize(u.config.TagNamePolicy)
+	if scope.ArticleID > 0 || scope.Tag == "" {
+		return scope, nil
+	}
+
+	tagKey, _, err := resolveTagFilterKeys(ctx, u.tagRepo, scope.Tag)
+	if err != nil {
+		return scope, err
+	}
+	scope.Tag = tagKey
+	return scope, nil
+}
+
+// 対象範囲の記事を取得
+func (u *BookRecommendationUsecase) scopeArticles(ctx context.Context, scope entity.BookRecommendationScope) ([]*entity.Article, error) {
+	if scope.ArticleID > 0 {
+		article, err := u.articleRepo.FindByID(ctx, scope.ArticleID)
+		if err != nil {
+			return nil, err
+		}
+		return []*entity.Article{article}, nil
+	}
+
+	articles, err := u.articleRepo.FindAll(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve articles for recommendations",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	if scope.Tag == "" {
+		return articles, nil
+	}
+
+	// 記事一覧のタグによる絞り込みと同じく、子孫のタグが付いた記事も含める
+	_, tagKeys, err := resolveTagFilterKeys(ctx, u.tagRepo, scope.Tag)
+	if err != nil {
+		return nil, err
+	}
+	policy := u.tagRepo.NamePolicy()
+	tagged := make([]*entity.Article, 0, len(articles))
+	for _, article := range articles {
+		if slices.ContainsFunc(article.Tags, func(tag string) bool {
+			return tagKeys[policy.Key(tag)]
+		}) {
+			tagged = append(tagged, article)
+		}
+	}
+	return tagged, nil
+}
+
+// 記事が上限を超える場合は、新しい記事と、それまでに含まれていないタグを多く持つ記事を選ぶ
+// 選んだ記事は新しい順に返す
+func sampleRecommendationArticles(articles []*entity.Article, limit int, policy entity.TagNamePolicy) []*entity.Article {
+	sorted := slices.Clone(articles)
+	slices.SortFunc(sorted, func(a, b *entity.Article) int {
+		return cmp.Or(b.CreatedAt.Compare(a.CreatedAt), cmp.Compare(b.ID, a.ID))
+	})
+	if len(sorted) <= limit {
+		return sorted
+	}
+
+	recent := min(recentRecommendationArticles, limit)
+	selected := slices.Clone(sorted[:recent])
+	covered := make(map[string]bool)
+	for _, article := range selected {
+		for _, tag := range article.Tags {
+			covered[policy.Key(tag)] = true
+		}
+	}
+
+	remaining := sorted[recent:]
+	for len(selected) < limit && len(remaining) > 0 {
+		// まだ含まれていないタグが最も多い記事（同数なら新しい記事）を選ぶ
+		best, bestScore := 0, -1
+		for i, article := range remaining {
+			score := 0
+			for _, tag := range article.Tags {
+				if !covered[policy.Key(tag)] {
+					score++
+				}
+			}
+			if score > bestScore {
+				best, bestScore = i, score
+			}
+		}
+
+		article := remaining[best]
+		selected = append(selected, article)
+		for _, tag := range article.Tags {
+			covered[policy.Key(tag)] = true
+		}
+		remaining = slices.Delete(remaining, best, best+1)
+	}
+
+	slices.SortFunc(selected, func(a, b *entity.Article) int {
+		return cmp.Or(b.CreatedAt.Compare(a.CreatedAt), cmp.Compare(b.ID, a.ID))
+	})
+	return selected
+}
@@ -3,10 +3,14 @@ package usecase
 import (
 	"context"
 	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
 	"article-manager/internal/domain/service"
 
 	"github.com/stretchr/testify/assert"
@@ -15,25 +19,53 @@ import (
 
 // モック BookRecommendationRepository
 type mockBookRecommendationRepository struct {
-	findLatestValidFunc func(ctx context.Context) (*entity.BookRecommendationCache, error)
+	findLatestValidFunc func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error)
 	saveFunc            func(ctx context.Context, cache *entity.BookRecommendationCache) (*entity.BookRecommendationCache, error)
+	findHistoryFunc     func(ctx context.Context, scopeKey string, limit int) ([]*entity.BookRecommendationCache, error)
+	deleteBeforeFunc    func(ctx context.Context, before time.Time) (int64, error)
+	findExpiringFunc    func(ctx context.Context, before time.Time) ([]*entity.BookRecommendationCache, error)
+	findByIDFunc        func(ctx context.Context, id int64) (*entity.BookRecommendationCache, error)
 }
 
-func (m *mockBookRecommendationRepository) FindLatestValid(ctx context.Context) (*entity.BookRecommendationCache, error) {
-	return m.findLatestValidFunc(ctx)
+func (m *mockBookRecommendationRepository) FindLatestValid(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
+	return m.findLatestValidFunc(ctx, scopeKey)
 }
 
 func (m *mockBookRecommendationRepository) Save(ctx context.Context, cache *entity.BookRecommendationCache) (*entity.BookRecommendationCache, error) {
 	return m.saveFunc(ctx, cache)
 }
 
+func (m *mockBookRecommendationRepository) FindByID(ctx context.Context, id int64) (*entity.BookRecommendationCache, error) {
+	return m.findByIDFunc(ctx, id)
+}
+
+func (m *mockBookRecommendationRepository) FindHistory(ctx context.Context, scopeKey string, limit int) ([]*entity.BookRecommendationCache, error) {
+	return m.findHistoryFunc(ctx, scopeKey, limit)
+}
+
+func (m *mockBookRecommendationRepository) FindValidExpiringBefore(ctx context.Context, before time.Time) ([]*entity.BookRecommendationCache, error) {
+	return m.findExpiringFunc(ctx, before)
+}
+
+func (m *mockBookRecommendationRepository) DeleteGeneratedBefore(ctx context.Context, before time.Time) (int64, error) {
+	return m.deleteBeforeFunc(ctx, before)
+}
+
 // モック BookRecommendationService
 type mockBookRecommendationService struct {
-	recommendBooksFunc func(ctx context.Context, articles []*entity.Article) ([]entity.Book, error)
+	recommendBooksFunc func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error)
+}
+
+func (m *mockBookRecommendationService) RecommendBooks(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
+	return m.recommendBooksFunc(ctx, articles, feedback)
 }
 
-func (m *mockBookRecommendationService) RecommendBooks(ctx context.Context, articles []*entity.Article) ([]entity.Book, error) {
-	return m.recommendBooksFunc(ctx, articles)
+func (m *mockBookRecommendationService) RecommendationModel() string {
+	return "test-model"
+}
+
+func (m *mockBookRecommendationService) RecommendationPromptVersion() string {
+	return "test-prompt-v1"
 }
 
 // GetBookRecommendationsのテスト
@@ -57,17 +89,22 @@ func TestGetBookRecommendations(t *testing.T) {
 		}
 
 		mockBookRecommendationRepo := &mockBookRecommendationRepository{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return expectedCache, nil
 			},
 		}
 
-		mockArticleRepo := &mockArticleRepository{}
+		// キャッシュ後に記事は変更されていない
+		mockArticleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return []*entity.Article{{ID: 1, Title: "Go言語入門"}}, nil
+			},
+		}
 		mockService := &mockBookRecommendationService{}
 
-		usecase := NewBookRecommendationUsecase(mockArticleRepo, mockBookRecommendationRepo, mockService)
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, mockBookRecommendationRepo, mockService, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
 
-		result, err := usecase.GetBookRecommendations(context.Background())
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{})
 
 		require.NoError(t, err)
 		assert.NotNil(t, result)
@@ -80,7 +117,7 @@ func TestGetBookRecommendations(t *testing.T) {
 	t.Run("正常系：キャッシュが存在しない場合、新規に生成する", func(t *testing.T) {
 		// キャッシュなし
 		mockBookRecommendationRepo := &mockBookRecommendationRepository{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return nil, errors.New("not found")
 			},
 			saveFunc: func(ctx context.Context, cache *entity.BookRecommendationCache) (*entity.BookRecommendationCache, error) {
@@ -106,7 +143,7 @@ func TestGetBookRecommendations(t *testing.T) {
 
 		// AIが書籍を推薦
 		mockService := &mockBookRecommendationService{
-			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article) ([]entity.Book, error) {
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
 				return []entity.Book{
 					{
 						Title: "プログラミング言語Go",
@@ -118,9 +155,9 @@ func TestGetBookRecommendations(t *testing.T) {
 			},
 		}
 
-		usecase := NewBookRecommendationUsecase(mockArticleRepo, mockBookRecommendationRepo, mockService)
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, mockBookRecommendationRepo, mockService, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
 
-		result, err := usecase.GetBookRecommendations(context.Background())
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{})
 
 		require.NoError(t, err)
 		assert.NotNil(t, result)
@@ -147,7 +184,7 @@ func TestGetBookRecommendations(t *testing.T) {
 		}
 
 		mockBookRecommendationRepo := &mockBookRecommendationRepository{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return expiredCache, nil
 			},
 			saveFunc: func(ctx context.Context, cache *entity.BookRecommendationCache) (*entity.BookRecommendationCache, error) {
@@ -171,7 +208,7 @@ func TestGetBookRecommendations(t *testing.T) {
 		}
 
 		mockService := &mockBookRecommendationService{
-			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article) ([]entity.Book, error) {
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
 				return []entity.Book{
 					{
 						Title: "新しい書籍",
@@ -183,9 +220,9 @@ func TestGetBookRecommendations(t *testing.T) {
 			},
 		}
 
-		usecase := NewBookRecommendationUsecase(mockArticleRepo, mockBookRecommendationRepo, mockService)
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, mockBookRecommendationRepo, mockService, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
 
-		result, err := usecase.GetBookRecommendations(context.Background())
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{})
 
 		require.NoError(t, err)
 		assert.NotNil(t, result)
@@ -197,7 +234,7 @@ func TestGetBookRecommendations(t *testing.T) {
 
 	t.Run("正常系：記事が0件の場合、空の推薦を返す", func(t *testing.T) {
 		mockBookRecommendationRepo := &mockBookRecommendationRepository{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return nil, errors.New("not found")
 			},
 		}
@@ -210,9 +247,9 @@ func TestGetBookRecommendations(t *testing.T) {
 
 		mockService := &mockBookRecommendationService{}
 
-		usecase := NewBookRecommendationUsecase(mockArticleRepo, mockBookRecommendationRepo, mockService)
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, mockBookRecommendationRepo, mockService, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
 
-		result, err := usecase.GetBookRecommendations(context.Background())
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{})
 
 		require.NoError(t, err)
 		assert.NotNil(t, result)
@@ -222,7 +259,7 @@ func TestGetBookRecommendations(t *testing.T) {
 
 	t.Run("異常系：記事取得時にエラーが発生", func(t *testing.T) {
 		mockBookRecommendationRepo := &mockBookRecommendationRepository{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return nil, errors.New("cache not found")
 			},
 		}
@@ -235,9 +272,9 @@ func TestGetBookRecommendations(t *testing.T) {
 
 		mockService := &mockBookRecommendationService{}
 
-		usecase := NewBookRecommendationUsecase(mockArticleRepo, mockBookRecommendationRepo, mockService)
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, mockBookRecommendationRepo, mockService, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
 
-		result, err := usecase.GetBookRecommendations(context.Background())
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -246,7 +283,7 @@ func TestGetBookRecommendations(t *testing.T) {
 
 	t.Run("異常系：AI書籍推薦時にエラーが発生", func(t *testing.T) {
 		mockBookRecommendationRepo := &mockBookRecommendationRepository{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return nil, errors.New("cache not found")
 			},
 		}
@@ -266,7 +303,7 @@ func TestGetBookRecommendations(t *testing.T) {
 		}
 
 		mockService := &mockBookRecommendationService{
-			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article) ([]entity.Book, error) {
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
 				return nil, &service.BookRecommendationError{
 					Code:    service.ErrCodeAIError,
 					Message: "AI API error",
@@ -275,9 +312,9 @@ func TestGetBookRecommendations(t *testing.T) {
 			},
 		}
 
-		usecase := NewBookRecommendationUsecase(mockArticleRepo, mockBookRecommendationRepo, mockService)
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, mockBookRecommendationRepo, mockService, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
 
-		result, err := usecase.GetBookRecommendations(context.Background())
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -286,7 +323,7 @@ func TestGetBookRecommendations(t *testing.T) {
 
 	t.Run("異常系：キャッシュ保存時にエラーが発生", func(t *testing.T) {
 		mockBookRecommendationRepo := &mockBookRecommendationRepository{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return nil, errors.New("cache not found")
 			},
 			saveFunc: func(ctx context.Context, cache *entity.BookRecommendationCache) (*entity.BookRecommendationCache, error) {
@@ -309,7 +346,7 @@ func TestGetBookRecommendations(t *testing.T) {
 		}
 
 		mockService := &mockBookRecommendationService{
-			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article) ([]entity.Book, error) {
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
 				return []entity.Book{
 					{
 						Title: "書籍タイトル",
@@ -321,9 +358,9 @@ func TestGetBookRecommendations(t *testing.T) {
 			},
 		}
 
-		usecase := NewBookRecommendationUsecase(mockArticleRepo, mockBookRecommendationRepo, mockService)
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, mockBookRecommendationRepo, mockService, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
 
-		result, err := usecase.GetBookRecommendations(context.Background())
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -332,7 +369,7 @@ func TestGetBookRecommendations(t *testing.T) {
 
 	t.Run("正常系：複数の書籍を推薦する", func(t *testing.T) {
 		mockBookRecommendationRepo := &mockBookRecommendationRepository{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return nil, errors.New("cache not found")
 			},
 			saveFunc: func(ctx context.Context, cache *entity.BookRecommendationCache) (*entity.BookRecommendationCache, error) {
@@ -363,7 +400,7 @@ func TestGetBookRecommendations(t *testing.T) {
 		}
 
 		mockService := &mockBookRecommendationService{
-			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article) ([]entity.Book, error) {
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
 				return []entity.Book{
 					{
 						Title: "Goプログラミング実践入門",
@@ -388,9 +425,9 @@ func TestGetBookRecommendations(t *testing.T) {
 			},
 		}
 
-		usecase := NewBookRecommendationUsecase(mockArticleRepo, mockBookRecommendationRepo, mockService)
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, mockBookRecommendationRepo, mockService, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
 
-		result, err := usecase.GetBookRecommendations(context.Background())
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{})
 
 		require.NoError(t, err)
 		assert.NotNil(t, result)
@@ -404,7 +441,7 @@ func TestGetBookRecommendations(t *testing.T) {
 
 	t.Run("異常系：エンティティ作成時のバリデーションエラー", func(t *testing.T) {
 		mockBookRecommendationRepo := &mockBookRecommendationRepository{
-			findLatestValidFunc: func(ctx context.Context) (*entity.BookRecommendationCache, error) {
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
 				return nil, errors.New("cache not found")
 			},
 		}
@@ -425,7 +462,7 @@ func TestGetBookRecommendations(t *testing.T) {
 
 		// タイトルが空の書籍を返す（バリデーションエラー）
 		mockService := &mockBookRecommendationService{
-			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article) ([]entity.Book, error) {
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
 				return []entity.Book{
 					{
 						Title: "",
@@ -437,12 +474,521 @@ func TestGetBookRecommendations(t *testing.T) {
 			},
 		}
 
-		usecase := NewBookRecommendationUsecase(mockArticleRepo, mockBookRecommendationRepo, mockService)
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, mockBookRecommendationRepo, mockService, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
 
-		result, err := usecase.GetBookRecommendations(context.Background())
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
 		assert.Contains(t, err.Error(), "book_recommendation_cache")
 	})
 }
+
+// 対象範囲を指定したGetBookRecommendationsのテスト
+func TestGetBookRecommendations_Scope(t *testing.T) {
+	articles := []*entity.Article{
+		{ID: 1, Title: "Kubernetes入門", Tags: []string{"Kubernetes"}},
+		{ID: 2, Title: "Go言語入門", Tags: []string{"Go"}},
+		{ID: 3, Title: "Kubernetesの運用", Tags: []string{"ｋｕｂｅｒｎｅｔｅｓ", "運用"}},
+	}
+	newRepo := func(savedScopeKey *string) *mockBookRecommendationRepository {
+		return &mockBookRecommendationRepository{
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
+				return nil, errors.New("not found")
+			},
+			saveFunc: func(ctx context.Context, cache *entity.BookRecommendationCache) (*entity.BookRecommendationCache, error) {
+				*savedScopeKey = cache.ScopeKey
+				cache.ID = 1
+				return cache, nil
+			},
+		}
+	}
+
+	t.Run("正常系：タグの記事のみから推薦し、タグごとにキャッシュする", func(t *testing.T) {
+		var savedScopeKey string
+		var received []*entity.Article
+		mockArticleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return articles, nil
+			},
+		}
+		mockService := &mockBookRecommendationService{
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
+				received = articles
+				return []entity.Book{{Title: "Kubernetes完全ガイド"}}, nil
+			},
+		}
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, newRepo(&savedScopeKey), mockService, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
+
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{Tag: "kubernetes"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "tag:kubernetes", result.ScopeKey)
+		assert.Equal(t, "tag:kubernetes", savedScopeKey)
+		assert.ElementsMatch(t, []int64{1, 3}, articleIDs(received))
+	})
+
+	t.Run("正常系：別名で指定しても代表のタグで子孫のタグの記事を含めて推薦する", func(t *testing.T) {
+		var savedScopeKey string
+		var received []*entity.Article
+		mockArticleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return []*entity.Article{
+					{ID: 1, Title: "Go言語入門", Tags: []string{"Go"}},
+					{ID: 2, Title: "goroutine入門", Tags: []string{"goroutine"}},
+					{ID: 3, Title: "Rust入門", Tags: []string{"Rust"}},
+				}, nil
+			},
+		}
+		tagRepo := &mockTagRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Tag, error) {
+				return []*entity.Tag{
+					{ID: 1, Name: "Go"},
+					{ID: 2, Name: "goroutine", ParentID: 1},
+					{ID: 3, Name: "Rust"},
+				}, nil
+			},
+			findByNameFunc: func(ctx context.Context, name string) (*entity.Tag, error) {
+				if name == "golang" {
+					return &entity.Tag{ID: 1, Name: "Go"}, nil
+				}
+				return nil, domainerrors.NotFoundError("tag", name)
+			},
+		}
+		mockService := &mockBookRecommendationService{
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
+				received = articles
+				return []entity.Book{{Title: "プログラミング言語Go"}}, nil
+			},
+		}
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, tagRepo, newRepo(&savedScopeKey), mockService, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
+
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{Tag: "golang"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "tag:go", result.ScopeKey)
+		assert.Equal(t, "tag:go", savedScopeKey)
+		assert.ElementsMatch(t, []int64{1, 2}, articleIDs(received))
+	})
+
+	t.Run("正常系：記事ごとに推薦する", func(t *testing.T) {
+		var savedScopeKey string
+		mockArticleRepo := &mockArticleRepository{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.Article, error) {
+				return articles[1], nil
+			},
+		}
+		mockService := &mockBookRecommendationService{
+			recommendBooksFunc: func(ctx context.Context, received []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
+				assert.Equal(t, []int64{2}, articleIDs(received))
+				return []entity.Book{{Title: "プログラミング言語Go"}}, nil
+			},
+		}
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, newRepo(&savedScopeKey), mockService, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
+
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{ArticleID: 2})
+
+		require.NoError(t, err)
+		assert.Equal(t, "article:2", savedScopeKey)
+		assert.Len(t, result.Books, 1)
+	})
+
+	t.Run("異常系：記事が存在しない", func(t *testing.T) {
+		mockArticleRepo := &mockArticleRepository{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.Article, error) {
+				return nil, domainerrors.NotFoundError("article", id)
+			},
+		}
+		var savedScopeKey string
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, newRepo(&savedScopeKey), &mockBookRecommendationService{}, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
+
+		_, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{ArticleID: 999})
+
+		assert.True(t, domainerrors.IsNotFoundError(err))
+	})
+}
+
+// 生成時の入力として記録する記事数のテスト
+func TestGetBookRecommendations_ArticleCount(t *testing.T) {
+	t.Run("正常系：プロンプトに含める記事を絞り込んでも対象範囲の記事数を記録する", func(t *testing.T) {
+		articles := make([]*entity.Article, 0, maxRecommendationArticles+5)
+		for i := range maxRecommendationArticles + 5 {
+			articles = append(articles, &entity.Article{ID: int64(i + 1), Title: fmt.Sprintf("記事%d", i+1), CreatedAt: time.Now()})
+		}
+		var saved *entity.BookRecommendationCache
+		mockRepo := &mockBookRecommendationRepository{
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
+				return nil, errors.New("not found")
+			},
+			saveFunc: func(ctx context.Context, cache *entity.BookRecommendationCache) (*entity.BookRecommendationCache, error) {
+				saved = cache
+				return cache, nil
+			},
+		}
+		mockArticleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return articles, nil
+			},
+		}
+		var received []*entity.Article
+		mockService := &mockBookRecommendationService{
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
+				received = articles
+				return []entity.Book{{Title: "リーダブルコード"}}, nil
+			},
+		}
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, mockRepo, mockService, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
+
+		_, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{})
+
+		require.NoError(t, err)
+		assert.Len(t, received, maxRecommendationArticles)
+		require.NotNil(t, saved)
+		assert.Equal(t, maxRecommendationArticles+5, saved.ArticleCount)
+	})
+}
+
+// フィードバックを反映したGetBookRecommendationsのテスト
+func TestGetBookRecommendations_Feedback(t *testing.T) {
+	owned, _ := entity.NewBookFeedback("リーダブルコード", "", "9784873115658", entity.BookFeedbackOwned)
+	dismissed, _ := entity.NewBookFeedback("退屈なことはPythonにやらせよう", "", "", entity.BookFeedbackDismissed)
+	liked, _ := entity.NewBookFeedback("プログラミング言語Go", "", "", entity.BookFeedbackLiked)
+	feedbackRepo := &mockBookFeedbackRepository{
+		findAllFunc: func(ctx context.Context) ([]*entity.BookFeedback, error) {
+			return []*entity.BookFeedback{owned, dismissed, liked}, nil
+		},
+	}
+	books := []entity.Book{
+		{Title: "リーダブル・コード", ISBN13: "9784873115658"},
+		{Title: "退屈なことは Python にやらせよう"},
+		{Title: "Go言語による並行処理"},
+	}
+
+	t.Run("正常系：フィードバックを推薦サービスに渡し、所有・興味なしの書籍を除外する", func(t *testing.T) {
+		var received []*entity.BookFeedback
+		var saved *entity.BookRecommendationCache
+		mockBookRecommendationRepo := &mockBookRecommendationRepository{
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
+				return nil, domainerrors.NotFoundError("book_recommendation_cache", scopeKey)
+			},
+			saveFunc: func(ctx context.Context, cache *entity.BookRecommendationCache) (*entity.BookRecommendationCache, error) {
+				saved = cache
+				copied := *cache
+				copied.ID = 1
+				return &copied, nil
+			},
+		}
+		mockArticleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return []*entity.Article{{ID: 1, Title: "Go言語入門"}}, nil
+			},
+		}
+		mockService := &mockBookRecommendationService{
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
+				received = feedback
+				return books, nil
+			},
+		}
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, mockBookRecommendationRepo, mockService, feedbackRepo, DefaultBookRecommendationConfig())
+
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{})
+
+		require.NoError(t, err)
+		assert.Len(t, received, 3)
+		require.Len(t, result.Books, 1)
+		assert.Equal(t, "Go言語による並行処理", result.Books[0].Title)
+		// キャッシュには除外前の推薦結果を保存する
+		assert.Len(t, saved.Books, 3)
+		// 生成時の入力を記録する
+		assert.Equal(t, 1, saved.ArticleCount)
+		assert.Equal(t, "test-model", saved.Model)
+		assert.Equal(t, "test-prompt-v1", saved.PromptVersion)
+	})
+
+	t.Run("正常系：キャッシュからもフィードバック済みの書籍を除外する", func(t *testing.T) {
+		mockBookRecommendationRepo := &mockBookRecommendationRepository{
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
+				now := time.Now()
+				return &entity.BookRecommendationCache{
+					ID:          1,
+					ScopeKey:    scopeKey,
+					Books:       append([]entity.Book(nil), books...),
+					GeneratedAt: now,
+					ExpiresAt:   now.Add(time.Hour),
+				}, nil
+			},
+		}
+		mockArticleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return []*entity.Article{{ID: 1, Title: "Go言語入門"}}, nil
+			},
+		}
+		usecase := NewBookRecommendationUsecase(mockArticleRepo, &mockTagRepository{}, mockBookRecommendationRepo, &mockBookRecommendationService{}, feedbackRepo, DefaultBookRecommendationConfig())
+
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{})
+
+		require.NoError(t, err)
+		require.Len(t, result.Books, 1)
+		assert.Equal(t, "Go言語による並行処理", result.Books[0].Title)
+	})
+}
+
+// キャッシュの更新に関するテスト（古いキャッシュの再生成、強制更新、同時リクエスト、事前生成）
+func TestBookRecommendations_Refresh(t *testing.T) {
+	generatedAt := time.Now().Add(-time.Hour)
+	validCache := func(scopeKey string) *entity.BookRecommendationCache {
+		return &entity.BookRecommendationCache{
+			ID:          1,
+			ScopeKey:    scopeKey,
+			Books:       []entity.Book{{Title: "古い推薦", SourceArticleIDs: []int64{1}}},
+			GeneratedAt: generatedAt,
+			ExpiresAt:   generatedAt.Add(24 * time.Hour),
+		}
+	}
+	newRepo := func(saved *[]*entity.BookRecommendationCache) *mockBookRecommendationRepository {
+		return &mockBookRecommendationRepository{
+			findLatestValidFunc: func(ctx context.Context, scopeKey string) (*entity.BookRecommendationCache, error) {
+				return validCache(scopeKey), nil
+			},
+			saveFunc: func(ctx context.Context, cache *entity.BookRecommendationCache) (*entity.BookRecommendationCache, error) {
+				*saved = append(*saved, cache)
+				cache.ID = 2
+				return cache, nil
+			},
+		}
+	}
+	articlesRepo := func(updatedAt time.Time) *mockArticleRepository {
+		return &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return []*entity.Article{{ID: 1, Title: "Go言語入門", Tags: []string{"Go"}, CreatedAt: generatedAt.Add(-time.Hour), UpdatedAt: updatedAt}}, nil
+			},
+		}
+	}
+	newService := func(calls *atomic.Int32) *mockBookRecommendationService {
+		return &mockBookRecommendationService{
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
+				calls.Add(1)
+				return []entity.Book{{Title: "新しい推薦"}}, nil
+			},
+		}
+	}
+
+	t.Run("正常系：キャッシュ後に記事が更新された場合は生成し直す", func(t *testing.T) {
+		var saved []*entity.BookRecommendationCache
+		var calls atomic.Int32
+		usecase := NewBookRecommendationUsecase(articlesRepo(generatedAt.Add(time.Minute)), &mockTagRepository{}, newRepo(&saved), newService(&calls), &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
+
+		result, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "新しい推薦", result.Books[0].Title)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("正常系：強制更新では有効なキャッシュがあっても生成し直す", func(t *testing.T) {
+		var saved []*entity.BookRecommendationCache
+		var calls atomic.Int32
+		usecase := NewBookRecommendationUsecase(articlesRepo(generatedAt.Add(-time.Hour)), &mockTagRepository{}, newRepo(&saved), newService(&calls), &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
+
+		cached, err := usecase.GetBookRecommendations(context.Background(), entity.BookRecommendationScope{})
+		require.NoError(t, err)
+		assert.Equal(t, "古い推薦", cached.Books[0].Title)
+
+		refreshed, err := usecase.RefreshBookRecommendations(context.Background(), entity.BookRecommendationScope{})
+		require.NoError(t, err)
+		assert.Equal(t, "新しい推薦", refreshed.Books[0].Title)
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("正常系：設定した有効期間でキャッシュを保存する", func(t *testing.T) {
+		var saved []*entity.BookRecommendationCache
+		var calls atomic.Int32
+		config := DefaultBookRecommendationConfig()
+		config.TTL = 6 * time.Hour
+		usecase := NewBookRecommendationUsecase(articlesRepo(generatedAt), &mockTagRepository{}, newRepo(&saved), newService(&calls), &mockBookFeedbackRepository{}, config)
+
+		_, err := usecase.RefreshBookRecommendations(context.Background(), entity.BookRecommendationScope{Tag: "Go"})
+
+		require.NoError(t, err)
+		require.Len(t, saved, 1)
+		assert.Equal(t, 6*time.Hour, saved[0].ExpiresAt.Sub(saved[0].GeneratedAt))
+	})
+
+	t.Run("正常系：同時のリクエストでは推薦を1回だけ生成する", func(t *testing.T) {
+		var saved []*entity.BookRecommendationCache
+		var calls atomic.Int32
+		release := make(chan struct{})
+		mockService := &mockBookRecommendationService{
+			recommendBooksFunc: func(ctx context.Context, articles []*entity.Article, feedback []*entity.BookFeedback) ([]entity.Book, error) {
+				calls.Add(1)
+				<-release
+				return []entity.Book{{Title: "新しい推薦"}}, nil
+			},
+		}
+		usecase := NewBookRecommendationUsecase(articlesRepo(generatedAt), &mockTagRepository{}, newRepo(&saved), mockService, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
+
+		const requests = 5
+		var started sync.WaitGroup
+		var wg sync.WaitGroup
+		started.Add(requests)
+		for i := 0; i < requests; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				started.Done()
+				result, err := usecase.RefreshBookRecommendations(context.Background(), entity.BookRecommendationScope{})
+				assert.NoError(t, err)
+				assert.Equal(t, "新しい推薦", result.Books[0].Title)
+			}()
+		}
+		started.Wait()
+		// 最初のリクエストが生成を開始してから、少し待って完了させる
+		for calls.Load() == 0 {
+			time.Sleep(time.Millisecond)
+		}
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), calls.Load())
+		assert.Len(t, saved, 1)
+	})
+
+	t.Run("正常系：有効期限が近いキャッシュを事前に生成し直す", func(t *testing.T) {
+		var saved []*entity.BookRecommendationCache
+		var calls atomic.Int32
+		var before time.Time
+		mockRepo := newRepo(&saved)
+		mockRepo.findExpiringFunc = func(ctx context.Context, b time.Time) ([]*entity.BookRecommendationCache, error) {
+			before = b
+			return []*entity.BookRecommendationCache{validCache("all"), validCache("tag:go"), validCache("invalid")}, nil
+		}
+		usecase := NewBookRecommendationUsecase(articlesRepo(generatedAt), &mockTagRepository{}, mockRepo, newService(&calls), &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
+
+		refreshed, err := usecase.PrewarmBookRecommendations(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, refreshed)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), before, time.Minute)
+		require.Len(t, saved, 2)
+		assert.Equal(t, "all", saved[0].ScopeKey)
+		assert.Equal(t, "tag:go", saved[1].ScopeKey)
+	})
+
+	t.Run("正常系：事前生成が無効な場合は何もしない", func(t *testing.T) {
+		config := DefaultBookRecommendationConfig()
+		config.PrewarmBefore = 0
+		usecase := NewBookRecommendationUsecase(&mockArticleRepository{}, &mockTagRepository{}, &mockBookRecommendationRepository{}, &mockBookRecommendationService{}, &mockBookFeedbackRepository{}, config)
+
+		refreshed, err := usecase.PrewarmBookRecommendations(context.Background())
+
+		require.NoError(t, err)
+		assert.Zero(t, refreshed)
+	})
+}
+
+// GetBookRecommendationHistoryのテスト
+func TestGetBookRecommendationHistory(t *testing.T) {
+	now := time.Now()
+	history := []*entity.BookRecommendationCache{
+		{ID: 3, ScopeKey: "all", GeneratedAt: now, Books: []entity.Book{{Title: "A"}, {Title: "C"}}},
+		{ID: 2, ScopeKey: "all", GeneratedAt: now.Add(-24 * time.Hour), Books: []entity.Book{{Title: "A"}, {Title: "B"}}},
+		{ID: 1, ScopeKey: "all", GeneratedAt: now.Add(-48 * time.Hour), Books: []entity.Book{{Title: "B"}}},
+	}
+
+	t.Run("正常系：1つ前の推薦からの変化とともに取得する", func(t *testing.T) {
+		var requestedLimit int
+		mockRepo := &mockBookRecommendationRepository{
+			findHistoryFunc: func(ctx context.Context, scopeKey string, limit int) ([]*entity.BookRecommendationCache, error) {
+				assert.Equal(t, "tag:go", scopeKey)
+				requestedLimit = limit
+				return history[:min(limit, len(history))], nil
+			},
+		}
+		usecase := NewBookRecommendationUsecase(&mockArticleRepository{}, &mockTagRepository{}, mockRepo, &mockBookRecommendationService{}, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
+
+		entries, err := usecase.GetBookRecommendationHistory(context.Background(), entity.BookRecommendationScope{Tag: "Go"}, 2)
+
+		require.NoError(t, err)
+		// 最も古い推薦の差分を求めるため1件多く取得する
+		assert.Equal(t, 3, requestedLimit)
+		require.Len(t, entries, 2)
+		assert.Equal(t, int64(3), entries[0].Recommendation.ID)
+		assert.Equal(t, []entity.Book{{Title: "C"}}, entries[0].Added)
+		assert.Equal(t, []entity.Book{{Title: "B"}}, entries[0].Removed)
+		assert.Equal(t, []entity.Book{{Title: "A"}}, entries[1].Added)
+		assert.Empty(t, entries[1].Removed)
+	})
+
+	t.Run("異常系：取得件数が上限を超える", func(t *testing.T) {
+		usecase := NewBookRecommendationUsecase(&mockArticleRepository{}, &mockTagRepository{}, &mockBookRecommendationRepository{}, &mockBookRecommendationService{}, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
+
+		_, err := usecase.GetBookRecommendationHistory(context.Background(), entity.BookRecommendationScope{}, MaxBookRecommendationHistoryLimit+1)
+
+		assert.Equal(t, domainerrors.ErrCodeInvalidArgument, domainerrors.GetErrorCode(err))
+	})
+}
+
+// PruneBookRecommendationHistoryのテスト
+func TestPruneBookRecommendationHistory(t *testing.T) {
+	t.Run("正常系：保持期間より前の推薦を削除する", func(t *testing.T) {
+		var before time.Time
+		mockRepo := &mockBookRecommendationRepository{
+			deleteBeforeFunc: func(ctx context.Context, b time.Time) (int64, error) {
+				before = b
+				return 4, nil
+			},
+		}
+		usecase := NewBookRecommendationUsecase(&mockArticleRepository{}, &mockTagRepository{}, mockRepo, &mockBookRecommendationService{}, &mockBookFeedbackRepository{}, DefaultBookRecommendationConfig())
+
+		deleted, err := usecase.PruneBookRecommendationHistory(context.Background(), 30*24*time.Hour)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), deleted)
+		assert.WithinDuration(t, time.Now().Add(-30*24*time.Hour), before, time.Minute)
+	})
+}
+
+func TestSampleRecommendationArticles(t *testing.T) {
+	base := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	newArticle := func(id int64, tags ...string) *entity.Article {
+		return &entity.Article{ID: id, Tags: tags, CreatedAt: base.Add(time.Duration(id) * time.Hour)}
+	}
+
+	t.Run("正常系：上限以下の場合は新しい順にすべて返す", func(t *testing.T) {
+		sampled := sampleRecommendationArticles([]*entity.Article{newArticle(1), newArticle(2)}, 5, entity.DefaultTagNamePolicy())
+		assert.Equal(t, []int64{2, 1}, articleIDs(sampled))
+	})
+
+	t.Run("正常系：新しい記事と、含まれていないタグを持つ古い記事を選ぶ", func(t *testing.T) {
+		var articles []*entity.Article
+		for id := int64(1); id <= maxRecommendationArticles+20; id++ {
+			articles = append(articles, newArticle(id, "Go"))
+		}
+		articles[0].Tags = []string{"Python"}
+		articles[1].Tags = []string{"Rust", "WebAssembly"}
+
+		sampled := sampleRecommendationArticles(articles, maxRecommendationArticles, entity.DefaultTagNamePolicy())
+
+		require.Len(t, sampled, maxRecommendationArticles)
+		assert.Equal(t, int64(maxRecommendationArticles+20), sampled[0].ID)
+		assert.Contains(t, articleIDs(sampled), int64(1))
+		assert.Contains(t, articleIDs(sampled), int64(2))
+	})
+}
+
+func TestBookRecommendationConfig_Validate(t *testing.T) {
+	t.Run("正常系：デフォルトの設定", func(t *testing.T) {
+		assert.NoError(t, DefaultBookRecommendationConfig().Validate())
+	})
+
+	t.Run("異常系：有効期間が0以下", func(t *testing.T) {
+		config := DefaultBookRecommendationConfig()
+		config.TTL = 0
+		assert.Error(t, config.Validate())
+
+		config = DefaultBookRecommendationConfig()
+		config.ArticleTTL = -time.Hour
+		assert.Error(t, config.Validate())
+	})
+}
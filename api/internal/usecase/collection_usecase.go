@@ -0,0 +1,345 @@
+package usecase
+
+import (
+	"context"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// コレクション作成の入力
+type CreateCollectionInput struct {
+	Name        string
+	Description string
+	ArticleIDs  []int64 // 掲載順
+}
+
+// コレクション更新の入力
+type UpdateCollectionInput struct {
+	Name        string
+	Description string
+}
+
+// コレクション内の記事の移動の入力
+type MoveCollectionArticleInput struct {
+	CollectionID int64 // 移動先のコレクション（0の場合は同じコレクション内で移動する）
+	Position     int   // 移動先の位置（1始まり、0の場合は末尾）
+}
+
+// 掲載する記事付きのコレクション
+type CollectionDetail struct {
+	Collection *entity.Collection
+	Articles   []*entity.Article // 掲載順
+}
+
+// コレクションに関するビジネスロジック
+type CollectionUsecase struct {
+	repo        repository.CollectionRepository
+	articleRepo repository.ArticleRepository
+}
+
+// コンストラクタ
+func NewCollectionUsecase(repo repository.CollectionRepository, articleRepo repository.ArticleRepository) *CollectionUsecase {
+	return &CollectionUsecase{
+		repo:        repo,
+		articleRepo: articleRepo,
+	}
+}
+
+// 新しいコレクションを作成
+func (u *CollectionUsecase) CreateCollection(ctx context.Context, input CreateCollectionInput) (*entity.Collection, error) {
+	logger.Debug("Creating collection",
+		zap.String("name", input.Name),
+		zap.Int("article_count", len(input.ArticleIDs)),
+	)
+
+	collection, err := entity.NewCollection(input.Name, input.Description)
+	if err != nil {
+		logger.Warn("Failed to create collection entity",
+			zap.Error(err),
+			zap.String("name", input.Name),
+		)
+		return nil, domainerrors.ValidationError("collection", err.Error())
+	}
+	for _, articleID := range input.ArticleIDs {
+		if err := collection.AddArticle(articleID, 0); err != nil {
+			return nil, domainerrors.ValidationError("article_ids", err.Error())
+		}
+	}
+	if err := u.ensureArticlesExist(ctx, collection.ArticleIDs); err != nil {
+		return nil, err
+	}
+
+	saved, err := u.repo.Create(ctx, collection)
+	if err != nil {
+		logger.Error("Failed to save collection to repository",
+			zap.Error(err),
+			zap.String("name", collection.Name),
+		)
+		return nil, err
+	}
+
+	logger.Info("Successfully created collection",
+		zap.Int64("id", saved.ID),
+		zap.String("name", saved.Name),
+	)
+
+	return saved, nil
+}
+
+// すべてのコレクションを名前順に取得
+func (u *CollectionUsecase) GetCollections(ctx context.Context) ([]*entity.Collection, error) {
+	collections, err := u.repo.FindAll(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve collections",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return collections, nil
+}
+
+// 指定されたIDのコレクションを掲載する記事付きで取得
+func (u *CollectionUsecase) GetCollection(ctx context.Context, id int64) (*CollectionDetail, error) {
+	collection, err := u.findCollection(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	articles := make([]*entity.Article, 0, len(collection.ArticleIDs))
+	for _, articleID := range collection.ArticleIDs {
+		article, err := u.articleRepo.FindByID(ctx, articleID)
+		if err != nil {
+			// 掲載後に削除された記事は含めない
+			if domainerrors.IsNotFoundError(err) {
+				continue
+			}
+			logger.Error("Failed to find collection article",
+				zap.Error(err),
+				zap.Int64("collection_id", id),
+				zap.Int64("article_id", articleID),
+			)
+			return nil, err
+		}
+		articles = append(articles, article)
+	}
+
+	return &CollectionDetail{Collection: collection, Articles: articles}, nil
+}
+
+// コレクションの名前と説明を更新
+func (u *CollectionUsecase) UpdateCollection(ctx context.Context, id int64, input UpdateCollectionInput) (*entity.Collection, error) {
+	collection, err := u.findCollection(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := collection.Update(input.Name, input.Description); err != nil {
+		return nil, domainerrors.ValidationError("collection", err.Error())
+	}
+
+	updated, err := u.repo.Update(ctx, collection)
+	if err != nil {
+		logger.Error("Failed to update collection in repository",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return nil, err
+	}
+
+	logger.Info("Successfully updated collection",
+		zap.Int64("id", updated.ID),
+		zap.String("name", updated.Name),
+	)
+
+	return updated, nil
+}
+
+// 指定されたIDのコレクションを削除（記事は削除しない）
+func (u *CollectionUsecase) DeleteCollection(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	if err := u.repo.Delete(ctx, id); err != nil {
+		logger.Error("Failed to delete collection from repository",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return err
+	}
+
+	logger.Info("Successfully deleted collection",
+		zap.Int64("id", id),
+	)
+
+	return nil
+}
+
+// 記事をコレクションの指定した位置（1始まり、0の場合は末尾）に追加
+func (u *CollectionUsecase) AddArticle(ctx context.Context, id, articleID int64, position int) (*entity.Collection, error) {
+	if position < 0 {
+		return nil, domainerrors.InvalidArgumentError("position", "position must be positive")
+	}
+	if err := u.ensureArticlesExist(ctx, []int64{articleID}); err != nil {
+		return nil, err
+	}
+
+	return u.updateArticles(ctx, id, func(collection *entity.Collection) error {
+		if collection.Contains(articleID) {
+			return domainerrors.AlreadyExistsError("collection_article", articleID)
+		}
+		if err := collection.AddArticle(articleID, position); err != nil {
+			return domainerrors.ValidationError("article_id", err.Error())
+		}
+		return nil
+	})
+}
+
+// 記事をコレクションから外す（記事は削除しない）
+func (u *CollectionUsecase) RemoveArticle(ctx context.Context, id, articleID int64) (*entity.Collection, error) {
+	return u.updateArticles(ctx, id, func(collection *entity.Collection) error {
+		if !collection.Contains(articleID) {
+			return domainerrors.NotFoundError("collection_article", articleID)
+		}
+		if err := collection.RemoveArticle(articleID); err != nil {
+			return domainerrors.ValidationError("article_id", err.Error())
+		}
+		return nil
+	})
+}
+
+// コレクションの記事を指定した順番に並べ替える（含まれている記事をすべて指定する）
+func (u *CollectionUsecase) ReorderArticles(ctx context.Context, id int64, articleIDs []int64) (*entity.Collection, error) {
+	return u.updateArticles(ctx, id, func(collection *entity.Collection) error {
+		if err := collection.Reorder(articleIDs); err != nil {
+			return domainerrors.InvalidArgumentError("article_ids", err.Error())
+		}
+		return nil
+	})
+}
+
+// 記事を同じコレクション内の別の位置、または別のコレクションに移動し、移動先のコレクションを返す
+func (u *CollectionUsecase) MoveArticle(ctx context.Context, id, articleID int64, input MoveCollectionArticleInput) (*entity.Collection, error) {
+	if input.Position < 0 {
+		return nil, domainerrors.InvalidArgumentError("position", "position must be positive")
+	}
+	if input.CollectionID < 0 {
+		return nil, domainerrors.InvalidArgumentError("collection_id", "collection id must be positive")
+	}
+
+	if input.CollectionID == 0 || input.CollectionID == id {
+		return u.updateArticles(ctx, id, func(collection *entity.Collection) error {
+			if !collection.Contains(articleID) {
+				return domainerrors.NotFoundError("collection_article", articleID)
+			}
+			if err := collection.MoveArticle(articleID, input.Position); err != nil {
+				return domainerrors.ValidationError("position", err.Error())
+			}
+			return nil
+		})
+	}
+
+	if id <= 0 {
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+	err := u.repo.Move(ctx, id, input.CollectionID, func(source, target *entity.Collection) error {
+		if !source.Contains(articleID) {
+			return domainerrors.NotFoundError("collection_article", articleID)
+		}
+		if target.Contains(articleID) {
+			return domainerrors.AlreadyExistsError("collection_article", articleID)
+		}
+		if err := source.MoveArticleTo(target, articleID, input.Position); err != nil {
+			return domainerrors.ValidationError("position", err.Error())
+		}
+		return nil
+	})
+	if err != nil {
+		logger.Warn("Failed to move article between collections",
+			zap.Error(err),
+			zap.Int64("source_id", id),
+			zap.Int64("target_id", input.CollectionID),
+			zap.Int64("article_id", articleID),
+		)
+		return nil, err
+	}
+
+	logger.Info("Successfully moved article between collections",
+		zap.Int64("source_id", id),
+		zap.Int64("target_id", input.CollectionID),
+		zap.Int64("article_id", articleID),
+	)
+
+	return u.findCollection(ctx, input.CollectionID)
+}
+
+// コレクションを読む順番に並べたMarkdownの読書リストとして出力
+func (u *CollectionUsecase) ExportMarkdown(ctx context.Context, id int64) (string, error) {
+	detail, err := u.GetCollection(ctx, id)
+	if err != nil {
+		return "", err
+	}
+
+	return detail.Collection.Markdown(detail.Articles), nil
+}
+
+func (u *CollectionUsecase) findCollection(ctx context.Context, id int64) (*entity.Collection, error) {
+	if id <= 0 {
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	collection, err := u.repo.FindByID(ctx, id)
+	if err != nil {
+		logger.Warn("Failed to find collection",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return nil, err
+	}
+
+	return collection, nil
+}
+
+// 掲載する記事の変更をリポジトリ内で排他的に適用する
+// 変更はロックしたコレクションに対して行うため、同時に変更されても互いの変更が失われない
+func (u *CollectionUsecase) updateArticles(ctx context.Context, id int64, modify func(collection *entity.Collection) error) (*entity.Collection, error) {
+	if id <= 0 {
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	updated, err := u.repo.UpdateArticles(ctx, id, modify)
+	if err != nil {
+		logger.Warn("Failed to update collection articles",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return nil, err
+	}
+
+	logger.Info("Successfully updated collection articles",
+		zap.Int64("id", updated.ID),
+		zap.Int("article_count", len(updated.ArticleIDs)),
+	)
+
+	return updated, nil
+}
+
+// 掲載する記事が存在することを確認
+func (u *CollectionUsecase) ensureArticlesExist(ctx context.Context, articleIDs []int64) error {
+	for _, articleID := range articleIDs {
+		if _, err := u.articleRepo.FindByID(ctx, articleID); err != nil {
+			logger.Warn("Failed to find article to add to collection",
+				zap.Error(err),
+				zap.Int64("article_id", articleID),
+			)
+			return err
+		}
+	}
+	return nil
+}
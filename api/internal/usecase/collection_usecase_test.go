@@ -0,0 +1,292 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// モック CollectionRepository
+type mockCollectionRepository struct {
+	createFunc         func(ctx context.Context, collection *entity.Collection) (*entity.Collection, error)
+	findByIDFunc       func(ctx context.Context, id int64) (*entity.Collection, error)
+	findAllFunc        func(ctx context.Context) ([]*entity.Collection, error)
+	updateFunc         func(ctx context.Context, collection *entity.Collection) (*entity.Collection, error)
+	updateArticlesFunc func(ctx context.Context, id int64, modify func(collection *entity.Collection) error) (*entity.Collection, error)
+	moveFunc           func(ctx context.Context, sourceID, targetID int64, modify func(source, target *entity.Collection) error) error
+	deleteFunc         func(ctx context.Context, id int64) error
+}
+
+func (m *mockCollectionRepository) Create(ctx context.Context, collection *entity.Collection) (*entity.Collection, error) {
+	return m.createFunc(ctx, collection)
+}
+
+func (m *mockCollectionRepository) FindByID(ctx context.Context, id int64) (*entity.Collection, error) {
+	return m.findByIDFunc(ctx, id)
+}
+
+func (m *mockCollectionRepository) FindAll(ctx context.Context) ([]*entity.Collection, error) {
+	return m.findAllFunc(ctx)
+}
+
+func (m *mockCollectionRepository) Update(ctx context.Context, collection *entity.Collection) (*entity.Collection, error) {
+	return m.updateFunc(ctx, collection)
+}
+
+func (m *mockCollectionRepository) UpdateArticles(ctx context.Context, id int64, modify func(collection *entity.Collection) error) (*entity.Collection, error) {
+	return m.updateArticlesFunc(ctx, id, modify)
+}
+
+func (m *mockCollectionRepository) Move(ctx context.Context, sourceID, targetID int64, modify func(source, target *entity.Collection) error) error {
+	return m.moveFunc(ctx, sourceID, targetID, modify)
+}
+
+func (m *mockCollectionRepository) Delete(ctx context.Context, id int64) error {
+	return m.deleteFunc(ctx, id)
+}
+
+// ID 1〜3の記事が存在する記事リポジトリ
+func newCollectionArticleRepository() *mockArticleRepository {
+	return &mockArticleRepository{
+		findByIDFunc: func(ctx context.Context, id int64) (*entity.Article, error) {
+			if id < 1 || id > 3 {
+				return nil, domainerrors.NotFoundError("article", id)
+			}
+			return &entity.Article{ID: id, Title: "記事", URL: "https://example.com"}, nil
+		},
+	}
+}
+
+// 指定したコレクションを保持し、変更に成功した場合のみ保存するリポジトリ
+func newStubCollectionRepository(collections ...*entity.Collection) *mockCollectionRepository {
+	stored := make(map[int64]*entity.Collection, len(collections))
+	for _, collection := range collections {
+		stored[collection.ID] = collection
+	}
+	find := func(id int64) (*entity.Collection, error) {
+		collection, exists := stored[id]
+		if !exists {
+			return nil, domainerrors.NotFoundError("collection", id)
+		}
+		copied := *collection
+		copied.ArticleIDs = append([]int64{}, collection.ArticleIDs...)
+		return &copied, nil
+	}
+	return &mockCollectionRepository{
+		findByIDFunc: func(ctx context.Context, id int64) (*entity.Collection, error) {
+			return find(id)
+		},
+		updateFunc: func(ctx context.Context, collection *entity.Collection) (*entity.Collection, error) {
+			saved, err := find(collection.ID)
+			if err != nil {
+				return nil, err
+			}
+			saved.Name = collection.Name
+			saved.Description = collection.Description
+			stored[saved.ID] = saved
+			return saved, nil
+		},
+		updateArticlesFunc: func(ctx context.Context, id int64, modify func(collection *entity.Collection) error) (*entity.Collection, error) {
+			collection, err := find(id)
+			if err != nil {
+				return nil, err
+			}
+			if err := modify(collection); err != nil {
+				return nil, err
+			}
+			stored[id] = collection
+			return collection, nil
+		},
+		moveFunc: func(ctx context.Context, sourceID, targetID int64, modify func(source, target *entity.Collection) error) error {
+			source, err := find(sourceID)
+			if err != nil {
+				return err
+			}
+			target, err := find(targetID)
+			if err != nil {
+				return err
+			}
+			if err := modify(source, target); err != nil {
+				return err
+			}
+			stored[sourceID] = source
+			stored[targetID] = target
+			return nil
+		},
+	}
+}
+
+// CreateCollectionのテスト
+func TestCreateCollection(t *testing.T) {
+	t.Run("正常系：指定した順番で記事を含むコレクションを作成できる", func(t *testing.T) {
+		mockRepo := &mockCollectionRepository{
+			createFunc: func(ctx context.Context, collection *entity.Collection) (*entity.Collection, error) {
+				collection.ID = 1
+				return collection, nil
+			},
+		}
+		uc := NewCollectionUsecase(mockRepo, newCollectionArticleRepository())
+
+		collection, err := uc.CreateCollection(context.Background(), CreateCollectionInput{
+			Name:       "新人向け",
+			ArticleIDs: []int64{3, 1},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 1}, collection.ArticleIDs)
+	})
+
+	t.Run("異常系：存在しない記事を含めることはできない", func(t *testing.T) {
+		uc := NewCollectionUsecase(&mockCollectionRepository{}, newCollectionArticleRepository())
+
+		_, err := uc.CreateCollection(context.Background(), CreateCollectionInput{
+			Name:       "新人向け",
+			ArticleIDs: []int64{1, 99},
+		})
+
+		assert.True(t, domainerrors.IsNotFoundError(err))
+	})
+
+	t.Run("異常系：名前が空", func(t *testing.T) {
+		uc := NewCollectionUsecase(&mockCollectionRepository{}, newCollectionArticleRepository())
+
+		_, err := uc.CreateCollection(context.Background(), CreateCollectionInput{Name: " "})
+
+		assert.Equal(t, domainerrors.ErrCodeValidation, domainerrors.GetErrorCode(err))
+	})
+}
+
+// GetCollectionのテスト
+func TestGetCollection(t *testing.T) {
+	t.Run("正常系：削除された記事を除いて掲載順に取得できる", func(t *testing.T) {
+		repo := newStubCollectionRepository(&entity.Collection{ID: 1, Name: "Go", ArticleIDs: []int64{3, 99, 1}})
+		uc := NewCollectionUsecase(repo, newCollectionArticleRepository())
+
+		detail, err := uc.GetCollection(context.Background(), 1)
+
+		require.NoError(t, err)
+		require.Len(t, detail.Articles, 2)
+		assert.Equal(t, int64(3), detail.Articles[0].ID)
+		assert.Equal(t, int64(1), detail.Articles[1].ID)
+	})
+}
+
+// UpdateCollectionのテスト
+func TestUpdateCollection(t *testing.T) {
+	t.Run("正常系：名前と説明のみを更新し、掲載する記事は変更しない", func(t *testing.T) {
+		repo := newStubCollectionRepository(&entity.Collection{ID: 1, Name: "Go", ArticleIDs: []int64{1, 2}})
+		repo.updateArticlesFunc = func(ctx context.Context, id int64, modify func(collection *entity.Collection) error) (*entity.Collection, error) {
+			t.Fatal("UpdateArticlesが呼ばれてはいけない")
+			return nil, nil
+		}
+		uc := NewCollectionUsecase(repo, newCollectionArticleRepository())
+
+		collection, err := uc.UpdateCollection(context.Background(), 1, UpdateCollectionInput{Name: "Go入門", Description: "最初に読む記事"})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Go入門", collection.Name)
+		assert.Equal(t, []int64{1, 2}, collection.ArticleIDs)
+	})
+}
+
+// AddArticleのテスト
+func TestCollectionAddArticle(t *testing.T) {
+	t.Run("正常系：指定した位置に追加できる", func(t *testing.T) {
+		repo := newStubCollectionRepository(&entity.Collection{ID: 1, Name: "Go", ArticleIDs: []int64{1, 2}})
+		uc := NewCollectionUsecase(repo, newCollectionArticleRepository())
+
+		collection, err := uc.AddArticle(context.Background(), 1, 3, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 1, 2}, collection.ArticleIDs)
+	})
+
+	t.Run("異常系：すでに含まれている記事", func(t *testing.T) {
+		repo := newStubCollectionRepository(&entity.Collection{ID: 1, Name: "Go", ArticleIDs: []int64{1, 2}})
+		uc := NewCollectionUsecase(repo, newCollectionArticleRepository())
+
+		_, err := uc.AddArticle(context.Background(), 1, 2, 0)
+
+		assert.True(t, domainerrors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("異常系：存在しない記事", func(t *testing.T) {
+		repo := newStubCollectionRepository(&entity.Collection{ID: 1, Name: "Go", ArticleIDs: []int64{1, 2}})
+		uc := NewCollectionUsecase(repo, newCollectionArticleRepository())
+
+		_, err := uc.AddArticle(context.Background(), 1, 99, 0)
+
+		assert.True(t, domainerrors.IsNotFoundError(err))
+	})
+}
+
+// ReorderArticlesのテスト
+func TestReorderCollectionArticles(t *testing.T) {
+	t.Run("異常系：含まれている記事と一致しない場合は並べ替えない", func(t *testing.T) {
+		repo := newStubCollectionRepository(&entity.Collection{ID: 1, Name: "Go", ArticleIDs: []int64{1, 2}})
+		uc := NewCollectionUsecase(repo, newCollectionArticleRepository())
+
+		_, err := uc.ReorderArticles(context.Background(), 1, []int64{2, 3})
+
+		assert.Equal(t, domainerrors.ErrCodeInvalidArgument, domainerrors.GetErrorCode(err))
+		collection, err := repo.FindByID(context.Background(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, collection.ArticleIDs)
+	})
+}
+
+// MoveArticleのテスト
+func TestMoveCollectionArticle(t *testing.T) {
+	t.Run("正常系：同じコレクション内で移動できる", func(t *testing.T) {
+		repo := newStubCollectionRepository(&entity.Collection{ID: 1, Name: "Go", ArticleIDs: []int64{1, 2, 3}})
+		uc := NewCollectionUsecase(repo, newCollectionArticleRepository())
+
+		collection, err := uc.MoveArticle(context.Background(), 1, 3, MoveCollectionArticleInput{Position: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, []int64{3, 1, 2}, collection.ArticleIDs)
+	})
+
+	t.Run("正常系：別のコレクションに移動し、移動先のコレクションを返す", func(t *testing.T) {
+		repo := newStubCollectionRepository(
+			&entity.Collection{ID: 1, Name: "Go", ArticleIDs: []int64{1, 2}},
+			&entity.Collection{ID: 2, Name: "Docker", ArticleIDs: []int64{3}},
+		)
+		uc := NewCollectionUsecase(repo, newCollectionArticleRepository())
+
+		collection, err := uc.MoveArticle(context.Background(), 1, 2, MoveCollectionArticleInput{CollectionID: 2, Position: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), collection.ID)
+		assert.Equal(t, []int64{2, 3}, collection.ArticleIDs)
+		source, err := repo.FindByID(context.Background(), 1)
+		require.NoError(t, err)
+		assert.Equal(t, []int64{1}, source.ArticleIDs)
+	})
+
+	t.Run("異常系：移動先にすでに含まれている記事", func(t *testing.T) {
+		repo := newStubCollectionRepository(
+			&entity.Collection{ID: 1, Name: "Go", ArticleIDs: []int64{1, 2}},
+			&entity.Collection{ID: 2, Name: "Docker", ArticleIDs: []int64{2}},
+		)
+		uc := NewCollectionUsecase(repo, newCollectionArticleRepository())
+
+		_, err := uc.MoveArticle(context.Background(), 1, 2, MoveCollectionArticleInput{CollectionID: 2})
+
+		assert.True(t, domainerrors.IsAlreadyExistsError(err))
+	})
+
+	t.Run("異常系：移動元に含まれていない記事", func(t *testing.T) {
+		repo := newStubCollectionRepository(&entity.Collection{ID: 1, Name: "Go", ArticleIDs: []int64{1}})
+		uc := NewCollectionUsecase(repo, newCollectionArticleRepository())
+
+		_, err := uc.MoveArticle(context.Background(), 1, 2, MoveCollectionArticleInput{})
+
+		assert.True(t, domainerrors.IsNotFoundError(err))
+	})
+}
@@ -0,0 +1,289 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/domain/service"
+	"article-manager/internal/infrastructure/logger"
+	"article-manager/internal/infrastructure/timeutil"
+
+	"go.uber.org/zap"
+)
+
+// ダイジェスト生成の制限
+const (
+	maxDigestGroups         = 8  // これを超えるタグは「その他」にまとめる
+	maxDigestPromptArticles = 20 // 1グループの概要生成でAIに渡す記事の最大数
+	maxDigestCatchUp        = 4  // 1回の定期実行で生成する期間の最大数
+)
+
+// 上限を超えたタグの記事をまとめるグループ名
+const digestOtherGroup = "その他"
+
+// ダイジェストユースケース
+type DigestUsecase struct {
+	articleRepo repository.ArticleRepository
+	digestRepo  repository.DigestRepository
+	summarizer  service.DigestSummarizer
+	period      time.Duration
+	now         func() time.Time
+}
+
+// periodは定期生成するダイジェストの期間の長さ
+func NewDigestUsecase(
+	articleRepo repository.ArticleRepository,
+	digestRepo repository.DigestRepository,
+	summarizer service.DigestSummarizer,
+	period time.Duration,
+) *DigestUsecase {
+	return &DigestUsecase{
+		articleRepo: articleRepo,
+		digestRepo:  digestRepo,
+		summarizer:  summarizer,
+		period:      period,
+		now:         time.Now,
+	}
+}
+
+// 期間[from, to)に作成された記事のダイジェストを生成して保存
+func (u *DigestUsecase) GenerateDigest(ctx context.Context, from, to time.Time) (*entity.Digest, error) {
+	logger.Debug("Generating digest",
+		zap.Time("from", from),
+		zap.Time("to", to),
+	)
+
+	if !from.Before(to) {
+		return nil, domainerrors.InvalidArgumentError("period", "period start must be before period end")
+	}
+
+	articles, err := u.articleRepo.FindByCreatedAtRange(ctx, from, to)
+	if err != nil {
+		logger.Error("Failed to find articles for digest",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	groups := groupArticlesByTag(articles)
+	digestGroups := make([]entity.DigestGroup, 0, len(groups))
+	for _, group := range groups {
+		overview, err := u.summarizeGroup(ctx, group.tag, group.articles)
+		if err != nil {
+			return nil, err
+		}
+
+		digestArticles := make([]entity.DigestArticle, 0, len(group.articles))
+		for _, article := range group.articles {
+			digestArticles = append(digestArticles, entity.DigestArticle{
+				ArticleID: article.ID,
+				Title:     article.Title,
+				URL:       article.URL,
+			})
+		}
+		digestGroups = append(digestGroups, entity.DigestGroup{
+			Tag:      group.tag,
+			Overview: overview,
+			Articles: digestArticles,
+		})
+	}
+
+	digest, err := entity.NewDigest(from, to, digestGroups)
+	if err != nil {
+		logger.Error("Failed to create digest entity",
+			zap.Error(err),
+		)
+		return nil, domainerrors.ValidationError("digest", err.Error())
+	}
+
+	saved, err := u.digestRepo.Create(ctx, digest)
+	if err != nil {
+		logger.Error("Failed to save digest",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	logger.Info("Successfully generated digest",
+		zap.Int64("id", saved.ID),
+		zap.Time("from", from),
+		zap.Time("to", to),
+		zap.Int("article_count", saved.ArticleCount),
+		zap.Int("group_count", len(saved.Groups)),
+	)
+
+	return saved, nil
+}
+
+// 前回のダイジェスト以降で期間が終了したダイジェストを生成
+// ダイジェストが1件もない場合は、今日（JST）の0時までの1期間を生成する
+func (u *DigestUsecase) GenerateDueDigests(ctx context.Context) ([]*entity.Digest, error) {
+	if u.period <= 0 {
+		return []*entity.Digest{}, nil
+	}
+
+	jst, err := timeutil.GetJST()
+	if err != nil {
+		return nil, domainerrors.InternalError("failed to load timezone", err)
+	}
+	now := u.now().In(jst)
+
+	latest, err := u.digestRepo.FindLatest(ctx)
+	if err != nil {
+		logger.Error("Failed to find latest digest",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	var start time.Time
+	if latest == nil {
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, jst)
+		start = today.Add(-u.period)
+	} else {
+		start = latest.PeriodEnd.In(jst)
+	}
+
+	generated := []*entity.Digest{}
+	for range maxDigestCatchUp {
+		end := start.Add(u.period)
+		if end.After(now) {
+			break
+		}
+
+		digest, err := u.GenerateDigest(ctx, start, end)
+		if err != nil {
+			if !domainerrors.IsAlreadyExistsError(err) {
+				return generated, err
+			}
+			// 他のインスタンスが同じ期間を生成済み
+			logger.Info("Digest for the period already exists",
+				zap.Time("from", start),
+				zap.Time("to", end),
+			)
+		} else {
+			generated = append(generated, digest)
+		}
+		start = end
+	}
+
+	return generated, nil
+}
+
+// すべてのダイジェストを新しい順に取得
+func (u *DigestUsecase) GetDigests(ctx context.Context) ([]*entity.Digest, error) {
+	digests, err := u.digestRepo.FindAll(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve digests",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return digests, nil
+}
+
+// 指定されたIDのダイジェストを取得
+func (u *DigestUsecase) GetDigest(ctx context.Context, id int64) (*entity.Digest, error) {
+	if id <= 0 {
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	digest, err := u.digestRepo.FindByID(ctx, id)
+	if err != nil {
+		if !domainerrors.IsNotFoundError(err) {
+			logger.Error("Failed to retrieve digest",
+				zap.Error(err),
+				zap.Int64("id", id),
+			)
+		}
+		return nil, err
+	}
+	return digest, nil
+}
+
+// グループの記事から概要を生成
+func (u *DigestUsecase) summarizeGroup(ctx context.Context, tag string, articles []*entity.Article) (string, error) {
+	sources := make([]service.SourceArticle, 0, min(len(articles), maxDigestPromptArticles))
+	for _, article := range articles[:min(len(articles), maxDigestPromptArticles)] {
+		sources = append(sources, service.SourceArticle{
+			ArticleID: article.ID,
+			Title:     article.Title,
+			URL:       article.URL,
+			Summary:   truncateRunes(article.Summary, maxSourceSummaryRunes),
+			Memo:      truncateRunes(article.Memo, maxSourceMemoRunes),
+			Tags:      article.Tags,
+		})
+	}
+
+	overview, err := u.summarizer.SummarizeDigestGroup(ctx, service.DigestGroupRequest{
+		Tag:      tag,
+		Articles: sources,
+	})
+	if err != nil {
+		logger.Error("Failed to summarize digest group",
+			zap.Error(err),
+			zap.String("tag", tag),
+		)
+		return "", err
+	}
+	return overview, nil
+}
+
+// タグごとの記事
+type articleGroup struct {
+	tag      string
+	articles []*entity.Article
+}
+
+// 記事をタグでグループ化する
+// 各記事は、期間内で最も多くの記事に付いているタグ（同数の場合は名前順）のグループに1回だけ含める
+// グループは記事数の多い順とし、上限を超えたグループは「その他」に、タグのない記事は「タグなし」にまとめる
+func groupArticlesByTag(articles []*entity.Article) []articleGroup {
+	tagCounts := make(map[string]int)
+	for _, article := range articles {
+		for _, tag := range article.Tags {
+			tagCounts[tag]++
+		}
+	}
+
+	byTag := make(map[string][]*entity.Article)
+	var untagged []*entity.Article
+	for _, article := range articles {
+		if len(article.Tags) == 0 {
+			untagged = append(untagged, article)
+			continue
+		}
+		primary := slices.MinFunc(article.Tags, func(a, b string) int {
+			return cmp.Or(cmp.Compare(tagCounts[b], tagCounts[a]), cmp.Compare(a, b))
+		})
+		byTag[primary] = append(byTag[primary], article)
+	}
+
+	groups := make([]articleGroup, 0, len(byTag)+1)
+	for tag, tagged := range byTag {
+		groups = append(groups, articleGroup{tag: tag, articles: tagged})
+	}
+	slices.SortFunc(groups, func(a, b articleGroup) int {
+		return cmp.Or(cmp.Compare(len(b.articles), len(a.articles)), cmp.Compare(a.tag, b.tag))
+	})
+
+	if len(groups) > maxDigestGroups {
+		var others []*entity.Article
+		for _, group := range groups[maxDigestGroups-1:] {
+			others = append(others, group.articles...)
+		}
+		slices.SortFunc(others, func(a, b *entity.Article) int {
+			return cmp.Or(a.CreatedAt.Compare(b.CreatedAt), cmp.Compare(a.ID, b.ID))
+		})
+		groups = append(groups[:maxDigestGroups-1], articleGroup{tag: digestOtherGroup, articles: others})
+	}
+	if len(untagged) > 0 {
+		groups = append(groups, articleGroup{tag: entity.DigestUntaggedGroup, articles: untagged})
+	}
+	return groups
+}
@@ -0,0 +1,268 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// モックDigestRepository（保存したダイジェストを記録する）
+type mockDigestRepository struct {
+	latest    *entity.Digest
+	createErr error
+	created   []*entity.Digest
+}
+
+func (m *mockDigestRepository) Create(ctx context.Context, digest *entity.Digest) (*entity.Digest, error) {
+	if m.createErr != nil {
+		return nil, m.createErr
+	}
+	saved := *digest
+	saved.ID = int64(len(m.created) + 1)
+	m.created = append(m.created, &saved)
+	return &saved, nil
+}
+
+func (m *mockDigestRepository) FindByID(ctx context.Context, id int64) (*entity.Digest, error) {
+	for _, digest := range m.created {
+		if digest.ID == id {
+			return digest, nil
+		}
+	}
+	return nil, domainerrors.NotFoundError("digest", id)
+}
+
+func (m *mockDigestRepository) FindAll(ctx context.Context) ([]*entity.Digest, error) {
+	return m.created, nil
+}
+
+func (m *mockDigestRepository) FindLatest(ctx context.Context) (*entity.Digest, error) {
+	return m.latest, nil
+}
+
+// モックDigestSummarizer（受け取ったリクエストを記録する）
+type mockDigestSummarizer struct {
+	requests []service.DigestGroupRequest
+	err      error
+}
+
+func (m *mockDigestSummarizer) SummarizeDigestGroup(ctx context.Context, req service.DigestGroupRequest) (string, error) {
+	if m.err != nil {
+		return "", m.err
+	}
+	m.requests = append(m.requests, req)
+	return fmt.Sprintf("%sの記事%d件の概要", req.Tag, len(req.Articles)), nil
+}
+
+func newDigestTestArticle(id int64, title string, tags ...string) *entity.Article {
+	return &entity.Article{
+		ID:        id,
+		Title:     title,
+		URL:       fmt.Sprintf("https://example.com/%d", id),
+		Summary:   title + "の要約",
+		Tags:      tags,
+		CreatedAt: time.Date(2026, 10, 6, 0, 0, 0, 0, time.UTC).Add(time.Duration(id) * time.Hour),
+	}
+}
+
+func TestGroupArticlesByTag(t *testing.T) {
+	t.Run("正常系：期間内で多いタグにまとめ、タグなしは最後にする", func(t *testing.T) {
+		articles := []*entity.Article{
+			newDigestTestArticle(1, "A", "Go", "Web"),
+			newDigestTestArticle(2, "B", "Go"),
+			newDigestTestArticle(3, "C", "Web", "Design"),
+			newDigestTestArticle(4, "D"),
+			newDigestTestArticle(5, "E", "Design", "Web"),
+		}
+
+		groups := groupArticlesByTag(articles)
+
+		require.Len(t, groups, 3)
+		// Web(3件)がGo(2件)より多いため、記事1はWebにまとめる
+		assert.Equal(t, "Web", groups[0].tag)
+		assert.Equal(t, []int64{1, 3, 5}, articleIDs(groups[0].articles))
+		assert.Equal(t, "Go", groups[1].tag)
+		assert.Equal(t, []int64{2}, articleIDs(groups[1].articles))
+		assert.Equal(t, entity.DigestUntaggedGroup, groups[2].tag)
+		assert.Equal(t, []int64{4}, articleIDs(groups[2].articles))
+	})
+
+	t.Run("正常系：上限を超えたタグは「その他」にまとめる", func(t *testing.T) {
+		var articles []*entity.Article
+		for i := range maxDigestGroups + 2 {
+			articles = append(articles, newDigestTestArticle(int64(i+1), "記事", fmt.Sprintf("tag%02d", i)))
+		}
+
+		groups := groupArticlesByTag(articles)
+
+		require.Len(t, groups, maxDigestGroups)
+		last := groups[len(groups)-1]
+		assert.Equal(t, digestOtherGroup, last.tag)
+		assert.Len(t, last.articles, 3)
+	})
+}
+
+func articleIDs(articles []*entity.Article) []int64 {
+	ids := make([]int64, 0, len(articles))
+	for _, article := range articles {
+		ids = append(ids, article.ID)
+	}
+	return ids
+}
+
+func TestGenerateDigest(t *testing.T) {
+	from := time.Date(2026, 10, 5, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+
+	t.Run("正常系：タグごとに概要を生成して保存する", func(t *testing.T) {
+		articleRepo := &mockArticleRepository{
+			findByCreatedAtRangeFunc: func(ctx context.Context, gotFrom, gotTo time.Time) ([]*entity.Article, error) {
+				assert.Equal(t, from, gotFrom)
+				assert.Equal(t, to, gotTo)
+				return []*entity.Article{
+					newDigestTestArticle(1, "Goroutine入門", "Go"),
+					newDigestTestArticle(2, "Channelの使い方", "Go"),
+					newDigestTestArticle(3, "雑記"),
+				}, nil
+			},
+		}
+		digestRepo := &mockDigestRepository{}
+		summarizer := &mockDigestSummarizer{}
+		uc := NewDigestUsecase(articleRepo, digestRepo, summarizer, 7*24*time.Hour)
+
+		digest, err := uc.GenerateDigest(context.Background(), from, to)
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), digest.ID)
+		assert.Equal(t, 3, digest.ArticleCount)
+		require.Len(t, digest.Groups, 2)
+		assert.Equal(t, "Go", digest.Groups[0].Tag)
+		assert.Equal(t, "Goの記事2件の概要", digest.Groups[0].Overview)
+		assert.Equal(t, int64(1), digest.Groups[0].Articles[0].ArticleID)
+		assert.Equal(t, entity.DigestUntaggedGroup, digest.Groups[1].Tag)
+		assert.Contains(t, digest.Markdown, "Goの記事2件の概要")
+		require.Len(t, summarizer.requests, 2)
+		assert.Equal(t, "Goroutine入門の要約", summarizer.requests[0].Articles[0].Summary)
+	})
+
+	t.Run("正常系：記事がない期間はAIを呼び出さずに保存する", func(t *testing.T) {
+		articleRepo := &mockArticleRepository{
+			findByCreatedAtRangeFunc: func(ctx context.Context, from, to time.Time) ([]*entity.Article, error) {
+				return nil, nil
+			},
+		}
+		digestRepo := &mockDigestRepository{}
+		summarizer := &mockDigestSummarizer{}
+		uc := NewDigestUsecase(articleRepo, digestRepo, summarizer, 7*24*time.Hour)
+
+		digest, err := uc.GenerateDigest(context.Background(), from, to)
+
+		require.NoError(t, err)
+		assert.Empty(t, digest.Groups)
+		assert.Empty(t, summarizer.requests)
+		assert.Len(t, digestRepo.created, 1)
+	})
+
+	t.Run("異常系：概要の生成に失敗した場合は保存しない", func(t *testing.T) {
+		articleRepo := &mockArticleRepository{
+			findByCreatedAtRangeFunc: func(ctx context.Context, from, to time.Time) ([]*entity.Article, error) {
+				return []*entity.Article{newDigestTestArticle(1, "記事", "Go")}, nil
+			},
+		}
+		digestRepo := &mockDigestRepository{}
+		summarizer := &mockDigestSummarizer{err: errors.New("ai error")}
+		uc := NewDigestUsecase(articleRepo, digestRepo, summarizer, 7*24*time.Hour)
+
+		_, err := uc.GenerateDigest(context.Background(), from, to)
+
+		assert.Error(t, err)
+		assert.Empty(t, digestRepo.created)
+	})
+
+	t.Run("異常系：期間の開始が終了以降", func(t *testing.T) {
+		uc := NewDigestUsecase(&mockArticleRepository{}, &mockDigestRepository{}, &mockDigestSummarizer{}, 7*24*time.Hour)
+
+		_, err := uc.GenerateDigest(context.Background(), to, from)
+
+		assert.Equal(t, domainerrors.ErrCodeInvalidArgument, domainerrors.GetErrorCode(err))
+	})
+}
+
+func TestGenerateDueDigests(t *testing.T) {
+	jst := time.FixedZone("Asia/Tokyo", 9*60*60)
+	period := 7 * 24 * time.Hour
+	emptyArticleRepo := &mockArticleRepository{
+		findByCreatedAtRangeFunc: func(ctx context.Context, from, to time.Time) ([]*entity.Article, error) {
+			return nil, nil
+		},
+	}
+
+	t.Run("正常系：ダイジェストがない場合は今日の0時までの1期間を生成する", func(t *testing.T) {
+		digestRepo := &mockDigestRepository{}
+		uc := NewDigestUsecase(emptyArticleRepo, digestRepo, &mockDigestSummarizer{}, period)
+		uc.now = func() time.Time { return time.Date(2026, 10, 18, 15, 30, 0, 0, jst) }
+
+		generated, err := uc.GenerateDueDigests(context.Background())
+
+		require.NoError(t, err)
+		require.Len(t, generated, 1)
+		assert.True(t, time.Date(2026, 10, 11, 0, 0, 0, 0, jst).Equal(generated[0].PeriodStart))
+		assert.True(t, time.Date(2026, 10, 18, 0, 0, 0, 0, jst).Equal(generated[0].PeriodEnd))
+	})
+
+	t.Run("正常系：前回の期間以降で終了した期間を順に生成する", func(t *testing.T) {
+		latestEnd := time.Date(2026, 10, 1, 0, 0, 0, 0, jst)
+		digestRepo := &mockDigestRepository{latest: &entity.Digest{PeriodEnd: latestEnd}}
+		uc := NewDigestUsecase(emptyArticleRepo, digestRepo, &mockDigestSummarizer{}, period)
+		uc.now = func() time.Time { return time.Date(2026, 10, 18, 15, 30, 0, 0, jst) }
+
+		generated, err := uc.GenerateDueDigests(context.Background())
+
+		require.NoError(t, err)
+		require.Len(t, generated, 2)
+		assert.True(t, latestEnd.Equal(generated[0].PeriodStart))
+		assert.True(t, time.Date(2026, 10, 15, 0, 0, 0, 0, jst).Equal(generated[1].PeriodEnd))
+	})
+
+	t.Run("正常系：期間が終了していない場合は生成しない", func(t *testing.T) {
+		digestRepo := &mockDigestRepository{latest: &entity.Digest{PeriodEnd: time.Date(2026, 10, 15, 0, 0, 0, 0, jst)}}
+		uc := NewDigestUsecase(emptyArticleRepo, digestRepo, &mockDigestSummarizer{}, period)
+		uc.now = func() time.Time { return time.Date(2026, 10, 18, 15, 30, 0, 0, jst) }
+
+		generated, err := uc.GenerateDueDigests(context.Background())
+
+		require.NoError(t, err)
+		assert.Empty(t, generated)
+	})
+
+	t.Run("正常系：生成済みの期間はスキップする", func(t *testing.T) {
+		digestRepo := &mockDigestRepository{createErr: domainerrors.AlreadyExistsError("digest", "2026-10-11")}
+		uc := NewDigestUsecase(emptyArticleRepo, digestRepo, &mockDigestSummarizer{}, period)
+		uc.now = func() time.Time { return time.Date(2026, 10, 18, 15, 30, 0, 0, jst) }
+
+		generated, err := uc.GenerateDueDigests(context.Background())
+
+		require.NoError(t, err)
+		assert.Empty(t, generated)
+	})
+
+	t.Run("正常系：期間が0の場合は生成しない", func(t *testing.T) {
+		digestRepo := &mockDigestRepository{}
+		uc := NewDigestUsecase(emptyArticleRepo, digestRepo, &mockDigestSummarizer{}, 0)
+
+		generated, err := uc.GenerateDueDigests(context.Background())
+
+		require.NoError(t, err)
+		assert.Empty(t, generated)
+		assert.Empty(t, digestRepo.created)
+	})
+}
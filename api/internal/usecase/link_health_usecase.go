@@ -0,0 +1,306 @@
+package usecase
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/domain/service"
+	"article-manager/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// リンクチェックの同時実行数（ホスト単位の制限はチェッカー側で行う）
+const linkCheckConcurrency = 8
+
+// 記事とそのリンクヘルス
+type ArticleLinkHealth struct {
+	Article *entity.Article
+	Health  *entity.LinkHealth
+}
+
+// リンクチェック結果の集計
+type LinkCheckSummary struct {
+	Checked    int
+	OK         int
+	Broken     int
+	Moved      int
+	URLUpdated int
+}
+
+// リンクヘルスに関するユースケース
+type LinkHealthUsecase struct {
+	articleRepo    repository.ArticleRepository
+	linkHealthRepo repository.LinkHealthRepository
+	linkChecker    service.LinkChecker
+	autoUpdateURL  bool
+	running        atomic.Bool
+}
+
+// コンストラクタ
+func NewLinkHealthUsecase(
+	articleRepo repository.ArticleRepository,
+	linkHealthRepo repository.LinkHealthRepository,
+	linkChecker service.LinkChecker,
+	autoUpdateURL bool,
+) *LinkHealthUsecase {
+	return &LinkHealthUsecase{
+		articleRepo:    articleRepo,
+		linkHealthRepo: linkHealthRepo,
+		linkChecker:    linkChecker,
+		autoUpdateURL:  autoUpdateURL,
+	}
+}
+
+// 全記事のリンクを確認
+func (u *LinkHealthUsecase) CheckAllLinks(ctx context.Context) (*LinkCheckSummary, error) {
+	if !u.running.CompareAndSwap(false, true) {
+		logger.Warn("Link check is already running")
+		return nil, domainerrors.ConflictError("link_check", "link check is already running")
+	}
+	defer u.running.Store(false)
+
+	return u.checkAllLinks(ctx)
+}
+
+// 全記事のリンクを確認（実行中フラグは呼び出し元で取得済み）
+func (u *LinkHealthUsecase) checkAllLinks(ctx context.Context) (*LinkCheckSummary, error) {
+	logger.Info("Starting link check for all articles")
+
+	articles, err := u.articleRepo.FindAll(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve articles for link check",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	summary := &LinkCheckSummary{}
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, linkCheckConcurrency)
+
+	for _, article := range articles {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return summary, ctx.Err()
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(article *entity.Article) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			health, updated, err := u.checkArticle(ctx, article)
+			if err != nil {
+				logger.Warn("Failed to check article link",
+					zap.Error(err),
+					zap.Int64("article_id", article.ID),
+				)
+				return
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+			summary.Checked++
+			switch health.Status {
+			case entity.LinkStatusOK:
+				summary.OK++
+			case entity.LinkStatusBroken:
+				summary.Broken++
+			case entity.LinkStatusMoved:
+				summary.Moved++
+			}
+			if updated {
+				summary.URLUpdated++
+			}
+		}(article)
+	}
+	wg.Wait()
+
+	logger.Info("Link check completed",
+		zap.Int("checked", summary.Checked),
+		zap.Int("ok", summary.OK),
+		zap.Int("broken", summary.Broken),
+		zap.Int("moved", summary.Moved),
+		zap.Int("url_updated", summary.URLUpdated),
+	)
+
+	return summary, nil
+}
+
+// バックグラウンドで全記事のリンクチェックを開始
+func (u *LinkHealthUsecase) StartLinkCheck() error {
+	// 同時に受け付けた2つ目の要求が確実に衝突となるよう、フラグは開始前に取得して実行側に渡す
+	if !u.running.CompareAndSwap(false, true) {
+		logger.Warn("Link check is already running")
+		return domainerrors.ConflictError("link_check", "link check is already running")
+	}
+
+	go func() {
+		defer u.running.Store(false)
+
+		if _, err := u.checkAllLinks(context.Background()); err != nil {
+			logger.Error("Background link check failed",
+				zap.Error(err),
+			)
+		}
+	}()
+
+	return nil
+}
+
+// 指定された記事のリンクを確認
+func (u *LinkHealthUsecase) CheckArticleLink(ctx context.Context, articleID int64) (*entity.LinkHealth, error) {
+	logger.Debug("Checking article link",
+		zap.Int64("article_id", articleID),
+	)
+
+	if articleID <= 0 {
+		logger.Warn("Invalid article ID",
+			zap.Int64("article_id", articleID),
+		)
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	article, err := u.articleRepo.FindByID(ctx, articleID)
+	if err != nil {
+		logger.Warn("Failed to find article for link check",
+			zap.Error(err),
+			zap.Int64("article_id", articleID),
+		)
+		return nil, err
+	}
+
+	health, _, err := u.checkArticle(ctx, article)
+	if err != nil {
+		return nil, err
+	}
+
+	return health, nil
+}
+
+// リンクヘルスの一覧を取得（statusが空の場合は全件）
+func (u *LinkHealthUsecase) GetLinkHealth(ctx context.Context, status string) ([]*ArticleLinkHealth, error) {
+	logger.Debug("Getting link health",
+		zap.String("status", status),
+	)
+
+	var healths []*entity.LinkHealth
+	var err error
+	if status == "" {
+		healths, err = u.linkHealthRepo.FindAll(ctx)
+	} else {
+		linkStatus, parseErr := entity.ParseLinkStatus(status)
+		if parseErr != nil {
+			logger.Warn("Invalid link status",
+				zap.String("status", status),
+			)
+			return nil, domainerrors.InvalidArgumentError("link_status", parseErr.Error())
+		}
+		healths, err = u.linkHealthRepo.FindByStatus(ctx, linkStatus)
+	}
+	if err != nil {
+		logger.Error("Failed to retrieve link health",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	articles, err := u.articleRepo.FindAll(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve articles for link health",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	articleMap := make(map[int64]*entity.Article, len(articles))
+	for _, article := range articles {
+		articleMap[article.ID] = article
+	}
+
+	results := make([]*ArticleLinkHealth, 0, len(healths))
+	for _, health := range healths {
+		article, exists := articleMap[health.ArticleID]
+		if !exists {
+			continue
+		}
+		results = append(results, &ArticleLinkHealth{Article: article, Health: health})
+	}
+
+	logger.Debug("Successfully retrieved link health",
+		zap.Int("count", len(results)),
+	)
+
+	return results, nil
+}
+
+// 記事のリンクを確認して結果を保存（恒久的なリダイレクトの場合は設定に応じてURLを更新）
+func (u *LinkHealthUsecase) checkArticle(ctx context.Context, article *entity.Article) (*entity.LinkHealth, bool, error) {
+	var statusCode int
+	var finalURL string
+	var permanent bool
+	var errorMessage string
+
+	result, err := u.linkChecker.CheckLink(ctx, article.URL)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, false, ctx.Err()
+		}
+		errorMessage = err.Error()
+	} else {
+		statusCode = result.StatusCode
+		finalURL = result.FinalURL
+		permanent = result.PermanentRedirect
+	}
+
+	health, err := entity.NewLinkHealth(article.ID, article.URL, statusCode, finalURL, permanent, errorMessage)
+	if err != nil {
+		logger.Warn("Failed to create link health entity",
+			zap.Error(err),
+			zap.Int64("article_id", article.ID),
+		)
+		return nil, false, domainerrors.ValidationError("link_health", err.Error())
+	}
+
+	updated := false
+	if u.autoUpdateURL && health.CanUpdateURL() {
+		// 確認中のユーザーの編集を上書きしないよう、URLのみを確認したURLのままの場合に限り更新する
+		if err := article.ChangeURL(health.FinalURL); err != nil {
+			logger.Warn("Failed to update article URL after permanent redirect",
+				zap.Error(err),
+				zap.Int64("article_id", article.ID),
+			)
+		} else if err := u.articleRepo.UpdateURL(ctx, article, health.CheckedURL); err != nil {
+			logger.Error("Failed to save updated article URL",
+				zap.Error(err),
+				zap.Int64("article_id", article.ID),
+			)
+		} else {
+			updated = true
+			logger.Info("Updated article URL after permanent redirect",
+				zap.Int64("article_id", article.ID),
+				zap.String("old_url", health.CheckedURL),
+				zap.String("new_url", health.FinalURL),
+			)
+		}
+	}
+
+	savedHealth, err := u.linkHealthRepo.Save(ctx, health)
+	if err != nil {
+		logger.Error("Failed to save link health",
+			zap.Error(err),
+			zap.Int64("article_id", article.ID),
+		)
+		return nil, false, err
+	}
+
+	return savedHealth, updated, nil
+}
@@ -0,0 +1,302 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/service"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// モック LinkHealthRepository
+type mockLinkHealthRepository struct {
+	mu      sync.Mutex
+	saved   map[int64]*entity.LinkHealth
+	saveErr error
+}
+
+func newMockLinkHealthRepository() *mockLinkHealthRepository {
+	return &mockLinkHealthRepository{saved: make(map[int64]*entity.LinkHealth)}
+}
+
+func (m *mockLinkHealthRepository) Save(ctx context.Context, health *entity.LinkHealth) (*entity.LinkHealth, error) {
+	if m.saveErr != nil {
+		return nil, m.saveErr
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.saved[health.ArticleID] = health
+	return health, nil
+}
+
+func (m *mockLinkHealthRepository) FindByArticleID(ctx context.Context, articleID int64) (*entity.LinkHealth, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	health, ok := m.saved[articleID]
+	if !ok {
+		return nil, domainerrors.NotFoundError("link_health", articleID)
+	}
+	return health, nil
+}
+
+func (m *mockLinkHealthRepository) FindAll(ctx context.Context) ([]*entity.LinkHealth, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	result := make([]*entity.LinkHealth, 0, len(m.saved))
+	for _, health := range m.saved {
+		result = append(result, health)
+	}
+	return result, nil
+}
+
+func (m *mockLinkHealthRepository) FindByStatus(ctx context.Context, status entity.LinkStatus) ([]*entity.LinkHealth, error) {
+	all, _ := m.FindAll(ctx)
+	result := make([]*entity.LinkHealth, 0)
+	for _, health := range all {
+		if health.Status == status {
+			result = append(result, health)
+		}
+	}
+	return result, nil
+}
+
+// モック LinkChecker
+type mockLinkChecker struct {
+	checkFunc func(ctx context.Context, url string) (*service.LinkCheckResult, error)
+}
+
+func (m *mockLinkChecker) CheckLink(ctx context.Context, url string) (*service.LinkCheckResult, error) {
+	return m.checkFunc(ctx, url)
+}
+
+func linkCheckTestArticles() []*entity.Article {
+	return []*entity.Article{
+		{ID: 1, Title: "生きている記事", URL: "https://example.com/alive", Summary: "要約", Tags: []string{}},
+		{ID: 2, Title: "消えた記事", URL: "https://example.com/gone", Summary: "要約", Tags: []string{}},
+		{ID: 3, Title: "移転した記事", URL: "https://old.example.com/moved", Summary: "要約", Tags: []string{}},
+	}
+}
+
+func linkCheckTestChecker() *mockLinkChecker {
+	return &mockLinkChecker{
+		checkFunc: func(ctx context.Context, url string) (*service.LinkCheckResult, error) {
+			switch url {
+			case "https://example.com/alive":
+				return &service.LinkCheckResult{StatusCode: 200, FinalURL: url}, nil
+			case "https://example.com/gone":
+				return &service.LinkCheckResult{StatusCode: 404, FinalURL: url}, nil
+			case "https://old.example.com/moved":
+				return &service.LinkCheckResult{StatusCode: 200, FinalURL: "https://new.example.com/moved", PermanentRedirect: true}, nil
+			default:
+				return nil, errors.New("connection refused")
+			}
+		},
+	}
+}
+
+// CheckAllLinksのテスト
+func TestCheckAllLinks(t *testing.T) {
+	t.Run("正常系：全記事のリンクを確認して結果を保存する", func(t *testing.T) {
+		articleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return linkCheckTestArticles(), nil
+			},
+		}
+		linkHealthRepo := newMockLinkHealthRepository()
+
+		uc := NewLinkHealthUsecase(articleRepo, linkHealthRepo, linkCheckTestChecker(), false)
+		summary, err := uc.CheckAllLinks(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, summary.Checked)
+		assert.Equal(t, 1, summary.OK)
+		assert.Equal(t, 1, summary.Broken)
+		assert.Equal(t, 1, summary.Moved)
+		assert.Equal(t, 0, summary.URLUpdated)
+
+		assert.Equal(t, entity.LinkStatusOK, linkHealthRepo.saved[1].Status)
+		assert.Equal(t, entity.LinkStatusBroken, linkHealthRepo.saved[2].Status)
+		assert.Equal(t, 404, linkHealthRepo.saved[2].StatusCode)
+		assert.Equal(t, entity.LinkStatusMoved, linkHealthRepo.saved[3].Status)
+		assert.Equal(t, "https://new.example.com/moved", linkHealthRepo.saved[3].FinalURL)
+	})
+
+	t.Run("正常系：自動更新が有効な場合、恒久的なリダイレクト先にURLを更新する", func(t *testing.T) {
+		var updated *entity.Article
+		var previousURL string
+		articleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return linkCheckTestArticles(), nil
+			},
+			updateURLFunc: func(ctx context.Context, article *entity.Article, previous string) error {
+				updated = article
+				previousURL = previous
+				return nil
+			},
+		}
+
+		uc := NewLinkHealthUsecase(articleRepo, newMockLinkHealthRepository(), linkCheckTestChecker(), true)
+		summary, err := uc.CheckAllLinks(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.URLUpdated)
+		require.NotNil(t, updated)
+		assert.Equal(t, int64(3), updated.ID)
+		assert.Equal(t, "https://new.example.com/moved", updated.URL)
+		assert.Equal(t, "https://old.example.com/moved", previousURL)
+	})
+
+	t.Run("正常系：確認中にURLが編集された記事は更新しない", func(t *testing.T) {
+		articleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return linkCheckTestArticles(), nil
+			},
+			updateURLFunc: func(ctx context.Context, article *entity.Article, previousURL string) error {
+				return domainerrors.NotFoundError("article", article.ID)
+			},
+		}
+		linkHealthRepo := newMockLinkHealthRepository()
+
+		uc := NewLinkHealthUsecase(articleRepo, linkHealthRepo, linkCheckTestChecker(), true)
+		summary, err := uc.CheckAllLinks(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 0, summary.URLUpdated)
+		assert.Equal(t, entity.LinkStatusMoved, linkHealthRepo.saved[3].Status)
+	})
+
+	t.Run("正常系：通信エラーはリンク切れとして記録する", func(t *testing.T) {
+		articleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return []*entity.Article{
+					{ID: 10, Title: "到達不能", URL: "https://unreachable.example.com/", Summary: "要約"},
+				}, nil
+			},
+		}
+		linkHealthRepo := newMockLinkHealthRepository()
+
+		uc := NewLinkHealthUsecase(articleRepo, linkHealthRepo, linkCheckTestChecker(), false)
+		summary, err := uc.CheckAllLinks(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, 1, summary.Broken)
+		assert.Equal(t, "connection refused", linkHealthRepo.saved[10].ErrorMessage)
+	})
+
+	t.Run("異常系：記事の取得に失敗した場合", func(t *testing.T) {
+		articleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return nil, errors.New("database error")
+			},
+		}
+
+		uc := NewLinkHealthUsecase(articleRepo, newMockLinkHealthRepository(), linkCheckTestChecker(), false)
+		summary, err := uc.CheckAllLinks(context.Background())
+
+		require.Error(t, err)
+		assert.Nil(t, summary)
+	})
+}
+
+// StartLinkCheckのテスト
+func TestStartLinkCheck(t *testing.T) {
+	t.Run("異常系：実行中に続けて開始した場合はその場で衝突を返す", func(t *testing.T) {
+		started := make(chan struct{})
+		release := make(chan struct{})
+		articleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				close(started)
+				<-release
+				return []*entity.Article{}, nil
+			},
+		}
+		uc := NewLinkHealthUsecase(articleRepo, newMockLinkHealthRepository(), linkCheckTestChecker(), false)
+
+		require.NoError(t, uc.StartLinkCheck())
+		// バックグラウンドの実行が始まる前でも2回目は衝突となる
+		err := uc.StartLinkCheck()
+		assert.Equal(t, domainerrors.ErrCodeConflict, domainerrors.GetErrorCode(err))
+
+		<-started
+		close(release)
+		require.Eventually(t, func() bool { return !uc.running.Load() }, time.Second, time.Millisecond)
+	})
+}
+
+// CheckArticleLinkのテスト
+func TestCheckArticleLink(t *testing.T) {
+	t.Run("正常系：指定した記事のリンクを確認する", func(t *testing.T) {
+		articleRepo := &mockArticleRepository{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.Article, error) {
+				return linkCheckTestArticles()[1], nil
+			},
+		}
+
+		uc := NewLinkHealthUsecase(articleRepo, newMockLinkHealthRepository(), linkCheckTestChecker(), false)
+		health, err := uc.CheckArticleLink(context.Background(), 2)
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.LinkStatusBroken, health.Status)
+	})
+
+	t.Run("異常系：IDが不正な場合", func(t *testing.T) {
+		uc := NewLinkHealthUsecase(&mockArticleRepository{}, newMockLinkHealthRepository(), linkCheckTestChecker(), false)
+		health, err := uc.CheckArticleLink(context.Background(), 0)
+
+		require.Error(t, err)
+		assert.Nil(t, health)
+		assert.True(t, domainerrors.IsValidationError(err))
+	})
+}
+
+// GetLinkHealthのテスト
+func TestGetLinkHealth(t *testing.T) {
+	setup := func(t *testing.T) *LinkHealthUsecase {
+		articleRepo := &mockArticleRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Article, error) {
+				return linkCheckTestArticles(), nil
+			},
+		}
+		uc := NewLinkHealthUsecase(articleRepo, newMockLinkHealthRepository(), linkCheckTestChecker(), false)
+		_, err := uc.CheckAllLinks(context.Background())
+		require.NoError(t, err)
+		return uc
+	}
+
+	t.Run("正常系：状態で絞り込む", func(t *testing.T) {
+		uc := setup(t)
+
+		results, err := uc.GetLinkHealth(context.Background(), "broken")
+
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, int64(2), results[0].Article.ID)
+		assert.Equal(t, "消えた記事", results[0].Article.Title)
+	})
+
+	t.Run("正常系：状態未指定の場合は全件", func(t *testing.T) {
+		uc := setup(t)
+
+		results, err := uc.GetLinkHealth(context.Background(), "")
+
+		require.NoError(t, err)
+		assert.Len(t, results, 3)
+	})
+
+	t.Run("異常系：不正な状態", func(t *testing.T) {
+		uc := setup(t)
+
+		results, err := uc.GetLinkHealth(context.Background(), "dead")
+
+		require.Error(t, err)
+		assert.Nil(t, results)
+		assert.True(t, domainerrors.IsValidationError(err))
+	})
+}
@@ -0,0 +1,261 @@
+package usecase
+
+import (
+	"context"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// 読書リストへの書籍追加の入力
+type AddReadingListItemInput struct {
+	Title         string
+	Author        string
+	ISBN          string // ISBN-10またはISBN-13
+	CoverImageURL string
+	ArticleIDs    []int64
+}
+
+// 読書リストの書籍更新の入力
+type UpdateReadingListItemInput struct {
+	Status     string
+	Notes      string
+	Rating     int
+	ArticleIDs []int64
+}
+
+// 読書リストに関するビジネスロジック
+type ReadingListUsecase struct {
+	repo                   repository.ReadingListRepository
+	articleRepo            repository.ArticleRepository
+	bookRecommendationRepo repository.BookRecommendationRepository
+}
+
+// コンストラクタ
+func NewReadingListUsecase(
+	repo repository.ReadingListRepository,
+	articleRepo repository.ArticleRepository,
+	bookRecommendationRepo repository.BookRecommendationRepository,
+) *ReadingListUsecase {
+	return &ReadingListUsecase{
+		repo:                   repo,
+		articleRepo:            articleRepo,
+		bookRecommendationRepo: bookRecommendationRepo,
+	}
+}
+
+// 書籍を読書リストに追加
+func (u *ReadingListUsecase) AddReadingListItem(ctx context.Context, input AddReadingListItemInput) (*entity.ReadingListItem, error) {
+	logger.Debug("Adding book to reading list",
+		zap.String("title", input.Title),
+		zap.String("isbn", input.ISBN),
+	)
+
+	item, err := entity.NewReadingListItem(input.Title, input.Author, input.ISBN, input.CoverImageURL, input.ArticleIDs)
+	if err != nil {
+		logger.Warn("Failed to create reading list item entity",
+			zap.Error(err),
+			zap.String("title", input.Title),
+		)
+		return nil, domainerrors.ValidationError("reading_list_item", err.Error())
+	}
+
+	if err := u.ensureArticlesExist(ctx, item.ArticleIDs); err != nil {
+		return nil, err
+	}
+
+	return u.create(ctx, item)
+}
+
+// 書籍推薦に含まれる書籍を読書リストに追加（推薦のきっかけとなった記事を関連付ける）
+func (u *ReadingListUsecase) AddReadingListItemFromRecommendation(ctx context.Context, recommendationID int64, bookKey string) (*entity.ReadingListItem, error) {
+	if recommendationID <= 0 {
+		return nil, domainerrors.InvalidArgumentError("recommendation_id", "recommendation id must be positive")
+	}
+	if bookKey == "" {
+		return nil, domainerrors.InvalidArgumentError("book_key", "book key is required")
+	}
+
+	recommendation, err := u.bookRecommendationRepo.FindByID(ctx, recommendationID)
+	if err != nil {
+		logger.Warn("Failed to find book recommendation",
+			zap.Error(err),
+			zap.Int64("recommendation_id", recommendationID),
+		)
+		return nil, err
+	}
+
+	var book *entity.Book
+	for i := range recommendation.Books {
+		if recommendation.Books[i].Key() == bookKey {
+			book = &recommendation.Books[i]
+			break
+		}
+	}
+	if book == nil {
+		return nil, domainerrors.NotFoundError("recommended_book", bookKey)
+	}
+
+	// 推薦後に削除された記事は関連付けない
+	articleIDs := make([]int64, 0, len(book.SourceArticleIDs))
+	for _, articleID := range book.SourceArticleIDs {
+		if _, err := u.articleRepo.FindByID(ctx, articleID); err != nil {
+			if domainerrors.IsNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+		articleIDs = append(articleIDs, articleID)
+	}
+
+	item, err := entity.NewReadingListItem(book.Title, book.Author, book.ISBN13, book.CoverImageURL, articleIDs)
+	if err != nil {
+		logger.Warn("Failed to create reading list item from recommendation",
+			zap.Error(err),
+			zap.Int64("recommendation_id", recommendationID),
+			zap.String("book_key", bookKey),
+		)
+		return nil, domainerrors.ValidationError("reading_list_item", err.Error())
+	}
+
+	return u.create(ctx, item)
+}
+
+// 指定されたIDの書籍を取得
+func (u *ReadingListUsecase) GetReadingListItem(ctx context.Context, id int64) (*entity.ReadingListItem, error) {
+	if id <= 0 {
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	item, err := u.repo.FindByID(ctx, id)
+	if err != nil {
+		logger.Warn("Failed to find reading list item",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return nil, err
+	}
+
+	return item, nil
+}
+
+// 読書リストを取得（statusを指定した場合はその状態の書籍のみ）
+func (u *ReadingListUsecase) GetReadingList(ctx context.Context, statusValue string) ([]*entity.ReadingListItem, error) {
+	var status entity.ReadingStatus
+	if statusValue != "" {
+		parsed, err := entity.ParseReadingStatus(statusValue)
+		if err != nil {
+			return nil, domainerrors.InvalidArgumentError("status", err.Error())
+		}
+		status = parsed
+	}
+
+	items, err := u.repo.FindAll(ctx, status)
+	if err != nil {
+		logger.Error("Failed to retrieve reading list",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// 書籍の状態・メモ・評価・関連する記事を更新
+func (u *ReadingListUsecase) UpdateReadingListItem(ctx context.Context, id int64, input UpdateReadingListItemInput) (*entity.ReadingListItem, error) {
+	logger.Debug("Updating reading list item",
+		zap.Int64("id", id),
+		zap.String("status", input.Status),
+	)
+
+	status, err := entity.ParseReadingStatus(input.Status)
+	if err != nil {
+		return nil, domainerrors.InvalidArgumentError("status", err.Error())
+	}
+
+	item, err := u.GetReadingListItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := item.Update(status, input.Notes, input.Rating, input.ArticleIDs); err != nil {
+		return nil, domainerrors.ValidationError("reading_list_item", err.Error())
+	}
+
+	if err := u.ensureArticlesExist(ctx, item.ArticleIDs); err != nil {
+		return nil, err
+	}
+
+	updated, err := u.repo.Update(ctx, item)
+	if err != nil {
+		logger.Error("Failed to update reading list item in repository",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return nil, err
+	}
+
+	logger.Info("Successfully updated reading list item",
+		zap.Int64("id", id),
+		zap.String("status", string(updated.Status)),
+	)
+
+	return updated, nil
+}
+
+// 指定されたIDの書籍を読書リストから削除
+func (u *ReadingListUsecase) DeleteReadingListItem(ctx context.Context, id int64) error {
+	if id <= 0 {
+		return domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+
+	if err := u.repo.Delete(ctx, id); err != nil {
+		logger.Error("Failed to delete reading list item from repository",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return err
+	}
+
+	logger.Info("Successfully deleted reading list item",
+		zap.Int64("id", id),
+	)
+
+	return nil
+}
+
+func (u *ReadingListUsecase) create(ctx context.Context, item *entity.ReadingListItem) (*entity.ReadingListItem, error) {
+	saved, err := u.repo.Create(ctx, item)
+	if err != nil {
+		logger.Error("Failed to save reading list item to repository",
+			zap.Error(err),
+			zap.String("key", item.Key),
+		)
+		return nil, err
+	}
+
+	logger.Info("Successfully added book to reading list",
+		zap.Int64("id", saved.ID),
+		zap.String("key", saved.Key),
+	)
+
+	return saved, nil
+}
+
+// 関連付ける記事が存在することを確認
+func (u *ReadingListUsecase) ensureArticlesExist(ctx context.Context, articleIDs []int64) error {
+	for _, articleID := range articleIDs {
+		if _, err := u.articleRepo.FindByID(ctx, articleID); err != nil {
+			logger.Warn("Failed to find article to link",
+				zap.Error(err),
+				zap.Int64("article_id", articleID),
+			)
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// モック ReadingListRepository
+type mockReadingListRepository struct {
+	createFunc   func(ctx context.Context, item *entity.ReadingListItem) (*entity.ReadingListItem, error)
+	findByIDFunc func(ctx context.Context, id int64) (*entity.ReadingListItem, error)
+	findAllFunc  func(ctx context.Context, status entity.ReadingStatus) ([]*entity.ReadingListItem, error)
+	updateFunc   func(ctx context.Context, item *entity.ReadingListItem) (*entity.ReadingListItem, error)
+	deleteFunc   func(ctx context.Context, id int64) error
+}
+
+func (m *mockReadingListRepository) Create(ctx context.Context, item *entity.ReadingListItem) (*entity.ReadingListItem, error) {
+	return m.createFunc(ctx, item)
+}
+
+func (m *mockReadingListRepository) FindByID(ctx context.Context, id int64) (*entity.ReadingListItem, error) {
+	return m.findByIDFunc(ctx, id)
+}
+
+func (m *mockReadingListRepository) FindAll(ctx context.Context, status entity.ReadingStatus) ([]*entity.ReadingListItem, error) {
+	return m.findAllFunc(ctx, status)
+}
+
+func (m *mockReadingListRepository) Update(ctx context.Context, item *entity.ReadingListItem) (*entity.ReadingListItem, error) {
+	return m.updateFunc(ctx, item)
+}
+
+func (m *mockReadingListRepository) Delete(ctx context.Context, id int64) error {
+	return m.deleteFunc(ctx, id)
+}
+
+// ID 1の記事のみ存在する記事リポジトリ
+func newReadingListArticleRepository() *mockArticleRepository {
+	return &mockArticleRepository{
+		findByIDFunc: func(ctx context.Context, id int64) (*entity.Article, error) {
+			if id != 1 {
+				return nil, domainerrors.NotFoundError("article", id)
+			}
+			return &entity.Article{ID: 1, Title: "Go入門"}, nil
+		},
+	}
+}
+
+// AddReadingListItemのテスト
+func TestAddReadingListItem(t *testing.T) {
+	t.Run("正常系：ISBN-10をISBN-13に変換して追加できる", func(t *testing.T) {
+		mockRepo := &mockReadingListRepository{
+			createFunc: func(ctx context.Context, item *entity.ReadingListItem) (*entity.ReadingListItem, error) {
+				item.ID = 1
+				return item, nil
+			},
+		}
+		usecase := NewReadingListUsecase(mockRepo, newReadingListArticleRepository(), &mockBookRecommendationRepository{})
+
+		result, err := usecase.AddReadingListItem(context.Background(), AddReadingListItemInput{
+			Title:      "リーダブルコード",
+			ISBN:       "4-87311-565-5",
+			ArticleIDs: []int64{1, 1},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "9784873115658", result.ISBN13)
+		assert.Equal(t, "isbn:9784873115658", result.Key)
+		assert.Equal(t, entity.ReadingStatusWant, result.Status)
+		assert.Equal(t, []int64{1}, result.ArticleIDs)
+	})
+
+	t.Run("異常系：チェックディジットが不正な場合はバリデーションエラー", func(t *testing.T) {
+		usecase := NewReadingListUsecase(&mockReadingListRepository{}, newReadingListArticleRepository(), &mockBookRecommendationRepository{})
+
+		_, err := usecase.AddReadingListItem(context.Background(), AddReadingListItemInput{
+			Title: "リーダブルコード",
+			ISBN:  "4873115656",
+		})
+
+		require.Error(t, err)
+		assert.True(t, domainerrors.IsValidationError(err))
+	})
+
+	t.Run("異常系：存在しない記事はNotFoundエラー", func(t *testing.T) {
+		usecase := NewReadingListUsecase(&mockReadingListRepository{}, newReadingListArticleRepository(), &mockBookRecommendationRepository{})
+
+		_, err := usecase.AddReadingListItem(context.Background(), AddReadingListItemInput{
+			Title:      "リーダブルコード",
+			ArticleIDs: []int64{2},
+		})
+
+		require.Error(t, err)
+		assert.True(t, domainerrors.IsNotFoundError(err))
+	})
+}
+
+// AddReadingListItemFromRecommendationのテスト
+func TestAddReadingListItemFromRecommendation(t *testing.T) {
+	recommendationRepo := &mockBookRecommendationRepository{
+		findByIDFunc: func(ctx context.Context, id int64) (*entity.BookRecommendationCache, error) {
+			return &entity.BookRecommendationCache{
+				ID: id,
+				Books: []entity.Book{
+					{Title: "プログラミング言語Go", Author: "Alan A. A. Donovan", ISBN13: "9784621300251", SourceArticleIDs: []int64{1, 2}},
+				},
+			}, nil
+		},
+	}
+
+	t.Run("正常系：推薦された書籍を存在する根拠の記事と共に追加できる", func(t *testing.T) {
+		mockRepo := &mockReadingListRepository{
+			createFunc: func(ctx context.Context, item *entity.ReadingListItem) (*entity.ReadingListItem, error) {
+				item.ID = 1
+				return item, nil
+			},
+		}
+		usecase := NewReadingListUsecase(mockRepo, newReadingListArticleRepository(), recommendationRepo)
+
+		result, err := usecase.AddReadingListItemFromRecommendation(context.Background(), 3, "isbn:9784621300251")
+
+		require.NoError(t, err)
+		assert.Equal(t, "プログラミング言語Go", result.Title)
+		assert.Equal(t, "Alan A. A. Donovan", result.Author)
+		assert.Equal(t, []int64{1}, result.ArticleIDs)
+	})
+
+	t.Run("異常系：推薦に含まれない書籍はNotFoundエラー", func(t *testing.T) {
+		usecase := NewReadingListUsecase(&mockReadingListRepository{}, newReadingListArticleRepository(), recommendationRepo)
+
+		_, err := usecase.AddReadingListItemFromRecommendation(context.Background(), 3, "title:リーダブルコード")
+
+		require.Error(t, err)
+		assert.True(t, domainerrors.IsNotFoundError(err))
+	})
+}
+
+// UpdateReadingListItemのテスト
+func TestUpdateReadingListItem(t *testing.T) {
+	t.Run("異常系：不正な状態はInvalidArgumentエラー", func(t *testing.T) {
+		usecase := NewReadingListUsecase(&mockReadingListRepository{}, newReadingListArticleRepository(), &mockBookRecommendationRepository{})
+
+		_, err := usecase.UpdateReadingListItem(context.Background(), 1, UpdateReadingListItemInput{Status: "finished"})
+
+		require.Error(t, err)
+		assert.Equal(t, domainerrors.ErrCodeInvalidArgument, domainerrors.GetErrorCode(err))
+	})
+
+	t.Run("正常系：状態・メモ・評価を更新できる", func(t *testing.T) {
+		existing, err := entity.NewReadingListItem("リーダブルコード", "", "", "", nil)
+		require.NoError(t, err)
+		existing.ID = 1
+
+		mockRepo := &mockReadingListRepository{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.ReadingListItem, error) {
+				return existing, nil
+			},
+			updateFunc: func(ctx context.Context, item *entity.ReadingListItem) (*entity.ReadingListItem, error) {
+				return item, nil
+			},
+		}
+		usecase := NewReadingListUsecase(mockRepo, newReadingListArticleRepository(), &mockBookRecommendationRepository{})
+
+		result, err := usecase.UpdateReadingListItem(context.Background(), 1, UpdateReadingListItemInput{
+			Status: "reading",
+			Notes:  "第2章まで",
+			Rating: 4,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, entity.ReadingStatusReading, result.Status)
+		assert.Equal(t, "第2章まで", result.Notes)
+		assert.Equal(t, 4, result.Rating)
+	})
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"context"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// タグの共起グラフの制限
+const (
+	DefaultTagGraphLimit = 100 // ノード数の既定値
+	MaxTagGraphLimit     = 500 // ノード数の上限
+)
+
+// タグの共起グラフのユースケース
+type TagGraphUsecase struct {
+	articleRepo repository.ArticleRepository
+}
+
+func NewTagGraphUsecase(articleRepo repository.ArticleRepository) *TagGraphUsecase {
+	return &TagGraphUsecase{articleRepo: articleRepo}
+}
+
+// タグの共起グラフを取得
+// MinWeightが0の場合は1、Limitが0の場合は既定のノード数とする
+func (u *TagGraphUsecase) GetTagGraph(ctx context.Context, opts entity.TagGraphOptions) (*entity.TagGraph, error) {
+	if opts.MinWeight < 0 {
+		return nil, domainerrors.InvalidArgumentError("min_weight", "min_weight must be positive")
+	}
+	if opts.Limit < 0 {
+		return nil, domainerrors.InvalidArgumentError("limit", "limit must be positive")
+	}
+	if opts.Limit > MaxTagGraphLimit {
+		return nil, domainerrors.InvalidArgumentError("limit", "limit must be 500 or less")
+	}
+	if opts.MinWeight == 0 {
+		opts.MinWeight = 1
+	}
+	if opts.Limit == 0 {
+		opts.Limit = DefaultTagGraphLimit
+	}
+
+	graph, err := u.articleRepo.FindTagGraph(ctx, opts)
+	if err != nil {
+		logger.Error("Failed to find tag graph",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	logger.Debug("Successfully built tag graph",
+		zap.Int("node_count", len(graph.Nodes)),
+		zap.Int("edge_count", len(graph.Edges)),
+	)
+
+	return graph, nil
+}
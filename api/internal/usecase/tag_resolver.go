@@ -0,0 +1,156 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+)
+
+// 既存タグに一致しないAI提案タグの扱い
+type TagPolicy string
+
+const (
+	TagPolicyExistingOnly TagPolicy = "existing_only" // 既存タグのみ使用し、新規タグは破棄する
+	TagPolicyAllowNew     TagPolicy = "allow_new"     // 新規タグを作成する
+	TagPolicyAsk          TagPolicy = "ask"           // 新規タグは作成せず、確認待ちとして返す
+)
+
+// デフォルトの類似度しきい値
+const DefaultTagSimilarityThreshold = 0.85
+
+// 文字列からTagPolicyを取得
+func ParseTagPolicy(s string) (TagPolicy, error) {
+	switch policy := TagPolicy(s); policy {
+	case TagPolicyExistingOnly, TagPolicyAllowNew, TagPolicyAsk:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid tag policy: %s", s)
+	}
+}
+
+// タグ提案の解決結果
+type TagResolution struct {
+	Matched []string // 既存タグに対応付けられたタグ名（既存タグの表記）
+	New     []string // 既存タグに対応しなかったタグ名
+}
+
+// AIが提案したタグを既存タグの語彙に対応付ける
+type TagResolver struct {
+	threshold float64
+	tagRepo   repository.TagRepository
+}
+
+// コンストラクタ
+func NewTagResolver(threshold float64, tagRepo repository.TagRepository) *TagResolver {
+	return &TagResolver{threshold: threshold, tagRepo: tagRepo}
+}
+
+// 提案タグを既存タグに対応付ける
+// 正規化キーの一致 → 別名（tag_aliases）の一致 → 類似度がしきい値以上の順に判定する
+func (r *TagResolver) Resolve(ctx context.Context, suggestions []string, existing []*entity.Tag) (TagResolution, error) {
+	policy := r.tagRepo.NamePolicy()
+	byKey := make(map[string]*entity.Tag, len(existing))
+	for _, tag := range existing {
+		key := policy.Key(tag.Name)
+		if _, exists := byKey[key]; !exists {
+			byKey[key] = tag
+		}
+	}
+
+	resolution := TagResolution{Matched: []string{}, New: []string{}}
+	seen := make(map[string]bool)
+
+	for _, suggestion := range suggestions {
+		suggestion = strings.TrimSpace(suggestion)
+		key := policy.Key(suggestion)
+		if key == "" {
+			continue
+		}
+
+		tag, err := r.match(ctx, suggestion, key, byKey)
+		if err != nil {
+			return TagResolution{}, err
+		}
+		if tag != nil {
+			matchedKey := "tag:" + policy.Key(tag.Name)
+			if !seen[matchedKey] {
+				seen[matchedKey] = true
+				resolution.Matched = append(resolution.Matched, tag.Name)
+			}
+			continue
+		}
+
+		newKey := "new:" + key
+		if !seen[newKey] {
+			seen[newKey] = true
+			resolution.New = append(resolution.New, suggestion)
+		}
+	}
+
+	return resolution, nil
+}
+
+func (r *TagResolver) match(ctx context.Context, suggestion, key string, byKey map[string]*entity.Tag) (*entity.Tag, error) {
+	if tag, ok := byKey[key]; ok {
+		return tag, nil
+	}
+
+	// 別名の場合は代表のタグを返す
+	tag, err := r.tagRepo.FindByName(ctx, suggestion)
+	if err == nil {
+		return tag, nil
+	}
+	if !domainerrors.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	var best *entity.Tag
+	bestScore := 0.0
+	for existingKey, tag := range byKey {
+		score := similarity(key, existingKey)
+		if score > bestScore || (score == bestScore && best != nil && tag.ID < best.ID) {
+			best = tag
+			bestScore = score
+		}
+	}
+	if best != nil && bestScore >= r.threshold {
+		return best, nil
+	}
+	return nil, nil
+}
+
+// レーベンシュタイン距離に基づく類似度（0〜1）
+func similarity(a, b string) float64 {
+	ra, rb := []rune(a), []rune(b)
+	longest := max(len(ra), len(rb))
+	if longest == 0 {
+		return 1
+	}
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
@@ -0,0 +1,140 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TagResolver.Resolveのテスト
+func TestTagResolver_Resolve(t *testing.T) {
+	existing := []*entity.Tag{
+		{ID: 1, Name: "Go"},
+		{ID: 2, Name: "JavaScript"},
+		{ID: 3, Name: "Kubernetes"},
+		{ID: 4, Name: "データベース"},
+		{ID: 5, Name: "機械学習"},
+	}
+	// tag_aliasesに登録された別名
+	aliases := map[string]*entity.Tag{
+		"golang": existing[0],
+		"k8s":    existing[2],
+		"ml":     existing[4],
+	}
+	tagRepo := &mockTagRepository{
+		findByNameFunc: func(ctx context.Context, name string) (*entity.Tag, error) {
+			if tag, ok := aliases[entity.DefaultTagNamePolicy().Key(name)]; ok {
+				return tag, nil
+			}
+			return nil, domainerrors.NotFoundError("tag", name)
+		},
+	}
+
+	tests := []struct {
+		name            string
+		suggestions     []string
+		expectedMatched []string
+		expectedNew     []string
+	}{
+		{
+			name:            "正常系：大文字小文字の違いを吸収する",
+			suggestions:     []string{"go", "JAVASCRIPT"},
+			expectedMatched: []string{"Go", "JavaScript"},
+			expectedNew:     []string{},
+		},
+		{
+			name:            "正常系：登録された別名を既存タグに対応付ける",
+			suggestions:     []string{"Golang", "k8s", "ML"},
+			expectedMatched: []string{"Go", "Kubernetes", "機械学習"},
+			expectedNew:     []string{},
+		},
+		{
+			name:            "正常系：全角英数と半角カナを正規化する",
+			suggestions:     []string{"Ｇｏ", "ﾃﾞｰﾀﾍﾞｰｽ"},
+			expectedMatched: []string{"Go", "データベース"},
+			expectedNew:     []string{},
+		},
+		{
+			name:            "正常系：類似度がしきい値以上なら既存タグとみなす",
+			suggestions:     []string{"Kubernetis", "Java Script"},
+			expectedMatched: []string{"Kubernetes", "JavaScript"},
+			expectedNew:     []string{},
+		},
+		{
+			name:            "正常系：一致しないタグは新規タグとして返す",
+			suggestions:     []string{"Rust", "Go"},
+			expectedMatched: []string{"Go"},
+			expectedNew:     []string{"Rust"},
+		},
+		{
+			name:            "正常系：重複と空文字を除外する",
+			suggestions:     []string{"Go", "golang", "", "Rust", "rust"},
+			expectedMatched: []string{"Go"},
+			expectedNew:     []string{"Rust"},
+		},
+	}
+	resolver := NewTagResolver(DefaultTagSimilarityThreshold, tagRepo)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolution, err := resolver.Resolve(context.Background(), tt.suggestions, existing)
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedMatched, resolution.Matched)
+			assert.Equal(t, tt.expectedNew, resolution.New)
+		})
+	}
+
+	t.Run("正常系：大文字小文字を区別する方針では大文字小文字の違うタグを新規タグとして返す", func(t *testing.T) {
+		resolver := NewTagResolver(DefaultTagSimilarityThreshold, &mockTagRepository{namePolicy: &entity.TagNamePolicy{FoldCase: false}})
+
+		resolution, err := resolver.Resolve(context.Background(), []string{"Go", "go"}, existing)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Go"}, resolution.Matched)
+		assert.Equal(t, []string{"go"}, resolution.New)
+	})
+
+	t.Run("正常系：登録されていない別名は新規タグとして返す", func(t *testing.T) {
+		resolver := NewTagResolver(DefaultTagSimilarityThreshold, &mockTagRepository{})
+
+		resolution, err := resolver.Resolve(context.Background(), []string{"k8s"}, existing)
+
+		require.NoError(t, err)
+		assert.Empty(t, resolution.Matched)
+		assert.Equal(t, []string{"k8s"}, resolution.New)
+	})
+
+	t.Run("異常系：別名の検索に失敗した場合はエラーを返す", func(t *testing.T) {
+		resolver := NewTagResolver(DefaultTagSimilarityThreshold, &mockTagRepository{
+			findByNameFunc: func(ctx context.Context, name string) (*entity.Tag, error) {
+				return nil, errors.New("database error")
+			},
+		})
+
+		_, err := resolver.Resolve(context.Background(), []string{"Rust"}, existing)
+
+		require.Error(t, err)
+	})
+}
+
+// ParseTagPolicyのテスト
+func TestParseTagPolicy(t *testing.T) {
+	t.Run("正常系：有効なポリシー", func(t *testing.T) {
+		for _, s := range []string{"existing_only", "allow_new", "ask"} {
+			policy, err := ParseTagPolicy(s)
+			require.NoError(t, err)
+			assert.Equal(t, TagPolicy(s), policy)
+		}
+	})
+
+	t.Run("異常系：不正なポリシー", func(t *testing.T) {
+		_, err := ParseTagPolicy("always")
+		require.Error(t, err)
+	})
+}
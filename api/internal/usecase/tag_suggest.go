@@ -0,0 +1,371 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
+	"article-manager/internal/domain/repository"
+	"article-manager/internal/infrastructure/logger"
+
+	"go.uber.org/zap"
+)
+
+// タグ候補の件数の制限
+const (
+	DefaultTagSuggestLimit = 10
+	MaxTagSuggestLimit     = 50
+	maxTagPrefixCandidates = 200 // 前方一致で取得する候補の最大数
+)
+
+// 読み・編集距離・文脈での探索に使う索引の有効期間
+// 前方一致はリポジトリから都度取得するため、記事の保存で増えたタグも前方一致の候補にはすぐ含まれる
+const tagSuggestIndexTTL = time.Minute
+
+// タグ候補の一致の種類（上から順に優先する）
+type TagMatch string
+
+const (
+	TagMatchExact   TagMatch = "exact"   // 入力と正規化キーが一致
+	TagMatchPrefix  TagMatch = "prefix"  // 入力で始まる
+	TagMatchReading TagMatch = "reading" // かな・ローマ字の読みが入力で始まる
+	TagMatchFuzzy   TagMatch = "fuzzy"   // 入力との編集距離が小さい
+	TagMatchContext TagMatch = "context" // 記事のタイトル・要約に含まれる（入力がない場合）
+)
+
+var tagMatchOrder = []TagMatch{TagMatchExact, TagMatchPrefix, TagMatchReading, TagMatchFuzzy, TagMatchContext}
+
+// タグ候補の取得条件
+type TagSuggestInput struct {
+	Query string
+	// 記事の文脈（タイトル・要約に含まれるタグを優先する）
+	Title   string
+	Summary string
+	Limit   int // 0の場合は既定の件数
+}
+
+// タグの候補
+type TagSuggestion struct {
+	Tag       *entity.Tag
+	Usage     entity.TagUsage
+	Match     TagMatch
+	InContext bool // 記事のタイトル・要約に含まれるか
+}
+
+// 入力途中のタグ名と記事の文脈から既存タグの候補を取得
+// 一致の種類の順に並べ、同じ種類の中では文脈に含まれるタグ、記事数の多いタグ、名前の順とする
+func (u *TagUsecase) SuggestTags(ctx context.Context, input TagSuggestInput) ([]TagSuggestion, error) {
+	if input.Limit < 0 {
+		return nil, domainerrors.InvalidArgumentError("limit", "limit must be positive")
+	}
+	if input.Limit > MaxTagSuggestLimit {
+		return nil, domainerrors.InvalidArgumentError("limit", "limit must be 50 or less")
+	}
+	if input.Limit == 0 {
+		input.Limit = DefaultTagSuggestLimit
+	}
+
+	policy := u.repo.NamePolicy()
+	query := policy.Key(input.Query)
+	articleContext := newTagSuggestContext(policy, input.Title+" "+input.Summary)
+	if query == "" && articleContext.empty() {
+		return nil, domainerrors.InvalidArgumentError("q", "q or article context is required")
+	}
+
+	logger.Debug("Suggesting tags",
+		zap.String("query", input.Query),
+		zap.Int("limit", input.Limit),
+	)
+
+	// 前方一致はリポジトリで絞り込み、読み・編集距離・文脈は索引から常に探す
+	// （前方一致の件数によって他の種類の候補の有無が変わらないようにする）
+	matcher := newTagQueryMatcher(policy, input.Query)
+	candidates := make(map[int64]*entity.Tag)
+	if query != "" {
+		tags, err := u.repo.FindByNamePrefix(ctx, entity.NormalizeTagName(input.Query), maxTagPrefixCandidates)
+		if err != nil {
+			logger.Error("Failed to find tags by prefix",
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		for _, tag := range tags {
+			candidates[tag.ID] = tag
+		}
+	}
+
+	entries, err := u.suggestIndex.get(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve tags",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	for _, entry := range entries {
+		if _, exists := candidates[entry.tag.ID]; exists {
+			continue
+		}
+		if _, ok := matcher.matchKeys(entry.key, entry.reading); ok || (query == "" && articleContext.containsKey(entry.key)) {
+			candidates[entry.tag.ID] = entry.tag
+		}
+	}
+
+	tagIDs := make([]int64, 0, len(candidates))
+	for id := range candidates {
+		tagIDs = append(tagIDs, id)
+	}
+	usages, err := u.repo.FindUsagesByTagIDs(ctx, tagIDs)
+	if err != nil {
+		logger.Error("Failed to retrieve tag usages",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	usageByTagID := make(map[int64]entity.TagUsage, len(usages))
+	for _, usage := range usages {
+		usageByTagID[usage.TagID] = usage
+	}
+
+	suggestions := make([]TagSuggestion, 0)
+	for _, tag := range candidates {
+		inContext := articleContext.contains(tag.Name)
+		match, ok := matcher.match(tag.Name)
+		switch {
+		case ok:
+		case query == "" && inContext:
+			match = TagMatchContext
+		default:
+			continue
+		}
+
+		usage := usageByTagID[tag.ID]
+		usage.TagID = tag.ID
+		suggestions = append(suggestions, TagSuggestion{Tag: tag, Usage: usage, Match: match, InContext: inContext})
+	}
+
+	slices.SortFunc(suggestions, func(a, b TagSuggestion) int {
+		if c := cmp.Compare(slices.Index(tagMatchOrder, a.Match), slices.Index(tagMatchOrder, b.Match)); c != 0 {
+			return c
+		}
+		if a.InContext != b.InContext {
+			if a.InContext {
+				return -1
+			}
+			return 1
+		}
+		if c := cmp.Compare(b.Usage.ArticleCount, a.Usage.ArticleCount); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Tag.Name, b.Tag.Name)
+	})
+	if len(suggestions) > input.Limit {
+		suggestions = suggestions[:input.Limit]
+	}
+
+	return suggestions, nil
+}
+
+// 入力途中のタグ名との一致を判定する
+type tagQueryMatcher struct {
+	policy  entity.TagNamePolicy
+	key     string
+	reading string
+}
+
+func newTagQueryMatcher(policy entity.TagNamePolicy, query string) tagQueryMatcher {
+	return tagQueryMatcher{
+		policy:  policy,
+		key:     policy.Key(query),
+		reading: entity.TagReadingPrefix(query),
+	}
+}
+
+func (m tagQueryMatcher) match(name string) (TagMatch, bool) {
+	return m.matchKeys(m.policy.Key(name), entity.TagReadingKey(name))
+}
+
+// 事前に計算したタグ名の正規化キーと読みで判定する
+func (m tagQueryMatcher) matchKeys(key, reading string) (TagMatch, bool) {
+	if m.key == "" {
+		return "", false
+	}
+
+	switch {
+	case key == m.key:
+		return TagMatchExact, true
+	case strings.HasPrefix(key, m.key):
+		return TagMatchPrefix, true
+	}
+
+	if m.reading != "" && strings.HasPrefix(reading, m.reading) {
+		return TagMatchReading, true
+	}
+
+	// 入力途中のため、タグ名全体とタグ名の先頭の同じ長さの部分のうち近い方と比べる
+	if allowed := allowedTagEditDistance(m.key); allowed > 0 && prefixDistance(m.key, key) <= allowed {
+		return TagMatchFuzzy, true
+	}
+	if allowed := allowedTagEditDistance(m.reading); allowed > 0 && prefixDistance(m.reading, reading) <= allowed {
+		return TagMatchFuzzy, true
+	}
+
+	return "", false
+}
+
+// 入力の長さに応じた許容する編集距離（短い入力では誤一致が多いため許容しない）
+func allowedTagEditDistance(query string) int {
+	switch length := len([]rune(query)); {
+	case length < 3:
+		return 0
+	case length < 6:
+		return 1
+	default:
+		return 2
+	}
+}
+
+func prefixDistance(query, target string) int {
+	q, t := []rune(query), []rune(target)
+	// 対象が短い場合は長さの差が編集距離の下限になるため、計算を省く
+	if len(t) < len(q) && len(q)-len(t) > allowedTagEditDistance(query) {
+		return len(q) - len(t)
+	}
+	distance := levenshtein(q, t)
+	if len(t) > len(q) {
+		distance = min(distance, levenshtein(q, t[:len(q)]))
+	}
+	return distance
+}
+
+// 記事のタイトル・要約に含まれるタグを判定する
+// 英数字のタグは単語単位で、それ以外のタグは文中の部分一致で判定する
+type tagSuggestContext struct {
+	policy entity.TagNamePolicy
+	words  []string
+	text   string
+}
+
+func newTagSuggestContext(policy entity.TagNamePolicy, text string) tagSuggestContext {
+	words := make([]string, 0)
+	fields := strings.FieldsFunc(entity.NormalizeTagName(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && !strings.ContainsRune("+#-_.", r)
+	})
+	for _, field := range fields {
+		// "Goで作る"のように英数字と日本語が続く場合も英数字の部分を単語とする
+		for _, word := range strings.FieldsFunc(field, func(r rune) bool { return r > unicode.MaxASCII }) {
+			if key := policy.Key(word); key != "" {
+				words = append(words, key)
+			}
+		}
+	}
+	return tagSuggestContext{policy: policy, words: words, text: policy.Key(text)}
+}
+
+func (c tagSuggestContext) empty() bool {
+	return c.text == ""
+}
+
+func (c tagSuggestContext) contains(name string) bool {
+	return c.containsKey(c.policy.Key(name))
+}
+
+// 事前に計算したタグ名の正規化キーで判定する
+func (c tagSuggestContext) containsKey(key string) bool {
+	if key == "" || c.empty() {
+		return false
+	}
+	if !isASCIITagKey(key) {
+		return strings.Contains(c.text, key)
+	}
+
+	// "Machine Learning"のような複数語のタグは連続する単語を空白でつなげて比べる
+	for i := range c.words {
+		joined := ""
+		for j, word := range c.words[i:min(i+3, len(c.words))] {
+			if j > 0 {
+				joined += " "
+			}
+			joined += word
+			if joined == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isASCIITagKey(key string) bool {
+	for _, r := range key {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
+
+// 索引のエントリ
+type tagSuggestEntry struct {
+	tag     *entity.Tag
+	key     string
+	reading string
+}
+
+// タグ候補の探索用の索引
+// 入力のたびに全タグを取得して正規化キーと読みを計算しないよう、有効期間の間は保持する
+type tagSuggestIndex struct {
+	repo    repository.TagRepository
+	ttl     time.Duration
+	now     func() time.Time
+	mu      sync.Mutex
+	entries []tagSuggestEntry
+	builtAt time.Time
+	valid   bool
+}
+
+func newTagSuggestIndex(repo repository.TagRepository, ttl time.Duration) *tagSuggestIndex {
+	return &tagSuggestIndex{repo: repo, ttl: ttl, now: time.Now}
+}
+
+// 索引を取得（期限切れの場合は作り直す）
+func (x *tagSuggestIndex) get(ctx context.Context) ([]tagSuggestEntry, error) {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	if x.valid && x.now().Before(x.builtAt.Add(x.ttl)) {
+		return x.entries, nil
+	}
+
+	tags, err := x.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	policy := x.repo.NamePolicy()
+	entries := make([]tagSuggestEntry, 0, len(tags))
+	for _, tag := range tags {
+		entries = append(entries, tagSuggestEntry{
+			tag:     tag,
+			key:     policy.Key(tag.Name),
+			reading: entity.TagReadingKey(tag.Name),
+		})
+	}
+
+	x.entries = entries
+	x.builtAt = x.now()
+	x.valid = true
+	return x.entries, nil
+}
+
+// タグの作成・変更・削除の後に索引を破棄する
+func (x *tagSuggestIndex) invalidate() {
+	x.mu.Lock()
+	defer x.mu.Unlock()
+
+	x.valid = false
+	x.entries = nil
+}
@@ -1,7 +1,12 @@
 package usecase
 
 import (
+	"cmp"
 	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"article-manager/internal/domain/entity"
 	domainerrors "article-manager/internal/domain/errors"
@@ -11,39 +16,94 @@ import (
 	"go.uber.org/zap"
 )
 
+// タグ作成の入力
+type CreateTagInput struct {
+	Name        string
+	ParentID    int64  // 0の場合は親なし
+	Color       string // #RRGGBB形式（空文字の場合は未設定）
+	Description string
+}
+
+// タグ更新の入力（nilの項目は変更しない）
+type UpdateTagInput struct {
+	Name        string
+	ParentID    *int64  // 0の場合は親を外す
+	Color       *string // 空文字の場合は色を外す
+	Description *string
+}
+
+// タグ一覧の並び順
+const (
+	TagSortName   = "name"   // 名前順（既定）
+	TagSortUsage  = "usage"  // 記事数の多い順
+	TagSortRecent = "recent" // 最後に使われた順
+)
+
+// タグ一覧の取得条件
+type TagListOptions struct {
+	Sort       string
+	UnusedOnly bool // 記事のないタグのみ
+}
+
+// 使用状況付きのタグ
+type TagSummary struct {
+	Tag   *entity.Tag
+	Usage entity.TagUsage
+}
+
+// タグの記事数の推移の既定の月数
+const DefaultTagGrowthMonths = 12
+
 // タグに関するビジネスロジック
 type TagUsecase struct {
-	repo repository.TagRepository
+	repo         repository.TagRepository
+	suggestIndex *tagSuggestIndex
 }
 
 func NewTagUsecase(repo repository.TagRepository) *TagUsecase {
-	return &TagUsecase{repo: repo}
+	return &TagUsecase{
+		repo:         repo,
+		suggestIndex: newTagSuggestIndex(repo, tagSuggestIndexTTL),
+	}
 }
 
 // 新しいタグを作成
-func (u *TagUsecase) CreateTag(ctx context.Context, name string) (*entity.Tag, error) {
+func (u *TagUsecase) CreateTag(ctx context.Context, input CreateTagInput) (*entity.Tag, error) {
 	logger.Debug("Creating tag",
-		zap.String("name", name),
+		zap.String("name", input.Name),
+		zap.Int64("parent_id", input.ParentID),
 	)
 
-	tag, err := entity.NewTag(name)
+	tag, err := entity.NewTag(input.Name)
 	if err != nil {
 		logger.Warn("Failed to create tag entity",
 			zap.Error(err),
-			zap.String("name", name),
+			zap.String("name", input.Name),
 		)
 		return nil, domainerrors.ValidationError("tag", err.Error())
 	}
+	if err := tag.SetColor(input.Color); err != nil {
+		return nil, domainerrors.ValidationError("tag", err.Error())
+	}
+	if err := tag.SetDescription(input.Description); err != nil {
+		return nil, domainerrors.ValidationError("tag", err.Error())
+	}
+
+	if err := u.setParent(ctx, tag, input.ParentID); err != nil {
+		return nil, err
+	}
 
 	savedTag, err := u.repo.Create(ctx, tag)
 	if err != nil {
 		logger.Error("Failed to save tag to repository",
 			zap.Error(err),
-			zap.String("name", name),
+			zap.String("name", input.Name),
 		)
 		return nil, err
 	}
 
+	u.suggestIndex.invalidate()
+
 	logger.Info("Successfully created tag",
 		zap.Int64("id", savedTag.ID),
 		zap.String("name", savedTag.Name),
@@ -129,11 +189,140 @@ func (u *TagUsecase) GetAllTags(ctx context.Context) ([]*entity.Tag, error) {
 	return tags, nil
 }
 
+// 使用状況付きのタグ一覧を取得
+func (u *TagUsecase) ListTags(ctx context.Context, opts TagListOptions) ([]TagSummary, error) {
+	switch opts.Sort {
+	case "", TagSortName, TagSortUsage, TagSortRecent:
+	default:
+		return nil, domainerrors.InvalidArgumentError("sort", "sort must be one of name, usage, recent")
+	}
+
+	tags, err := u.GetAllTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+	usages, err := u.repo.FindUsages(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve tag usages",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	usageByTagID := make(map[int64]entity.TagUsage, len(usages))
+	for _, usage := range usages {
+		usageByTagID[usage.TagID] = usage
+	}
+
+	summaries := make([]TagSummary, 0, len(tags))
+	for _, tag := range tags {
+		usage := usageByTagID[tag.ID]
+		usage.TagID = tag.ID
+		if opts.UnusedOnly && !usage.Unused() {
+			continue
+		}
+		summaries = append(summaries, TagSummary{Tag: tag, Usage: usage})
+	}
+
+	// 同じ記事数・日時の場合は名前順とする
+	slices.SortStableFunc(summaries, func(a, b TagSummary) int {
+		switch opts.Sort {
+		case TagSortUsage:
+			if c := cmp.Compare(b.Usage.ArticleCount, a.Usage.ArticleCount); c != 0 {
+				return c
+			}
+		case TagSortRecent:
+			if c := b.Usage.LastUsedAt.Compare(a.Usage.LastUsedAt); c != 0 {
+				return c
+			}
+		}
+		return cmp.Compare(a.Tag.Name, b.Tag.Name)
+	})
+
+	return summaries, nil
+}
+
+// タグの使用状況と直近monthsか月の記事数の推移を取得（0の場合は既定の月数）
+func (u *TagUsecase) GetTagStats(ctx context.Context, id int64, months int) (*entity.TagStats, error) {
+	if months < 0 {
+		return nil, domainerrors.InvalidArgumentError("months", "months must be positive")
+	}
+	if months == 0 {
+		months = DefaultTagGrowthMonths
+	}
+
+	tag, err := u.GetTagByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	usages, err := u.repo.FindUsages(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve tag usages",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	usage := entity.TagUsage{TagID: id}
+	for _, candidate := range usages {
+		if candidate.TagID == id {
+			usage = candidate
+			break
+		}
+	}
+
+	counts, err := u.repo.FindMonthlyArticleCounts(ctx, id)
+	if err != nil {
+		logger.Error("Failed to retrieve monthly article counts",
+			zap.Error(err),
+			zap.Int64("id", id),
+		)
+		return nil, err
+	}
+
+	now := time.Now()
+	thisMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	return &entity.TagStats{
+		Tag:    tag,
+		Usage:  usage,
+		Growth: entity.BuildTagGrowth(counts, thisMonth.AddDate(0, -(months-1), 0), thisMonth),
+	}, nil
+}
+
+// 記事にも子タグにも使われていないタグを削除
+func (u *TagUsecase) DeleteUnusedTags(ctx context.Context) ([]*entity.Tag, error) {
+	deleted, err := u.repo.DeleteUnused(ctx)
+	if err != nil {
+		logger.Error("Failed to delete unused tags",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	u.suggestIndex.invalidate()
+
+	logger.Info("Successfully deleted unused tags",
+		zap.Int("count", len(deleted)),
+	)
+
+	return deleted, nil
+}
+
+// タグの階層構造を取得
+func (u *TagUsecase) GetTagTree(ctx context.Context) ([]*entity.TagNode, error) {
+	tags, err := u.GetAllTags(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.BuildTagTree(tags), nil
+}
+
 // タグを更新
-func (u *TagUsecase) UpdateTag(ctx context.Context, id int64, name string) (*entity.Tag, error) {
+func (u *TagUsecase) UpdateTag(ctx context.Context, id int64, input UpdateTagInput) (*entity.Tag, error) {
 	logger.Debug("Updating tag",
 		zap.Int64("id", id),
-		zap.String("name", name),
+		zap.String("name", input.Name),
 	)
 
 	if id <= 0 {
@@ -152,13 +341,29 @@ func (u *TagUsecase) UpdateTag(ctx context.Context, id int64, name string) (*ent
 		return nil, err
 	}
 
-	if err := tag.Update(name); err != nil {
+	if err := tag.Update(input.Name); err != nil {
 		logger.Warn("Failed to update tag entity",
 			zap.Error(err),
 			zap.Int64("id", id),
 		)
 		return nil, domainerrors.ValidationError("tag", err.Error())
 	}
+	if input.Color != nil {
+		if err := tag.SetColor(*input.Color); err != nil {
+			return nil, domainerrors.ValidationError("tag", err.Error())
+		}
+	}
+	if input.Description != nil {
+		if err := tag.SetDescription(*input.Description); err != nil {
+			return nil, domainerrors.ValidationError("tag", err.Error())
+		}
+	}
+
+	if input.ParentID != nil {
+		if err := u.setParent(ctx, tag, *input.ParentID); err != nil {
+			return nil, err
+		}
+	}
 
 	updatedTag, err := u.repo.Update(ctx, tag)
 	if err != nil {
@@ -169,6 +374,8 @@ func (u *TagUsecase) UpdateTag(ctx context.Context, id int64, name string) (*ent
 		return nil, err
 	}
 
+	u.suggestIndex.invalidate()
+
 	logger.Info("Successfully updated tag",
 		zap.Int64("id", updatedTag.ID),
 		zap.String("name", updatedTag.Name),
@@ -198,9 +405,218 @@ func (u *TagUsecase) DeleteTag(ctx context.Context, id int64) error {
 		return err
 	}
 
+	u.suggestIndex.invalidate()
+
 	logger.Info("Successfully deleted tag",
 		zap.Int64("id", id),
 	)
 
 	return nil
 }
+
+// 統合元のタグを統合先のタグに統合
+func (u *TagUsecase) MergeTags(ctx context.Context, targetID int64, sourceIDs []int64) (*entity.Tag, error) {
+	logger.Debug("Merging tags",
+		zap.Int64("target_id", targetID),
+		zap.Int64s("source_ids", sourceIDs),
+	)
+
+	if targetID <= 0 {
+		return nil, domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+	sourceIDs = slices.Compact(slices.Sorted(slices.Values(sourceIDs)))
+	if len(sourceIDs) == 0 {
+		return nil, domainerrors.InvalidArgumentError("source_ids", "source ids are required")
+	}
+
+	if _, err := u.GetTagByID(ctx, targetID); err != nil {
+		return nil, err
+	}
+	for _, id := range sourceIDs {
+		if id == targetID {
+			continue
+		}
+		if _, err := u.GetTagByID(ctx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	tags, err := u.repo.FindAll(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve tags for merge validation",
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	if err := entity.ValidateTagMerge(targetID, sourceIDs, tags); err != nil {
+		logger.Warn("Invalid tag merge",
+			zap.Error(err),
+			zap.Int64("target_id", targetID),
+		)
+		return nil, domainerrors.ValidationError("source_ids", err.Error())
+	}
+
+	merged, err := u.repo.Merge(ctx, targetID, sourceIDs)
+	if err != nil {
+		logger.Error("Failed to merge tags in repository",
+			zap.Error(err),
+			zap.Int64("target_id", targetID),
+		)
+		return nil, err
+	}
+
+	u.suggestIndex.invalidate()
+
+	logger.Info("Successfully merged tags",
+		zap.Int64("target_id", merged.ID),
+		zap.Int64s("source_ids", sourceIDs),
+	)
+
+	return merged, nil
+}
+
+// 正規化キーが衝突したタグを、それぞれID順で先頭のタグに統合する
+// 正規化キーを持たないタグが残らないよう起動時に実行し、統合できない場合は衝突の一覧を含むエラーを返す
+func (u *TagUsecase) MergeNameCollisions(ctx context.Context, collisions []entity.TagNameCollision) error {
+	for _, collision := range collisions {
+		target := collision.Tags[0]
+		sourceIDs := make([]int64, 0, len(collision.Tags)-1)
+		for _, tag := range collision.Tags[1:] {
+			sourceIDs = append(sourceIDs, tag.ID)
+		}
+
+		if _, err := u.MergeTags(ctx, target.ID, sourceIDs); err != nil {
+			return fmt.Errorf("failed to merge colliding tags (key: %q, tags: %s): %w", collision.Key, tagNameList(collision.Tags), err)
+		}
+		logger.Info("Merged tags with colliding normalized names",
+			zap.String("key", collision.Key),
+			zap.Int64("target_id", target.ID),
+			zap.Int64s("source_ids", sourceIDs),
+		)
+	}
+	return nil
+}
+
+// ログやエラーに含めるタグの一覧
+func tagNameList(tags []*entity.Tag) string {
+	names := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		names = append(names, fmt.Sprintf("%q(id=%d)", tag.Name, tag.ID))
+	}
+	return strings.Join(names, ", ")
+}
+
+// 指定されたタグの別名を取得
+func (u *TagUsecase) GetTagAliases(ctx context.Context, tagID int64) ([]*entity.TagAlias, error) {
+	if _, err := u.GetTagByID(ctx, tagID); err != nil {
+		return nil, err
+	}
+
+	aliases, err := u.repo.FindAliases(ctx, tagID)
+	if err != nil {
+		logger.Error("Failed to retrieve tag aliases",
+			zap.Error(err),
+			zap.Int64("tag_id", tagID),
+		)
+		return nil, err
+	}
+
+	return aliases, nil
+}
+
+// タグに別名を追加
+func (u *TagUsecase) CreateTagAlias(ctx context.Context, tagID int64, aliasName string) (*entity.TagAlias, error) {
+	logger.Debug("Creating tag alias",
+		zap.Int64("tag_id", tagID),
+		zap.String("alias", aliasName),
+	)
+
+	if _, err := u.GetTagByID(ctx, tagID); err != nil {
+		return nil, err
+	}
+
+	alias, err := entity.NewTagAlias(aliasName, tagID)
+	if err != nil {
+		return nil, domainerrors.ValidationError("alias", err.Error())
+	}
+
+	saved, err := u.repo.CreateAlias(ctx, alias)
+	if err != nil {
+		logger.Warn("Failed to save tag alias to repository",
+			zap.Error(err),
+			zap.String("alias", alias.Alias),
+		)
+		return nil, err
+	}
+
+	logger.Info("Successfully created tag alias",
+		zap.Int64("tag_id", tagID),
+		zap.String("alias", saved.Alias),
+	)
+
+	return saved, nil
+}
+
+// タグの別名を削除
+func (u *TagUsecase) DeleteTagAlias(ctx context.Context, tagID int64, alias string) error {
+	if tagID <= 0 {
+		return domainerrors.InvalidArgumentError("id", "id must be positive")
+	}
+	if alias == "" {
+		return domainerrors.InvalidArgumentError("alias", "alias is required")
+	}
+
+	if err := u.repo.DeleteAlias(ctx, tagID, alias); err != nil {
+		logger.Warn("Failed to delete tag alias",
+			zap.Error(err),
+			zap.Int64("tag_id", tagID),
+			zap.String("alias", alias),
+		)
+		return err
+	}
+
+	logger.Info("Successfully deleted tag alias",
+		zap.Int64("tag_id", tagID),
+		zap.String("alias", alias),
+	)
+
+	return nil
+}
+
+// 親タグの存在と循環を確認して設定
+func (u *TagUsecase) setParent(ctx context.Context, tag *entity.Tag, parentID int64) error {
+	if parentID == tag.ParentID {
+		return nil
+	}
+	if parentID < 0 {
+		return domainerrors.InvalidArgumentError("parent_id", "parent id must be positive")
+	}
+	if parentID > 0 {
+		if _, err := u.repo.FindByID(ctx, parentID); err != nil {
+			logger.Warn("Failed to find parent tag",
+				zap.Error(err),
+				zap.Int64("parent_id", parentID),
+			)
+			return err
+		}
+	}
+
+	tags, err := u.repo.FindAll(ctx)
+	if err != nil {
+		logger.Error("Failed to retrieve tags for parent validation",
+			zap.Error(err),
+		)
+		return err
+	}
+
+	if err := tag.SetParent(parentID, tags); err != nil {
+		logger.Warn("Failed to set parent tag",
+			zap.Error(err),
+			zap.Int64("id", tag.ID),
+			zap.Int64("parent_id", parentID),
+		)
+		return domainerrors.ValidationError("parent_id", err.Error())
+	}
+
+	return nil
+}
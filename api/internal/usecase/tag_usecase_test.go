@@ -3,11 +3,13 @@ package usecase
 import (
 	"context"
 	"errors"
+	"slices"
 	"strings"
 	"testing"
 	"time"
 
 	"article-manager/internal/domain/entity"
+	domainerrors "article-manager/internal/domain/errors"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -21,6 +23,12 @@ type mockTagRepository struct {
 	findAllFunc    func(ctx context.Context) ([]*entity.Tag, error)
 	updateFunc     func(ctx context.Context, tag *entity.Tag) (*entity.Tag, error)
 	deleteFunc     func(ctx context.Context, id int64) error
+	mergeFunc      func(ctx context.Context, targetID int64, sourceIDs []int64) (*entity.Tag, error)
+	usages         []entity.TagUsage
+	monthlyCounts  []entity.TagMonthlyCount
+	namePolicy     *entity.TagNamePolicy // nilの場合は既定の正規化方針
+
+	usageTagIDs []int64 // FindUsagesByTagIDsに渡されたタグID
 }
 
 func (m *mockTagRepository) Create(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
@@ -32,13 +40,40 @@ func (m *mockTagRepository) FindByID(ctx context.Context, id int64) (*entity.Tag
 }
 
 func (m *mockTagRepository) FindByName(ctx context.Context, name string) (*entity.Tag, error) {
+	if m.findByNameFunc == nil {
+		return nil, domainerrors.NotFoundError("tag", name)
+	}
 	return m.findByNameFunc(ctx, name)
 }
 
 func (m *mockTagRepository) FindAll(ctx context.Context) ([]*entity.Tag, error) {
+	if m.findAllFunc == nil {
+		return []*entity.Tag{}, nil
+	}
 	return m.findAllFunc(ctx)
 }
 
+func (m *mockTagRepository) FindByNamePrefix(ctx context.Context, prefix string, limit int) ([]*entity.Tag, error) {
+	tags, err := m.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*entity.Tag, 0)
+	for _, tag := range tags {
+		if strings.HasPrefix(strings.ToLower(tag.Name), strings.ToLower(prefix)) && len(result) < limit {
+			result = append(result, tag)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockTagRepository) NamePolicy() entity.TagNamePolicy {
+	if m.namePolicy == nil {
+		return entity.DefaultTagNamePolicy()
+	}
+	return *m.namePolicy
+}
+
 func (m *mockTagRepository) Update(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
 	return m.updateFunc(ctx, tag)
 }
@@ -47,6 +82,45 @@ func (m *mockTagRepository) Delete(ctx context.Context, id int64) error {
 	return m.deleteFunc(ctx, id)
 }
 
+func (m *mockTagRepository) Merge(ctx context.Context, targetID int64, sourceIDs []int64) (*entity.Tag, error) {
+	return m.mergeFunc(ctx, targetID, sourceIDs)
+}
+
+func (m *mockTagRepository) CreateAlias(ctx context.Context, alias *entity.TagAlias) (*entity.TagAlias, error) {
+	return alias, nil
+}
+
+func (m *mockTagRepository) FindAliases(ctx context.Context, tagID int64) ([]*entity.TagAlias, error) {
+	return []*entity.TagAlias{}, nil
+}
+
+func (m *mockTagRepository) DeleteAlias(ctx context.Context, tagID int64, alias string) error {
+	return nil
+}
+
+func (m *mockTagRepository) FindUsages(ctx context.Context) ([]entity.TagUsage, error) {
+	return m.usages, nil
+}
+
+func (m *mockTagRepository) FindUsagesByTagIDs(ctx context.Context, tagIDs []int64) ([]entity.TagUsage, error) {
+	m.usageTagIDs = tagIDs
+	result := make([]entity.TagUsage, 0)
+	for _, usage := range m.usages {
+		if slices.Contains(tagIDs, usage.TagID) {
+			result = append(result, usage)
+		}
+	}
+	return result, nil
+}
+
+func (m *mockTagRepository) FindMonthlyArticleCounts(ctx context.Context, tagID int64) ([]entity.TagMonthlyCount, error) {
+	return m.monthlyCounts, nil
+}
+
+func (m *mockTagRepository) DeleteUnused(ctx context.Context) ([]*entity.Tag, error) {
+	return []*entity.Tag{}, nil
+}
+
 // CreateTagのテスト
 func TestCreateTag(t *testing.T) {
 	t.Run("正常系：タグを作成できる", func(t *testing.T) {
@@ -62,7 +136,7 @@ func TestCreateTag(t *testing.T) {
 		usecase := NewTagUsecase(mockRepo)
 
 		// テスト実行
-		result, err := usecase.CreateTag(context.Background(), "Go")
+		result, err := usecase.CreateTag(context.Background(), CreateTagInput{Name: "Go"})
 
 		// 検証
 		require.NoError(t, err)
@@ -74,7 +148,7 @@ func TestCreateTag(t *testing.T) {
 		mockRepo := &mockTagRepository{}
 		usecase := NewTagUsecase(mockRepo)
 
-		result, err := usecase.CreateTag(context.Background(), "")
+		result, err := usecase.CreateTag(context.Background(), CreateTagInput{Name: ""})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -85,7 +159,7 @@ func TestCreateTag(t *testing.T) {
 		mockRepo := &mockTagRepository{}
 		usecase := NewTagUsecase(mockRepo)
 
-		result, err := usecase.CreateTag(context.Background(), "   ")
+		result, err := usecase.CreateTag(context.Background(), CreateTagInput{Name: "   "})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -97,7 +171,7 @@ func TestCreateTag(t *testing.T) {
 		usecase := NewTagUsecase(mockRepo)
 
 		longName := strings.Repeat("あ", 51)
-		result, err := usecase.CreateTag(context.Background(), longName)
+		result, err := usecase.CreateTag(context.Background(), CreateTagInput{Name: longName})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -112,7 +186,7 @@ func TestCreateTag(t *testing.T) {
 		}
 		usecase := NewTagUsecase(mockRepo)
 
-		result, err := usecase.CreateTag(context.Background(), "Go")
+		result, err := usecase.CreateTag(context.Background(), CreateTagInput{Name: "Go"})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -303,7 +377,7 @@ func TestUpdateTag(t *testing.T) {
 		}
 
 		usecase := NewTagUsecase(mockRepo)
-		result, err := usecase.UpdateTag(context.Background(), 1, "新タグ名")
+		result, err := usecase.UpdateTag(context.Background(), 1, UpdateTagInput{Name: "新タグ名"})
 
 		require.NoError(t, err)
 		assert.Equal(t, "新タグ名", result.Name)
@@ -317,7 +391,7 @@ func TestUpdateTag(t *testing.T) {
 		}
 
 		usecase := NewTagUsecase(mockRepo)
-		result, err := usecase.UpdateTag(context.Background(), 999, "新タグ名")
+		result, err := usecase.UpdateTag(context.Background(), 999, UpdateTagInput{Name: "新タグ名"})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -339,7 +413,7 @@ func TestUpdateTag(t *testing.T) {
 		}
 
 		usecase := NewTagUsecase(mockRepo)
-		result, err := usecase.UpdateTag(context.Background(), 1, "")
+		result, err := usecase.UpdateTag(context.Background(), 1, UpdateTagInput{Name: ""})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -364,7 +438,7 @@ func TestUpdateTag(t *testing.T) {
 		}
 
 		usecase := NewTagUsecase(mockRepo)
-		result, err := usecase.UpdateTag(context.Background(), 1, "新タグ名")
+		result, err := usecase.UpdateTag(context.Background(), 1, UpdateTagInput{Name: "新タグ名"})
 
 		require.Error(t, err)
 		assert.Nil(t, result)
@@ -425,3 +499,445 @@ func TestDeleteTag(t *testing.T) {
 		assert.Contains(t, err.Error(), "database error")
 	})
 }
+
+// 親タグの設定のテスト
+func TestUpdateTag_Parent(t *testing.T) {
+	tags := map[int64]*entity.Tag{
+		1: {ID: 1, Name: "Infrastructure"},
+		2: {ID: 2, Name: "Kubernetes", ParentID: 1},
+	}
+	newMockRepo := func() *mockTagRepository {
+		return &mockTagRepository{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.Tag, error) {
+				tag, ok := tags[id]
+				if !ok {
+					return nil, domainerrors.NotFoundError("tag", id)
+				}
+				copied := *tag
+				return &copied, nil
+			},
+			findAllFunc: func(ctx context.Context) ([]*entity.Tag, error) {
+				return []*entity.Tag{tags[1], tags[2]}, nil
+			},
+			updateFunc: func(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
+				return tag, nil
+			},
+		}
+	}
+
+	t.Run("正常系：親を省略した場合は親を変更しない", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+
+		result, err := usecase.UpdateTag(context.Background(), 2, UpdateTagInput{Name: "K8s"})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), result.ParentID)
+	})
+
+	t.Run("正常系：0を指定すると親を外せる", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+		parentID := int64(0)
+
+		result, err := usecase.UpdateTag(context.Background(), 2, UpdateTagInput{Name: "Kubernetes", ParentID: &parentID})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), result.ParentID)
+	})
+
+	t.Run("異常系：子孫を親にするとバリデーションエラー", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+		parentID := int64(2)
+
+		_, err := usecase.UpdateTag(context.Background(), 1, UpdateTagInput{Name: "Infrastructure", ParentID: &parentID})
+
+		require.Error(t, err)
+		assert.True(t, domainerrors.IsValidationError(err))
+	})
+
+	t.Run("異常系：存在しない親はNotFoundエラー", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+		parentID := int64(99)
+
+		_, err := usecase.UpdateTag(context.Background(), 2, UpdateTagInput{Name: "Kubernetes", ParentID: &parentID})
+
+		require.Error(t, err)
+		assert.True(t, domainerrors.IsNotFoundError(err))
+	})
+}
+
+// MergeTagsのテスト
+func TestMergeTags(t *testing.T) {
+	tags := map[int64]*entity.Tag{
+		1: {ID: 1, Name: "Go"},
+		2: {ID: 2, Name: "golang"},
+		3: {ID: 3, Name: "Go言語"},
+		4: {ID: 4, Name: "Goroutine", ParentID: 1},
+	}
+	newMockRepo := func(merged *[]int64) *mockTagRepository {
+		return &mockTagRepository{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.Tag, error) {
+				tag, ok := tags[id]
+				if !ok {
+					return nil, domainerrors.NotFoundError("tag", id)
+				}
+				return tag, nil
+			},
+			findAllFunc: func(ctx context.Context) ([]*entity.Tag, error) {
+				return []*entity.Tag{tags[1], tags[2], tags[3], tags[4]}, nil
+			},
+			mergeFunc: func(ctx context.Context, targetID int64, sourceIDs []int64) (*entity.Tag, error) {
+				*merged = sourceIDs
+				return tags[targetID], nil
+			},
+		}
+	}
+
+	t.Run("正常系：重複を除いた統合元を統合できる", func(t *testing.T) {
+		var merged []int64
+		usecase := NewTagUsecase(newMockRepo(&merged))
+
+		result, err := usecase.MergeTags(context.Background(), 1, []int64{3, 2, 3})
+
+		require.NoError(t, err)
+		assert.Equal(t, "Go", result.Name)
+		assert.Equal(t, []int64{2, 3}, merged)
+	})
+
+	t.Run("異常系：子孫のタグには統合できない", func(t *testing.T) {
+		var merged []int64
+		usecase := NewTagUsecase(newMockRepo(&merged))
+
+		_, err := usecase.MergeTags(context.Background(), 4, []int64{1})
+
+		require.Error(t, err)
+		assert.True(t, domainerrors.IsValidationError(err))
+		assert.Nil(t, merged)
+	})
+
+	t.Run("異常系：存在しない統合元はNotFoundエラー", func(t *testing.T) {
+		var merged []int64
+		usecase := NewTagUsecase(newMockRepo(&merged))
+
+		_, err := usecase.MergeTags(context.Background(), 1, []int64{99})
+
+		require.Error(t, err)
+		assert.True(t, domainerrors.IsNotFoundError(err))
+	})
+
+	t.Run("異常系：統合元が空の場合はInvalidArgumentエラー", func(t *testing.T) {
+		var merged []int64
+		usecase := NewTagUsecase(newMockRepo(&merged))
+
+		_, err := usecase.MergeTags(context.Background(), 1, nil)
+
+		require.Error(t, err)
+		assert.Equal(t, domainerrors.ErrCodeInvalidArgument, domainerrors.GetErrorCode(err))
+	})
+}
+
+func TestMergeNameCollisions(t *testing.T) {
+	tags := map[int64]*entity.Tag{
+		1: {ID: 1, Name: "Go"},
+		2: {ID: 2, Name: "go"},
+		3: {ID: 3, Name: "Ｇｏ"},
+		4: {ID: 4, Name: "Rust"},
+		5: {ID: 5, Name: "rust", ParentID: 4},
+	}
+	newMockRepo := func(merged map[int64][]int64) *mockTagRepository {
+		return &mockTagRepository{
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.Tag, error) {
+				tag, ok := tags[id]
+				if !ok {
+					return nil, domainerrors.NotFoundError("tag", id)
+				}
+				return tag, nil
+			},
+			findAllFunc: func(ctx context.Context) ([]*entity.Tag, error) {
+				return []*entity.Tag{tags[1], tags[2], tags[3], tags[4], tags[5]}, nil
+			},
+			mergeFunc: func(ctx context.Context, targetID int64, sourceIDs []int64) (*entity.Tag, error) {
+				merged[targetID] = sourceIDs
+				return tags[targetID], nil
+			},
+		}
+	}
+
+	t.Run("正常系：衝突したタグをID順で先頭のタグに統合する", func(t *testing.T) {
+		merged := make(map[int64][]int64)
+		usecase := NewTagUsecase(newMockRepo(merged))
+
+		err := usecase.MergeNameCollisions(context.Background(), []entity.TagNameCollision{
+			{Key: "go", Tags: []*entity.Tag{tags[1], tags[2], tags[3]}},
+			{Key: "rust", Tags: []*entity.Tag{tags[4], tags[5]}},
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, map[int64][]int64{1: {2, 3}, 4: {5}}, merged)
+	})
+
+	t.Run("異常系：統合できない場合は衝突したタグを含むエラーを返す", func(t *testing.T) {
+		merged := make(map[int64][]int64)
+		repo := newMockRepo(merged)
+		repo.mergeFunc = func(ctx context.Context, targetID int64, sourceIDs []int64) (*entity.Tag, error) {
+			return nil, domainerrors.DatabaseError("merge tags", errors.New("connection lost"))
+		}
+		usecase := NewTagUsecase(repo)
+
+		err := usecase.MergeNameCollisions(context.Background(), []entity.TagNameCollision{
+			{Key: "go", Tags: []*entity.Tag{tags[1], tags[2]}},
+		})
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"go"(id=2)`)
+	})
+}
+
+// ListTags・GetTagStatsのテスト
+func TestListTags(t *testing.T) {
+	lastWeek := time.Now().AddDate(0, 0, -7)
+	yesterday := time.Now().AddDate(0, 0, -1)
+	newMockRepo := func() *mockTagRepository {
+		return &mockTagRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Tag, error) {
+				return []*entity.Tag{
+					{ID: 1, Name: "AWS"},
+					{ID: 2, Name: "Go"},
+					{ID: 3, Name: "Rust"},
+				}, nil
+			},
+			findByIDFunc: func(ctx context.Context, id int64) (*entity.Tag, error) {
+				return &entity.Tag{ID: id, Name: "Go"}, nil
+			},
+			usages: []entity.TagUsage{
+				{TagID: 2, ArticleCount: 5, LastUsedAt: lastWeek},
+				{TagID: 3, ArticleCount: 1, LastUsedAt: yesterday},
+			},
+		}
+	}
+
+	t.Run("正常系：記事数の多い順に並べる", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+
+		summaries, err := usecase.ListTags(context.Background(), TagListOptions{Sort: TagSortUsage})
+
+		require.NoError(t, err)
+		require.Len(t, summaries, 3)
+		assert.Equal(t, []string{"Go", "Rust", "AWS"}, []string{summaries[0].Tag.Name, summaries[1].Tag.Name, summaries[2].Tag.Name})
+		assert.Equal(t, 5, summaries[0].Usage.ArticleCount)
+	})
+
+	t.Run("正常系：最後に使われた順に並べる", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+
+		summaries, err := usecase.ListTags(context.Background(), TagListOptions{Sort: TagSortRecent})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Rust", "Go", "AWS"}, []string{summaries[0].Tag.Name, summaries[1].Tag.Name, summaries[2].Tag.Name})
+	})
+
+	t.Run("正常系：記事のないタグのみを取得する", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+
+		summaries, err := usecase.ListTags(context.Background(), TagListOptions{UnusedOnly: true})
+
+		require.NoError(t, err)
+		require.Len(t, summaries, 1)
+		assert.Equal(t, "AWS", summaries[0].Tag.Name)
+		assert.True(t, summaries[0].Usage.Unused())
+	})
+
+	t.Run("異常系：不正な並び順", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+
+		_, err := usecase.ListTags(context.Background(), TagListOptions{Sort: "popular"})
+
+		require.Error(t, err)
+		assert.Equal(t, domainerrors.ErrCodeInvalidArgument, domainerrors.GetErrorCode(err))
+	})
+
+	t.Run("正常系：使用状況と指定した月数の推移を取得する", func(t *testing.T) {
+		mockRepo := newMockRepo()
+		mockRepo.monthlyCounts = []entity.TagMonthlyCount{
+			{Month: "2020-01", Count: 4},
+			{Month: time.Now().Format("2006-01"), Count: 1},
+		}
+		usecase := NewTagUsecase(mockRepo)
+
+		stats, err := usecase.GetTagStats(context.Background(), 2, 3)
+
+		require.NoError(t, err)
+		assert.Equal(t, 5, stats.Usage.ArticleCount)
+		require.Len(t, stats.Growth, 3)
+		assert.Equal(t, 4, stats.Growth[0].Total)
+		assert.Equal(t, 1, stats.Growth[2].Count)
+		assert.Equal(t, 5, stats.Growth[2].Total)
+	})
+}
+
+func TestSuggestTags(t *testing.T) {
+	newMockRepo := func() *mockTagRepository {
+		return &mockTagRepository{
+			findAllFunc: func(ctx context.Context) ([]*entity.Tag, error) {
+				return []*entity.Tag{
+					{ID: 1, Name: "Go"},
+					{ID: 2, Name: "Google Cloud"},
+					{ID: 3, Name: "GraphQL"},
+					{ID: 4, Name: "ドッカー"},
+					{ID: 5, Name: "Kubernetes"},
+					{ID: 6, Name: "機械学習"},
+				}, nil
+			},
+			usages: []entity.TagUsage{
+				{TagID: 1, ArticleCount: 2},
+				{TagID: 2, ArticleCount: 8},
+				{TagID: 6, ArticleCount: 1},
+			},
+		}
+	}
+	names := func(suggestions []TagSuggestion) []string {
+		result := make([]string, 0, len(suggestions))
+		for _, suggestion := range suggestions {
+			result = append(result, suggestion.Tag.Name)
+		}
+		return result
+	}
+
+	t.Run("正常系：完全一致、前方一致の順に並べ、同じ種類では記事数の多い順とする", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+
+		suggestions, err := usecase.SuggestTags(context.Background(), TagSuggestInput{Query: "ｇｏ"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Go", "Google Cloud"}, names(suggestions))
+		assert.Equal(t, TagMatchExact, suggestions[0].Match)
+		assert.Equal(t, TagMatchPrefix, suggestions[1].Match)
+		assert.Equal(t, 8, suggestions[1].Usage.ArticleCount)
+	})
+
+	t.Run("正常系：ローマ字の入力でカタカナのタグを候補にする", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+
+		suggestions, err := usecase.SuggestTags(context.Background(), TagSuggestInput{Query: "dokk"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"ドッカー"}, names(suggestions))
+		assert.Equal(t, TagMatchReading, suggestions[0].Match)
+	})
+
+	t.Run("正常系：綴りの誤りを編集距離で候補にする", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+
+		suggestions, err := usecase.SuggestTags(context.Background(), TagSuggestInput{Query: "kubrenetes"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Kubernetes"}, names(suggestions))
+		assert.Equal(t, TagMatchFuzzy, suggestions[0].Match)
+	})
+
+	t.Run("正常系：記事の文脈に含まれるタグを候補にする", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+
+		suggestions, err := usecase.SuggestTags(context.Background(), TagSuggestInput{
+			Title:   "Goで作る機械学習の入門",
+			Summary: "GoogleのAPIを使ってモデルを動かす",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Go", "機械学習"}, names(suggestions))
+		assert.Equal(t, TagMatchContext, suggestions[0].Match)
+		assert.True(t, suggestions[0].InContext)
+	})
+
+	t.Run("正常系：同じ種類の一致では文脈に含まれるタグを優先する", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+
+		suggestions, err := usecase.SuggestTags(context.Background(), TagSuggestInput{Query: "g", Title: "GraphQL入門"})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"GraphQL", "Google Cloud", "Go"}, names(suggestions))
+	})
+
+	t.Run("正常系：件数を制限できる", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+
+		suggestions, err := usecase.SuggestTags(context.Background(), TagSuggestInput{Query: "g", Limit: 1})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Google Cloud"}, names(suggestions))
+	})
+
+	t.Run("正常系：前方一致の件数に関わらず同じ順位で候補を返す", func(t *testing.T) {
+		repo := newMockRepo()
+		findAll := repo.findAllFunc
+		repo.findAllFunc = func(ctx context.Context) ([]*entity.Tag, error) {
+			tags, err := findAll(ctx)
+			return append(tags, &entity.Tag{ID: 7, Name: "ゴーレム"}), err
+		}
+		usecase := NewTagUsecase(repo)
+
+		all, err := usecase.SuggestTags(context.Background(), TagSuggestInput{Query: "go"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Go", "Google Cloud", "ゴーレム"}, names(all))
+
+		for limit := 1; limit <= len(all); limit++ {
+			suggestions, err := usecase.SuggestTags(context.Background(), TagSuggestInput{Query: "go", Limit: limit})
+			require.NoError(t, err)
+			assert.Equal(t, names(all)[:limit], names(suggestions), limit)
+		}
+	})
+
+	t.Run("正常系：候補のタグのみ使用状況を取得する", func(t *testing.T) {
+		repo := newMockRepo()
+		usecase := NewTagUsecase(repo)
+
+		_, err := usecase.SuggestTags(context.Background(), TagSuggestInput{Query: "ｇｏ"})
+
+		require.NoError(t, err)
+		assert.ElementsMatch(t, []int64{1, 2}, repo.usageTagIDs)
+	})
+
+	t.Run("正常系：全タグの索引は有効期間の間は再利用し、タグの作成後は作り直す", func(t *testing.T) {
+		repo := newMockRepo()
+		findAll := repo.findAllFunc
+		calls := 0
+		repo.findAllFunc = func(ctx context.Context) ([]*entity.Tag, error) {
+			calls++
+			return findAll(ctx)
+		}
+		repo.createFunc = func(ctx context.Context, tag *entity.Tag) (*entity.Tag, error) {
+			tag.ID = 10
+			return tag, nil
+		}
+		now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+		usecase := NewTagUsecase(repo)
+		usecase.suggestIndex.now = func() time.Time { return now }
+
+		// 入力がない場合は前方一致の検索をしないため、全タグの取得は索引の作成のみとなる
+		input := TagSuggestInput{Title: "Goで作る機械学習の入門"}
+		for range 2 {
+			_, err := usecase.SuggestTags(context.Background(), input)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 1, calls)
+
+		_, err := usecase.CreateTag(context.Background(), CreateTagInput{Name: "Rust"})
+		require.NoError(t, err)
+		_, err = usecase.SuggestTags(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, 2, calls)
+
+		now = now.Add(tagSuggestIndexTTL)
+		_, err = usecase.SuggestTags(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("異常系：入力も文脈もない場合", func(t *testing.T) {
+		usecase := NewTagUsecase(newMockRepo())
+
+		_, err := usecase.SuggestTags(context.Background(), TagSuggestInput{Query: " "})
+
+		require.Error(t, err)
+		assert.Equal(t, domainerrors.ErrCodeInvalidArgument, domainerrors.GetErrorCode(err))
+	})
+}